@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -78,6 +79,29 @@ func (m *DnsLookupFamily) Decode(value string) error {
 	}
 }
 
+// AdmissionFailurePolicy determines how the agentgateway xDS connections are handled when the admission webhook
+// cannot be reached or does not answer with a valid response.
+type AdmissionFailurePolicy string
+
+const (
+	// AdmissionFailurePolicyFail rejects the connection.
+	AdmissionFailurePolicyFail AdmissionFailurePolicy = "Fail"
+	// AdmissionFailurePolicyIgnore admits the connection, as if the webhook was not configured.
+	AdmissionFailurePolicyIgnore AdmissionFailurePolicy = "Ignore"
+)
+
+// Decode implements envconfig.Decoder.
+func (p *AdmissionFailurePolicy) Decode(value string) error {
+	policy := AdmissionFailurePolicy(value)
+	switch policy {
+	case AdmissionFailurePolicyFail, AdmissionFailurePolicyIgnore:
+		*p = policy
+		return nil
+	default:
+		return fmt.Errorf("invalid admission failure policy: %q", value)
+	}
+}
+
 // GatewayClassParametersRefs maps GatewayClass names to ParametersReference
 type GatewayClassParametersRefs map[string]*gwv1.ParametersReference
 
@@ -170,6 +194,11 @@ type Settings struct {
 	// This corresponds to the value of the `grpc-xds-agw` port in the service.
 	AgentgatewayXdsServicePort uint32 `split_words:"true" default:"9978"`
 
+	// WasmServicePort is the port of the Kubernetes Service that serves the Wasm modules pulled from OCI images
+	// to the Envoy proxies, on the xDS host.
+	// This corresponds to the value of the `http-wasm` port in the service.
+	WasmServicePort uint32 `split_words:"true" default:"9979"`
+
 	UseRustFormations bool `split_words:"true" default:"true"`
 
 	// EnableInferExt defines whether to enable/disable support for Gateway API inference extension.
@@ -245,6 +274,65 @@ type Settings struct {
 	// "namespace" (required), "group" (optional), and "kind" (optional) fields.
 	// E.g., {"gateway-class-name":{"name":"params-name","namespace":"params-namespace","group":"gateway.networking.k8s.io","kind":"GatewayParameters"}}
 	GatewayClassParametersRefs GatewayClassParametersRefs `split_words:"true" default:"{}"`
+
+	// PropagatedGatewayLabelPrefixes is a comma-separated allowlist of label key prefixes. Gateway labels whose key
+	// starts with one of the prefixes are propagated onto the resources generated for the Gateway, such as the
+	// Deployment, Service and Pods. Labels set in the Gateway spec.infrastructure take precedence.
+	// E.g., "team,cost-center.example.com/"
+	PropagatedGatewayLabelPrefixes []string `split_words:"true"`
+
+	// PropagatedGatewayAnnotationPrefixes is a comma-separated allowlist of annotation key prefixes. Gateway annotations
+	// whose key starts with one of the prefixes are propagated onto the resources generated for the Gateway, such as
+	// the Deployment, Service and Pods. Annotations set in the Gateway spec.infrastructure take precedence.
+	// E.g., "backup.velero.io/,sidecar.istio.io/"
+	PropagatedGatewayAnnotationPrefixes []string `split_words:"true"`
+
+	// EnableClusterTrustBundles enables watching ClusterTrustBundles (certificates.k8s.io/v1beta1), so they can be
+	// referenced as the CA certificates for backend TLS and client certificate validation.
+	// The ClusterTrustBundle API must be served by the cluster.
+	EnableClusterTrustBundles bool `split_words:"true" default:"false"`
+
+	// GatewayProgrammedRequiresXdsAck holds the Programmed condition of Envoy-based Gateways at Pending until at least
+	// one proxy replica of the generated Deployment is ready. Envoy only reports ready once it loaded its first full
+	// xDS configuration, whichever control plane replica it is connected to. The Programmed condition message then
+	// reports the ready and desired proxy replicas of the Gateway.
+	GatewayProgrammedRequiresXdsAck bool `split_words:"true" default:"false"`
+
+	// ConfigExportDir is the directory the xDS configuration translated for each Envoy-based Gateway is exported to,
+	// e.g. a mounted volume synced to git or an object store. Export is disabled if empty.
+	ConfigExportDir string `split_words:"true"`
+
+	// ConfigExportInterval is the interval at which the configuration is exported to ConfigExportDir.
+	// If 0, the configuration is only exported on demand, through the admin server.
+	ConfigExportInterval time.Duration `split_words:"true" default:"1m"`
+
+	// EnableTap allows TrafficPolicies to configure the tap filter, which captures full requests and responses,
+	// including their bodies. It is disabled by default as the captures may contain sensitive data; when disabled,
+	// TrafficPolicies setting tap are rejected. When enabled, the proxies mount a memory-backed volume of 128Mi at
+	// /var/run/tap, the only directory tap file sinks can write captures to.
+	EnableTap bool `split_words:"true" default:"false"`
+
+	// AdminAuthz requires the callers of the admin server to authenticate with a Kubernetes bearer token, and
+	// authorizes them with a SubjectAccessReview on the virtual "admin" resource of the gateway.kgateway.dev group,
+	// named after the path of the endpoint (e.g. snapshots/xds), with the get verb for reads and create for actions.
+	AdminAuthz bool `split_words:"true" default:"false"`
+
+	// XdsAdmissionWebhookURL is the URL of a webhook admitting the agentgateway proxies connecting to the control plane,
+	// beyond the built-in identity checks. The webhook is sent the node metadata and authenticated identity of each new
+	// xDS connection, and the connection is rejected unless it is allowed. Disabled if empty.
+	XdsAdmissionWebhookURL string `split_words:"true"`
+
+	// XdsAdmissionWebhookFailurePolicy determines whether the xDS connections are rejected (Fail) or admitted (Ignore)
+	// when the admission webhook cannot be reached, times out or does not answer with a valid response. The
+	// connections the webhook denies are always rejected.
+	XdsAdmissionWebhookFailurePolicy AdmissionFailurePolicy `split_words:"true" default:"Fail"`
+
+	// XdsAdmissionWebhookTimeout is the maximum time to wait for the admission webhook to answer.
+	XdsAdmissionWebhookTimeout time.Duration `split_words:"true" default:"5s"`
+
+	// XdsAdmissionWebhookCAFile is the path to a PEM bundle of the CA certificates verifying the certificate of an
+	// https admission webhook. The system roots are used if empty.
+	XdsAdmissionWebhookCAFile string `split_words:"true"`
 }
 
 // BuildSettings returns a zero-valued Settings obj if error is encountered when parsing env
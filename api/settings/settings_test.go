@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/require"
@@ -29,6 +30,7 @@ func allEnvVarsSet() map[string]string {
 		"KGW_XDS_SERVICE_NAME":                         "custom-svc",
 		"KGW_XDS_SERVICE_PORT":                         "1234",
 		"KGW_AGENTGATEWAY_XDS_SERVICE_PORT":            "5678",
+		"KGW_WASM_SERVICE_PORT":                        "9012",
 		"KGW_USE_RUST_FORMATIONS":                      "false",
 		"KGW_ENABLE_INFER_EXT":                         "true",
 		"KGW_DEFAULT_IMAGE_REGISTRY":                   "my-registry",
@@ -51,6 +53,18 @@ func allEnvVarsSet() map[string]string {
 		"KGW_XDS_AUTH":                                 "false",
 		"KGW_XDS_TLS":                                  "true",
 		"KGW_ENABLE_EXPERIMENTAL_GATEWAY_API_FEATURES": "false",
+		"KGW_PROPAGATED_GATEWAY_LABEL_PREFIXES":        "team,cost-center.example.com/",
+		"KGW_PROPAGATED_GATEWAY_ANNOTATION_PREFIXES":   "backup.velero.io/",
+		"KGW_ENABLE_CLUSTER_TRUST_BUNDLES":             "true",
+		"KGW_GATEWAY_PROGRAMMED_REQUIRES_XDS_ACK":      "true",
+		"KGW_CONFIG_EXPORT_DIR":                        "/var/run/kgateway/config",
+		"KGW_CONFIG_EXPORT_INTERVAL":                   "30s",
+		"KGW_ENABLE_TAP":                               "true",
+		"KGW_ADMIN_AUTHZ":                              "true",
+		"KGW_XDS_ADMISSION_WEBHOOK_URL":                "https://admission.example.com/xds",
+		"KGW_XDS_ADMISSION_WEBHOOK_FAILURE_POLICY":     string(AdmissionFailurePolicyIgnore),
+		"KGW_XDS_ADMISSION_WEBHOOK_TIMEOUT":            "2s",
+		"KGW_XDS_ADMISSION_WEBHOOK_CA_FILE":            "/etc/admission/ca.crt",
 	}
 }
 
@@ -83,6 +97,7 @@ func TestSettings(t *testing.T) {
 				XdsServiceName:                       wellknown.DefaultXdsService,
 				XdsServicePort:                       wellknown.DefaultXdsPort,
 				AgentgatewayXdsServicePort:           wellknown.DefaultAgwXdsPort,
+				WasmServicePort:                      wellknown.DefaultWasmPort,
 				UseRustFormations:                    true,
 				EnableInferExt:                       false,
 				DefaultImageRegistry:                 "cr.kgateway.dev",
@@ -105,6 +120,9 @@ func TestSettings(t *testing.T) {
 				XdsTLS:                               false,
 				EnableExperimentalGatewayAPIFeatures: true,
 				GatewayClassParametersRefs:           GatewayClassParametersRefs{},
+				ConfigExportInterval:                 time.Minute,
+				XdsAdmissionWebhookFailurePolicy:     AdmissionFailurePolicyFail,
+				XdsAdmissionWebhookTimeout:           5 * time.Second,
 			},
 		},
 		{
@@ -122,6 +140,7 @@ func TestSettings(t *testing.T) {
 				XdsServiceName:                       "custom-svc",
 				XdsServicePort:                       1234,
 				AgentgatewayXdsServicePort:           5678,
+				WasmServicePort:                      9012,
 				UseRustFormations:                    false,
 				EnableInferExt:                       true,
 				DefaultImageRegistry:                 "my-registry",
@@ -153,6 +172,18 @@ func TestSettings(t *testing.T) {
 						Namespace: ptr.To(gwv1.Namespace("infra")),
 					},
 				},
+				PropagatedGatewayLabelPrefixes:      []string{"team", "cost-center.example.com/"},
+				PropagatedGatewayAnnotationPrefixes: []string{"backup.velero.io/"},
+				EnableClusterTrustBundles:           true,
+				GatewayProgrammedRequiresXdsAck:     true,
+				ConfigExportDir:                     "/var/run/kgateway/config",
+				ConfigExportInterval:                30 * time.Second,
+				EnableTap:                           true,
+				AdminAuthz:                          true,
+				XdsAdmissionWebhookURL:              "https://admission.example.com/xds",
+				XdsAdmissionWebhookFailurePolicy:    AdmissionFailurePolicyIgnore,
+				XdsAdmissionWebhookTimeout:          2 * time.Second,
+				XdsAdmissionWebhookCAFile:           "/etc/admission/ca.crt",
 			},
 		},
 		{
@@ -219,6 +250,7 @@ func TestSettings(t *testing.T) {
 				XdsServiceName:                       wellknown.DefaultXdsService,
 				XdsServicePort:                       wellknown.DefaultXdsPort,
 				AgentgatewayXdsServicePort:           wellknown.DefaultAgwXdsPort,
+				WasmServicePort:                      wellknown.DefaultWasmPort,
 				UseRustFormations:                    true,
 				DefaultImageRegistry:                 "cr.kgateway.dev",
 				DefaultImageTag:                      "",
@@ -236,6 +268,9 @@ func TestSettings(t *testing.T) {
 				XdsTLS:                               false,
 				EnableExperimentalGatewayAPIFeatures: true,
 				GatewayClassParametersRefs:           GatewayClassParametersRefs{},
+				ConfigExportInterval:                 time.Minute,
+				XdsAdmissionWebhookFailurePolicy:     AdmissionFailurePolicyFail,
+				XdsAdmissionWebhookTimeout:           5 * time.Second,
 			},
 		},
 	}
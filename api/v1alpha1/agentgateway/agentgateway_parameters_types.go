@@ -147,6 +147,43 @@ type AgentgatewayParametersConfigs struct {
 	// +optional
 	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
 
+	// Additional volumes of the agentgateway pod, e.g. to share files with
+	// extraContainers or to mount them in the agentgateway container with
+	// extraVolumeMounts. See
+	// https://kubernetes.io/docs/concepts/storage/volumes/
+	// for details. Volumes with the same name as a volume of the GatewayClass
+	// parameters replace it.
+	//
+	// +optional
+	ExtraVolumes []corev1.Volume `json:"extraVolumes,omitempty"`
+
+	// Additional volume mounts of the agentgateway container. The volumes
+	// must be defined in extraVolumes. Mounts with the same mount path as a
+	// mount of the GatewayClass parameters replace it.
+	//
+	// +optional
+	ExtraVolumeMounts []corev1.VolumeMount `json:"extraVolumeMounts,omitempty"`
+
+	// Additional containers of the agentgateway pod, running alongside the
+	// agentgateway container, e.g. a log shipper. Containers with the same
+	// name as a container of the GatewayClass parameters replace it. The
+	// name `agentgateway` is reserved for the agentgateway container.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(c, c.name != 'agentgateway')",message="the container name agentgateway is reserved for the agentgateway container"
+	ExtraContainers []corev1.Container `json:"extraContainers,omitempty"`
+
+	// Init containers of the agentgateway pod, which run to completion before
+	// the agentgateway container starts, e.g. to fetch secrets. See
+	// https://kubernetes.io/docs/concepts/workloads/pods/init-containers/
+	// for details. Init containers with the same name as an init container of
+	// the GatewayClass parameters replace it. The name `agentgateway` is
+	// reserved for the agentgateway container.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.all(c, c.name != 'agentgateway')",message="the container name agentgateway is reserved for the agentgateway container"
+	ExtraInitContainers []corev1.Container `json:"extraInitContainers,omitempty"`
+
 	// Shutdown delay configuration.  How graceful planned or unplanned data
 	// plane changes happen is in tension with how quickly rollouts of the data
 	// plane complete. How long a data plane pod must wait for shutdown to be
@@ -159,6 +196,36 @@ type AgentgatewayParametersConfigs struct {
 	//
 	// +optional
 	Istio *IstioSpec `json:"istio,omitempty"`
+
+	// The readiness probe of the agentgateway container, replacing the
+	// default probe of the /healthz/ready endpoint on port 15021. See
+	// https://kubernetes.io/docs/tasks/configure-pod-container/configure-liveness-readiness-startup-probes/
+	// for details.
+	//
+	// +optional
+	ReadinessProbe *corev1.Probe `json:"readinessProbe,omitempty"`
+
+	// The startup probe of the agentgateway container, replacing the default
+	// probe of the /healthz/ready endpoint on port 15021, which allows 60
+	// seconds for agentgateway to start. A higher failureThreshold can be
+	// needed for slow starts, e.g. with large route tables.
+	//
+	// +optional
+	StartupProbe *corev1.Probe `json:"startupProbe,omitempty"`
+
+	// The liveness probe of the agentgateway container. By default, the
+	// agentgateway container has no liveness probe.
+	//
+	// +optional
+	LivenessProbe *corev1.Probe `json:"livenessProbe,omitempty"`
+
+	// The lifecycle hooks of the agentgateway container, e.g. a preStop hook
+	// delaying the shutdown until the endpoints of the pod are removed from
+	// the load balancers. The termination grace period of the pod is
+	// configured with shutdown.max.
+	//
+	// +optional
+	Lifecycle *corev1.Lifecycle `json:"lifecycle,omitempty"`
 }
 
 type IstioSpec struct {
@@ -76,6 +76,12 @@ type AgentgatewayPolicySpec struct {
 	// +optional
 	TargetSelectors []shared.LocalPolicyTargetSelectorWithSectionName `json:"targetSelectors,omitempty"`
 
+	// expiresAt is the time after which the policy is automatically deactivated and no longer attached to its targets,
+	// e.g. for a temporary allowlist or bypass. As the expiry approaches, the Accepted condition of the policy reports
+	// the Expiring reason; once expired, the policy reports the Expired reason.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
 	// frontend defines settings for how to handle incoming traffic.
 	//
 	// A frontend policy can only target a Gateway. Listener and ListenerSet are not valid targets.
@@ -420,6 +426,7 @@ const (
 )
 
 // +kubebuilder:validation:XValidation:rule="has(self.phase) && self.phase == 'PreRouting' ? !has(self.rateLimit) && !has(self.cors) && !has(self.csrf) && !has(self.headerModifiers) && !has(self.hostRewrite) && !has(self.timeouts) && !has(self.retry) && !has(self.authorization): true",message="phase PreRouting only supports extAuth, transformation, and extProc"
+// +kubebuilder:validation:XValidation:rule="!has(self.authenticationComposition) || (has(self.authenticationComposition.anyOf) ? self.authenticationComposition.anyOf : self.authenticationComposition.allOf).all(m, m == 'JWT' ? has(self.jwtAuthentication) : (m == 'APIKey' ? has(self.apiKeyAuthentication) : has(self.basicAuthentication)))",message="authenticationComposition may only list the authentication mechanisms configured in the policy"
 type Traffic struct {
 	// The phase to apply the traffic policy to. If the phase is PreRouting, the targetRef must be a Gateway or a Listener.
 	// PreRouting is typically used only when a policy needs to influence the routing decision.
@@ -486,10 +493,19 @@ type Traffic struct {
 	// +optional
 	Retry *Retry `json:"retry,omitempty"`
 
+	// mirroring duplicates a percentage of requests to a secondary backend, for example to shadow production
+	// traffic to a new version of a service. Mirrored requests are fire-and-forget: the responses of the mirror
+	// backend are ignored.
+	// It is applicable to HTTPRoutes and ignored for other targeted kinds.
+	// +optional
+	Mirroring *RequestMirroring `json:"mirroring,omitempty"`
+
 	// authorization specifies the access rules based on roles and permissions.
 	// If multiple authorization rules are applied across different policies (at the same, or different, attahcment points),
 	// all rules are merged.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="!has(self.action) || self.action != 'Audit'",message="the Audit action is not supported by agentgateway"
+	// +kubebuilder:validation:XValidation:rule="!has(self.policy.matchers) || self.policy.matchers.all(m, !has(m.mcp))",message="mcp matchers are only supported by the MCP authorization of backends"
 	Authorization *shared.Authorization `json:"authorization,omitempty"`
 
 	// jwtAuthentication authenticates users based on JWT tokens.
@@ -505,9 +521,22 @@ type Traffic struct {
 	// +optional
 	APIKeyAuthentication *APIKeyAuthentication `json:"apiKeyAuthentication,omitempty"`
 
+	// authenticationComposition combines the jwtAuthentication, apiKeyAuthentication and basicAuthentication
+	// mechanisms of this policy, for example to accept requests with either a valid JWT or a valid API key.
+	// The mode of the combined mechanisms is ignored: with anyOf, a credential is validated when present, and
+	// requests without any credential are rejected; with allOf, all the credentials are required.
+	// +optional
+	AuthenticationComposition *shared.AuthenticationComposition `json:"authenticationComposition,omitempty"`
+
 	// direct response configures the policy to send a direct response to the client.
 	// +optional
 	DirectResponse *DirectResponse `json:"directResponse,omitempty"`
+
+	// redirect redirects the requests instead of forwarding them to the backends.
+	// The pathRegex and stripQuery fields are not supported by agentgateway.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!has(self.pathRegex) && !has(self.stripQuery)",message="pathRegex and stripQuery are not supported by agentgateway"
+	Redirect *shared.RequestRedirect `json:"redirect,omitempty"`
 }
 
 // DirectResponse defines the policy to send a direct response to the client.
@@ -767,7 +796,7 @@ type BackendAuth struct {
 	// request, the original token would be unchanged, so this would have no effect.
 	// +optional
 	Passthrough *BackendAuthPassthrough `json:"passthrough,omitempty"`
-	// TODO: azure, gcp
+	// TODO: azure
 
 	// Auth specifies an explicit AWS authentication method for the backend.
 	// When omitted, we will try to use the default AWS SDK authentication methods.
@@ -809,8 +838,10 @@ type GcpAuth struct {
 type AwsAuth struct {
 	// SecretRef references a Kubernetes Secret containing the AWS credentials.
 	// The Secret must have keys "accessKey", "secretKey", and optionally "sessionToken".
-	// +required
-	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// If unset, the credentials are resolved with the default AWS SDK credential chain, for example from IRSA or
+	// EKS Pod Identity.
+	// +optional
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
 }
 
 type BackendAuthPassthrough struct {
@@ -890,7 +921,7 @@ const (
 	RouteTypeRealtime RouteType = "Realtime"
 )
 
-// +kubebuilder:validation:AtLeastOneOf=authorization;authentication
+// +kubebuilder:validation:AtLeastOneOf=authorization;authentication;filter
 type BackendMCP struct {
 	// authorization defines MCPBackend level authorization. Unlike authorization at the HTTP level, which will reject
 	// unauthorized requests with a 403 error, this policy works at the MCPBackend level.
@@ -899,10 +930,27 @@ type BackendMCP struct {
 	//
 	// Get or call operations, such as call_tool, will evaluate the specific item and reject requests that do not meet the rule.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="!has(self.action) || self.action != 'Audit'",message="the Audit action is not supported by agentgateway"
 	Authorization *shared.Authorization `json:"authorization,omitempty"`
 	// authentication defines MCPBackend specific authentication rules.
 	// +optional
 	Authentication *MCPAuthentication `json:"authentication,omitempty"`
+	// filter hides tools, prompts and resources of the MCPBackend from the clients, without changing the MCP servers.
+	// Hidden items are removed from list operations, and get or call operations on them are rejected.
+	// +optional
+	Filter *MCPFilter `json:"filter,omitempty"`
+}
+
+// MCPFilter selects the tools, prompts and resources exposed by an MCPBackend.
+// For conditions on other attributes, use an authorization rule with the Deny action.
+// +kubebuilder:validation:AtLeastOneOf=allow;deny
+type MCPFilter struct {
+	// allow exposes only the listed tools, prompts and resources. The kinds of items without a list are not filtered.
+	// +optional
+	Allow *shared.AuthorizationMCPMatch `json:"allow,omitempty"`
+	// deny hides the listed tools, prompts and resources.
+	// +optional
+	Deny *shared.AuthorizationMCPMatch `json:"deny,omitempty"`
 }
 
 type MCPAuthentication struct {
@@ -1144,7 +1192,8 @@ type ExtAuthBody struct {
 
 // +kubebuilder:validation:AtLeastOneOf=local;global
 type RateLimits struct {
-	// Local defines a local rate limiting policy.
+	// Local defines a local rate limiting policy. Only the first limit is enforced by agentgateway; the other
+	// limits are reported as unsupported in the policy status.
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
 	// +optional
@@ -1253,6 +1302,7 @@ type CORS struct {
 	*gwv1.HTTPCORSFilter `json:",inline"`
 }
 
+// +kubebuilder:validation:AtMostOneOf=percentageEnabled;percentageShadowed
 type CSRF struct {
 	// additionalOrigin specifies additional source origins that will be allowed in addition to the destination origin. The
 	// `Origin` consists of a scheme and a host, with an optional port, and takes the form `<scheme>://<host>(:<port>)`.
@@ -1261,6 +1311,25 @@ type CSRF struct {
 	// +kubebuilder:validation:MaxItems=16
 	// +optional
 	AdditionalOrigins []ShortString `json:"additionalOrigins,omitempty"`
+
+	// percentageEnabled specifies the percentage of requests for which the CSRF policy is enforced.
+	//
+	// This field is not supported by agentgateway, which enforces the CSRF policy on all requests and reports the
+	// field as unsupported in the policy status.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	PercentageEnabled *int32 `json:"percentageEnabled,omitempty"`
+
+	// percentageShadowed specifies the percentage of requests for which the CSRF policy is evaluated and tracked,
+	// but not enforced.
+	//
+	// This field is not supported by agentgateway, which enforces the CSRF policy on all requests and reports the
+	// field as unsupported in the policy status.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	PercentageShadowed *int32 `json:"percentageShadowed,omitempty"`
 }
 
 type HostnameRewrite struct {
@@ -1291,6 +1360,22 @@ type Retry struct {
 	*gwv1.HTTPRouteRetry `json:",inline"`
 }
 
+// RequestMirroring defines the backend requests are mirrored to.
+type RequestMirroring struct {
+	// backendRef references the backend requests are mirrored to.
+	// Supported types are Service, InferencePool and (static) Backend.
+	// +required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// percentage of requests to mirror.
+	// If unset, all requests are mirrored.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
 // accessLogs specifies how per-request access logs are emitted.
 type AccessLog struct {
 	// filter specifies a CEL expression that is used to filter logs. A log will only be emitted if the expression evaluates
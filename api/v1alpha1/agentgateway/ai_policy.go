@@ -199,9 +199,10 @@ type PromptguardResponse struct {
 	Webhook *Webhook `json:"webhook,omitempty"`
 }
 
-// AIPromptGuard configures a prompt guards to block unwanted requests to the LLM provider and mask sensitive data.
-// Prompt guards can be used to reject requests based on the content of the prompt, as well as
-// mask responses based on the content of the response.
+// AIPromptGuard configures prompt guards to block unwanted requests to the LLM provider and mask sensitive data.
+// Each guard either matches regular expressions, forwards the content to a webhook, or, for requests only, passes
+// the prompt through the OpenAI Moderations endpoint. The guards of requests can reject or mask the matched content,
+// while the responses are always masked. The guards are evaluated in order.
 //
 // This example rejects any request prompts that contain
 // the string "credit card", and masks any credit card numbers in the response.
@@ -212,15 +213,13 @@ type PromptguardResponse struct {
 //	- response:
 //	    message: "Rejected due to inappropriate content"
 //	  regex:
-//	    action: REJECT
+//	    action: Reject
 //	    matches:
-//	    - pattern: "credit card"
-//	      name: "CC"
+//	    - "credit card"
 //	response:
 //	- regex:
 //	    builtins:
-//	    - CREDIT_CARD
-//	    action: MASK
+//	    - CreditCard
 //
 // ```
 // +kubebuilder:validation:AtLeastOneOf=request;response
@@ -415,6 +415,34 @@ func (in *AgentgatewayParametersConfigs) DeepCopyInto(out *AgentgatewayParameter
 		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExtraVolumes != nil {
+		in, out := &in.ExtraVolumes, &out.ExtraVolumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraVolumeMounts != nil {
+		in, out := &in.ExtraVolumeMounts, &out.ExtraVolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraContainers != nil {
+		in, out := &in.ExtraContainers, &out.ExtraContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtraInitContainers != nil {
+		in, out := &in.ExtraInitContainers, &out.ExtraInitContainers
+		*out = make([]corev1.Container, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Shutdown != nil {
 		in, out := &in.Shutdown, &out.Shutdown
 		*out = new(ShutdownSpec)
@@ -425,6 +453,26 @@ func (in *AgentgatewayParametersConfigs) DeepCopyInto(out *AgentgatewayParameter
 		*out = new(IstioSpec)
 		**out = **in
 	}
+	if in.ReadinessProbe != nil {
+		in, out := &in.ReadinessProbe, &out.ReadinessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LivenessProbe != nil {
+		in, out := &in.LivenessProbe, &out.LivenessProbe
+		*out = new(corev1.Probe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(corev1.Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentgatewayParametersConfigs.
@@ -632,6 +680,10 @@ func (in *AgentgatewayPolicySpec) DeepCopyInto(out *AgentgatewayPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 	if in.Frontend != nil {
 		in, out := &in.Frontend, &out.Frontend
 		*out = new(Frontend)
@@ -697,7 +749,11 @@ func (in *AttributeAdd) DeepCopy() *AttributeAdd {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AwsAuth) DeepCopyInto(out *AwsAuth) {
 	*out = *in
-	out.SecretRef = in.SecretRef
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AwsAuth.
@@ -814,7 +870,7 @@ func (in *BackendAuth) DeepCopyInto(out *BackendAuth) {
 	if in.AWS != nil {
 		in, out := &in.AWS, &out.AWS
 		*out = new(AwsAuth)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.GCP != nil {
 		in, out := &in.GCP, &out.GCP
@@ -912,6 +968,11 @@ func (in *BackendMCP) DeepCopyInto(out *BackendMCP) {
 		*out = new(MCPAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(MCPFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendMCP.
@@ -1158,6 +1219,16 @@ func (in *CSRF) DeepCopyInto(out *CSRF) {
 		*out = make([]ShortString, len(*in))
 		copy(*out, *in)
 	}
+	if in.PercentageEnabled != nil {
+		in, out := &in.PercentageEnabled, &out.PercentageEnabled
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PercentageShadowed != nil {
+		in, out := &in.PercentageShadowed, &out.PercentageShadowed
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRF.
@@ -1865,6 +1936,31 @@ func (in *MCPBackend) DeepCopy() *MCPBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MCPFilter) DeepCopyInto(out *MCPFilter) {
+	*out = *in
+	if in.Allow != nil {
+		in, out := &in.Allow, &out.Allow
+		*out = new(shared.AuthorizationMCPMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Deny != nil {
+		in, out := &in.Deny, &out.Deny
+		*out = new(shared.AuthorizationMCPMatch)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MCPFilter.
+func (in *MCPFilter) DeepCopy() *MCPFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(MCPFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *McpSelector) DeepCopyInto(out *McpSelector) {
 	*out = *in
@@ -2248,6 +2344,27 @@ func (in *RemoteJWKS) DeepCopy() *RemoteJWKS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestMirroring) DeepCopyInto(out *RequestMirroring) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestMirroring.
+func (in *RequestMirroring) DeepCopy() *RequestMirroring {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestMirroring)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceAdd) DeepCopyInto(out *ResourceAdd) {
 	*out = *in
@@ -2451,6 +2568,11 @@ func (in *Traffic) DeepCopyInto(out *Traffic) {
 		*out = new(Retry)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Mirroring != nil {
+		in, out := &in.Mirroring, &out.Mirroring
+		*out = new(RequestMirroring)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Authorization != nil {
 		in, out := &in.Authorization, &out.Authorization
 		*out = new(shared.Authorization)
@@ -2471,11 +2593,21 @@ func (in *Traffic) DeepCopyInto(out *Traffic) {
 		*out = new(APIKeyAuthentication)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AuthenticationComposition != nil {
+		in, out := &in.AuthenticationComposition, &out.AuthenticationComposition
+		*out = new(shared.AuthenticationComposition)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DirectResponse != nil {
 		in, out := &in.DirectResponse, &out.DirectResponse
 		*out = new(DirectResponse)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(shared.RequestRedirect)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Traffic.
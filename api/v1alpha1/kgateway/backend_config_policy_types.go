@@ -40,6 +40,9 @@ type BackendConfigPolicyList struct {
 // BackendConfigPolicySpec defines the desired state of BackendConfigPolicy.
 //
 // +kubebuilder:validation:AtMostOneOf=http1ProtocolOptions;http2ProtocolOptions
+// +kubebuilder:validation:XValidation:rule="!has(self.httpProtocol) || self.httpProtocol != 'Auto' || has(self.tls)",message="httpProtocol Auto requires tls to be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.httpProtocol) || self.httpProtocol != 'HTTP1' || !has(self.http2ProtocolOptions)",message="http2ProtocolOptions cannot be set when httpProtocol is HTTP1"
+// +kubebuilder:validation:XValidation:rule="!has(self.httpProtocol) || self.httpProtocol != 'HTTP2' || !has(self.http1ProtocolOptions)",message="http1ProtocolOptions cannot be set when httpProtocol is HTTP2"
 type BackendConfigPolicySpec struct {
 	// TargetRefs specifies the target references to attach the policy to.
 	// +optional
@@ -73,12 +76,24 @@ type BackendConfigPolicySpec struct {
 	// +optional
 	CommonHttpProtocolOptions *CommonHttpProtocolOptions `json:"commonHttpProtocolOptions,omitempty"`
 
+	// HTTPProtocol selects the HTTP protocol used to connect to the backend, overriding the protocol
+	// inferred from the appProtocol of the Service port or Backend.
+	// HTTP1 uses HTTP/1.1.
+	// HTTP2 uses HTTP/2 with prior knowledge: h2c for plaintext backends, or h2 when TLS is configured.
+	// Auto negotiates HTTP/2 or HTTP/1.1 with the backend using ALPN, and requires TLS to be set.
+	// Downstream uses the protocol of the downstream request, so that HTTP/2 requests are sent over HTTP/2
+	// and HTTP/1.1 requests over HTTP/1.1.
+	// If unset, HTTP/2 is used for backends with an http2, grpc, grpc-web or kubernetes.io/h2c appProtocol,
+	// and HTTP/1.1 otherwise.
+	// +optional
+	HTTPProtocol *BackendHTTPProtocol `json:"httpProtocol,omitempty"`
+
 	// Additional options when handling HTTP1 requests upstream.
 	// +optional
 	Http1ProtocolOptions *Http1ProtocolOptions `json:"http1ProtocolOptions,omitempty"`
 
 	// Http2ProtocolOptions contains the options necessary to configure HTTP/2 backends.
-	// Note: Http2ProtocolOptions can only be applied to HTTP/2 backends.
+	// Note: Http2ProtocolOptions can only be applied to HTTP/2 backends, or backends with httpProtocol HTTP2, Auto or Downstream.
 	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/tls/v3/tls.proto#envoy-v3-api-msg-extensions-transport-sockets-tls-v3-sslconfig) for more details.
 	// +optional
 	Http2ProtocolOptions *Http2ProtocolOptions `json:"http2ProtocolOptions,omitempty"`
@@ -88,6 +103,13 @@ type BackendConfigPolicySpec struct {
 	// +optional
 	TLS *TLS `json:"tls,omitempty"`
 
+	// ProxyProtocol sends a PROXY protocol header with the address of the original client when connecting to the
+	// backend, for backends needing the client address of TCP connections, e.g. HAProxy or NGINX.
+	// The header is sent before the TLS handshake when TLS is configured.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/transport_sockets/proxy_protocol/v3/upstream_proxy_protocol.proto) for more details.
+	// +optional
+	ProxyProtocol *UpstreamProxyProtocol `json:"proxyProtocol,omitempty"`
+
 	// LoadBalancer contains the options necessary to configure the load balancer.
 	// +optional
 	LoadBalancer *LoadBalancer `json:"loadBalancer,omitempty"`
@@ -104,8 +126,114 @@ type BackendConfigPolicySpec struct {
 	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/circuit_breaking) for more details.
 	// +optional
 	CircuitBreakers *CircuitBreakers `json:"circuitBreakers,omitempty"`
+
+	// SessionAffinity pins the clients of stateful backends to the endpoint that served the first request of
+	// their session, by recording the endpoint in a cookie or a header of the responses.
+	// Unlike consistent hashing, sessions stay on their endpoint when endpoints are added or removed.
+	// NOTE: This field is only honored for backends of HTTPRoutes.
+	// +optional
+	SessionAffinity *SessionAffinity `json:"sessionAffinity,omitempty"`
+
+	// TrafficDistribution prefers the endpoints topologically close to the gateway, e.g. to reduce cross-zone
+	// traffic costs. It overrides the trafficDistribution of the targeted Services.
+	// NOTE: This field is ignored for the backends targeted by a DestinationRule with locality load balancing.
+	// +optional
+	TrafficDistribution *TrafficDistribution `json:"trafficDistribution,omitempty"`
+}
+
+// TrafficDistribution configures topology-aware routing to the endpoints of a backend.
+type TrafficDistribution struct {
+	// Mode selects the endpoints that are preferred. Endpoints farther away are only used
+	// when not enough preferred endpoints are healthy.
+	// +required
+	Mode TrafficDistributionMode `json:"mode"`
+
+	// FailoverThreshold is the percentage of healthy endpoints among the preferred endpoints below which
+	// traffic starts failing over to the endpoints farther away, proportionally to the missing endpoints.
+	// Defaults to 72, i.e. an overprovisioning factor of 1.4.
+	// See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/upstream/load_balancing/priority) for more details.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	FailoverThreshold *int32 `json:"failoverThreshold,omitempty"`
 }
 
+// TrafficDistributionMode selects the endpoints preferred by topology-aware routing.
+// +kubebuilder:validation:Enum=Any;PreferSameZone;PreferSameNode;PreferNetwork
+type TrafficDistributionMode string
+
+const (
+	// TrafficDistributionModeAny does not prefer any endpoint.
+	TrafficDistributionModeAny TrafficDistributionMode = "Any"
+	// TrafficDistributionModePreferSameZone prefers the endpoints in the zone of the gateway, failing over to
+	// the same region and then network.
+	TrafficDistributionModePreferSameZone TrafficDistributionMode = "PreferSameZone"
+	// TrafficDistributionModePreferSameNode prefers the endpoints on the node of the gateway, failing over to
+	// the same subzone, then zone, region, and network.
+	TrafficDistributionModePreferSameNode TrafficDistributionMode = "PreferSameNode"
+	// TrafficDistributionModePreferNetwork prefers the endpoints in the network of the gateway.
+	TrafficDistributionModePreferNetwork TrafficDistributionMode = "PreferNetwork"
+)
+
+// SessionAffinity configures stateful session affinity.
+// +kubebuilder:validation:ExactlyOneOf=cookie;header
+type SessionAffinity struct {
+	// Cookie records the endpoint of a session in a cookie set by the gateway.
+	// If the TTL is unset or zero, the cookie is a session cookie.
+	// +optional
+	Cookie *Cookie `json:"cookie,omitempty"`
+
+	// Header records the endpoint of a session in a response header, that clients must send back in
+	// the next requests of the session. Useful for clients that do not support cookies.
+	// +optional
+	Header *SessionAffinityHeader `json:"header,omitempty"`
+
+	// Strict rejects the requests of a session whose endpoint is no longer available with a 503 response,
+	// instead of routing them to another endpoint.
+	// +optional
+	Strict *bool `json:"strict,omitempty"`
+}
+
+type SessionAffinityHeader struct {
+	// Name is the name of the header recording the endpoint of the session.
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+}
+
+// UpstreamProxyProtocol configures the PROXY protocol header sent to backends.
+type UpstreamProxyProtocol struct {
+	// Version is the version of the PROXY protocol header. Defaults to V1.
+	// +optional
+	Version *ProxyProtocolVersion `json:"version,omitempty"`
+}
+
+// ProxyProtocolVersion is a version of the PROXY protocol.
+// +kubebuilder:validation:Enum=V1;V2
+type ProxyProtocolVersion string
+
+const (
+	// ProxyProtocolVersionV1 is the human-readable version 1 of the PROXY protocol.
+	ProxyProtocolVersionV1 ProxyProtocolVersion = "V1"
+	// ProxyProtocolVersionV2 is the binary version 2 of the PROXY protocol.
+	ProxyProtocolVersionV2 ProxyProtocolVersion = "V2"
+)
+
+// BackendHTTPProtocol selects the HTTP protocol used to connect to a backend.
+// +kubebuilder:validation:Enum=HTTP1;HTTP2;Auto;Downstream
+type BackendHTTPProtocol string
+
+const (
+	// BackendHTTPProtocolHTTP1 uses HTTP/1.1.
+	BackendHTTPProtocolHTTP1 BackendHTTPProtocol = "HTTP1"
+	// BackendHTTPProtocolHTTP2 uses HTTP/2 with prior knowledge.
+	BackendHTTPProtocolHTTP2 BackendHTTPProtocol = "HTTP2"
+	// BackendHTTPProtocolAuto negotiates the protocol using ALPN.
+	BackendHTTPProtocolAuto BackendHTTPProtocol = "Auto"
+	// BackendHTTPProtocolDownstream uses the protocol of the downstream request.
+	BackendHTTPProtocolDownstream BackendHTTPProtocol = "Downstream"
+)
+
 // CircuitBreakers contains the options to configure circuit breaker thresholds for the default priority.
 // See [Envoy documentation](https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/circuit_breaker.proto) for more details.
 // +kubebuilder:validation:AtLeastOneOf=maxConnections;maxPendingRequests;maxRequests;maxRetries
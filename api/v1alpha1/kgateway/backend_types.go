@@ -92,8 +92,61 @@ type DynamicForwardProxyBackend struct {
 	// The hostname will be used for SNI and auto SAN validation.
 	// +optional
 	EnableTls *bool `json:"enableTls,omitempty"`
+
+	// DnsCache tunes the resolution and caching of the hostnames the requests are forwarded to.
+	// +optional
+	DnsCache *DynamicForwardProxyDnsCache `json:"dnsCache,omitempty"`
 }
 
+// DynamicForwardProxyDnsCache tunes the resolution and caching of the hostnames of a dynamic forward proxy backend.
+// Each hostname is resolved on its first request and cached, and resolved again periodically while it is in use.
+type DynamicForwardProxyDnsCache struct {
+	// LookupFamily is the DNS lookup family of the hostnames.
+	// Defaults to Auto.
+	// +optional
+	LookupFamily *DnsLookupFamily `json:"lookupFamily,omitempty"`
+
+	// RefreshRate is the interval at which the cached hostnames are resolved again.
+	// Defaults to 5s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="refreshRate must be at least 1ms"
+	RefreshRate *metav1.Duration `json:"refreshRate,omitempty"`
+
+	// RespectDnsTtl resolves the cached hostnames again when their DNS records expire, instead of at RefreshRate.
+	// +optional
+	RespectDnsTtl *bool `json:"respectDnsTtl,omitempty"`
+
+	// HostTtl is the duration after which a hostname that received no request is removed from the cache.
+	// Defaults to 5m.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	HostTtl *metav1.Duration `json:"hostTtl,omitempty"`
+
+	// MaxHosts is the maximum number of cached hostnames. Requests to new hostnames fail while the cache is full.
+	// Defaults to 1024.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxHosts *int32 `json:"maxHosts,omitempty"`
+}
+
+// DnsLookupFamily is the IP family of the addresses a hostname is resolved to.
+// +kubebuilder:validation:Enum=V4Preferred;V4Only;V6Only;Auto;All
+type DnsLookupFamily string
+
+const (
+	// DnsLookupFamilyV4Preferred resolves IPv4 addresses, and IPv6 addresses when there is no IPv4 address.
+	DnsLookupFamilyV4Preferred DnsLookupFamily = "V4Preferred"
+	// DnsLookupFamilyV4Only resolves IPv4 addresses.
+	DnsLookupFamilyV4Only DnsLookupFamily = "V4Only"
+	// DnsLookupFamilyV6Only resolves IPv6 addresses.
+	DnsLookupFamilyV6Only DnsLookupFamily = "V6Only"
+	// DnsLookupFamilyAuto resolves IPv6 addresses, and IPv4 addresses when there is no IPv6 address.
+	DnsLookupFamilyAuto DnsLookupFamily = "Auto"
+	// DnsLookupFamilyAll resolves both IPv4 and IPv6 addresses.
+	DnsLookupFamilyAll DnsLookupFamily = "All"
+)
+
 // AwsBackend is the AWS backend configuration.
 type AwsBackend struct {
 	// Lambda configures the AWS lambda service.
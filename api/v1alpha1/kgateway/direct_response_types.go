@@ -32,6 +32,8 @@ type DirectResponseList struct {
 }
 
 // DirectResponseSpec describes the desired state of a DirectResponse.
+//
+// +kubebuilder:validation:AtMostOneOf=body;bodyRef
 type DirectResponseSpec struct {
 	// StatusCode defines the HTTP status code to return for this route.
 	//
@@ -47,6 +49,34 @@ type DirectResponseSpec struct {
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:MaxLength=4096
 	Body *string `json:"body,omitempty"`
+	// BodyRef references a ConfigMap key, in the same namespace as the DirectResponse,
+	// holding the content to be returned in the HTTP response body.
+	// Use it to return bodies larger than the limit of the inline body.
+	//
+	// +optional
+	BodyRef *DirectResponseBodyRef `json:"bodyRef,omitempty"`
+	// ContentType sets the content-type header of the response.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	ContentType *string `json:"contentType,omitempty"`
+}
+
+// DirectResponseBodyRef references a ConfigMap key holding the body of a DirectResponse.
+type DirectResponseBodyRef struct {
+	// Name is the name of the ConfigMap.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+	// Key is the key of the ConfigMap data holding the body.
+	//
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
 }
 
 // DirectResponseStatus defines the observed state of a DirectResponse.
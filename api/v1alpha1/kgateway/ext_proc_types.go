@@ -82,6 +82,11 @@ type ExtProcPolicy struct {
 	// +optional
 	ProcessingMode *ProcessingMode `json:"processingMode,omitempty"`
 
+	// FailOpen overrides the failOpen setting of the referenced ExtProc provider for the targeted routes.
+	// When true, requests are allowed upstream even if the ext proc service is unavailable.
+	// +optional
+	FailOpen *bool `json:"failOpen,omitempty"`
+
 	// Disable all external processing filters.
 	// Can be used to disable external processing policies applied at a higher level in the config hierarchy.
 	// +optional
@@ -94,6 +99,12 @@ type MetadataOptions struct {
 	// Forwarding defines the typed or untyped dynamic metadata namespaces to forward to the external processing server.
 	// +optional
 	Forwarding *MetadataNamespaces `json:"forwarding,omitempty"`
+
+	// Receiving defines the untyped dynamic metadata namespaces the external processing server is allowed to write.
+	// Metadata received in other namespaces is ignored. Receiving typed metadata is not supported.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="!has(self.typed)",message="receiving typed metadata is not supported"
+	Receiving *MetadataNamespaces `json:"receiving,omitempty"`
 }
 
 // MetadataNamespaces configures which metadata namespaces to use.
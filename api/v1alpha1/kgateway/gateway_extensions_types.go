@@ -85,15 +85,35 @@ type JWT struct {
 	ValidationMode *ValidationMode `json:"validationMode,omitempty"`
 
 	// Providers configures named JWT providers.
-	// If multiple providers are specified for a given JWT policy,
-	// the providers will be `OR`-ed together and will allow validation to any of the providers.
+	// If multiple providers are specified for a given JWT policy, they are combined
+	// according to ProviderRequirement: by default a JWT valid for any of the providers is required.
 	// +optional
 	// +listType=map
 	// +listMapKey=name
 	// +kubebuilder:validation:MaxItems=32
 	Providers []NamedJWTProvider `json:"providers,omitempty"`
+
+	// ProviderRequirement configures how the providers are combined when
+	// multiple providers are specified.
+	// If unset or empty, Any is used: a JWT valid for any of the providers is required.
+	// If set to All, a valid JWT is required for each of the providers, which
+	// is useful when each provider reads its JWT from a different token source.
+	// +kubebuilder:validation:Enum=Any;All
+	// +optional
+	ProviderRequirement *JWTProviderRequirement `json:"providerRequirement,omitempty"`
 }
 
+// JWTProviderRequirement configures how multiple JWT providers are combined.
+type JWTProviderRequirement string
+
+const (
+	// A JWT valid for any of the providers must be present.
+	// This is the default option.
+	JWTProviderRequirementAny JWTProviderRequirement = "Any"
+	// A valid JWT must be present for each of the providers.
+	JWTProviderRequirementAll JWTProviderRequirement = "All"
+)
+
 type ValidationMode string
 
 const (
@@ -193,6 +213,24 @@ type AuthorizationResponse struct {
 	// Common examples: ["x-current-user", "x-user-id", "x-auth-request-email"]
 	// +optional
 	HeadersToBackend []string `json:"headersToBackend,omitempty"`
+
+	// HeadersToAppendToBackend specifies which headers from the authorization response
+	// should be appended to the request to the upstream service when the request is authorized.
+	// Unlike HeadersToBackend, the values of existing request headers are kept.
+	// +optional
+	HeadersToAppendToBackend []string `json:"headersToAppendToBackend,omitempty"`
+
+	// HeadersToClient specifies which headers from the authorization response
+	// should be sent to the client when the request is denied.
+	// If not specified, all the headers of the authorization response are sent to the client.
+	// +optional
+	HeadersToClient []string `json:"headersToClient,omitempty"`
+
+	// HeadersToClientOnSuccess specifies which headers from the authorization response
+	// should be added to the response sent to the client when the request is authorized,
+	// e.g. ["set-cookie"].
+	// +optional
+	HeadersToClientOnSuccess []string `json:"headersToClientOnSuccess,omitempty"`
 }
 
 type ExtSvcRetryPolicy struct {
@@ -258,6 +296,14 @@ type RateLimitProvider struct {
 	// +kubebuilder:default="Off"
 	// +optional
 	XRateLimitHeaders XRateLimitHeadersStandard `json:"xRateLimitHeaders,omitempty"`
+
+	// LimitExceededStatusCode is the HTTP status code of the responses to requests limited by the rate limit service.
+	// It must be a standard HTTP status code. Defaults to 429 (Too Many Requests).
+	// See [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/ratelimit/v3/rate_limit.proto#envoy-v3-api-field-extensions-filters-http-ratelimit-v3-ratelimit-rate-limited-status) for more info.
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	LimitExceededStatusCode *int32 `json:"limitExceededStatusCode,omitempty"`
 }
 
 // XRateLimitHeadersStandard controls how XRateLimit headers will emitted.
@@ -146,6 +146,13 @@ type KubernetesProxyConfig struct {
 	// +optional
 	OmitDefaultSecurityContext *bool `json:"omitDefaultSecurityContext,omitempty"`
 
+	// Configuration for the names of the Kubernetes resources generated for
+	// the Gateway. By default, the Deployment, Service, ServiceAccount and
+	// ConfigMap are named after the Gateway.
+	//
+	// +optional
+	ResourceNaming *ResourceNaming `json:"resourceNaming,omitempty"`
+
 	GatewayParametersOverlays `json:",inline"`
 }
 
@@ -212,6 +219,51 @@ func (in *KubernetesProxyConfig) GetOmitDefaultSecurityContext() *bool {
 	return in.OmitDefaultSecurityContext
 }
 
+func (in *KubernetesProxyConfig) GetResourceNaming() *ResourceNaming {
+	if in == nil {
+		return nil
+	}
+	return in.ResourceNaming
+}
+
+// ResourceNaming configures how the names of the Kubernetes resources
+// generated for a Gateway are derived from the Gateway name. The generated
+// name is `<prefix><gateway name><suffix>`. Names longer than 63 characters
+// are truncated and suffixed with a stable hash of the full name.
+//
+// Changing the generated name of an existing Gateway creates the resources
+// under the new name; the resources previously generated for the Gateway are
+// then deleted.
+type ResourceNaming struct {
+	// Prefix prepended to the Gateway name.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*)?$`
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Suffix appended to the Gateway name.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^([-a-z0-9]*[a-z0-9])?$`
+	Suffix *string `json:"suffix,omitempty"`
+}
+
+func (in *ResourceNaming) GetPrefix() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Prefix
+}
+
+func (in *ResourceNaming) GetSuffix() *string {
+	if in == nil {
+		return nil
+	}
+	return in.Suffix
+}
+
 // ProxyDeployment configures the Proxy deployment in Kubernetes.
 type ProxyDeployment struct {
 	// The number of desired pods.
@@ -22,7 +22,8 @@ type JWTAuth struct {
 }
 
 // JWTProvider configures the JWT Provider
-// If multiple providers are specified for a given JWT policy, the providers will be `OR`-ed together and will allow validation to any of the providers.
+// If multiple providers are specified for a given JWT policy, they are combined according to the
+// ProviderRequirement of the JWT configuration.
 type JWTProvider struct {
 	// Issuer of the JWT. the 'iss' claim of the JWT must match this.
 	// +kubebuilder:validation:MaxLength=2048
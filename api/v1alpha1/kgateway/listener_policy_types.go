@@ -1,6 +1,7 @@
 package kgateway
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -108,16 +109,31 @@ type ListenerConfig struct {
 	// +kubebuilder:validation:Minimum=0
 	PerConnectionBufferLimitBytes *int32 `json:"perConnectionBufferLimitBytes,omitempty"`
 
+	// MaxConnections limits the number of concurrent downstream connections, to protect the gateway from
+	// connection exhaustion. Connections over the limit are closed right after being accepted.
+	// The limit applies to each filter chain of a listener, i.e. to each hostname of HTTPS and TLS listeners.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/network_filters/connection_limit_filter
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+
 	// HTTPListenerPolicy is intended to be used for configuring the Envoy `HttpConnectionManager` and any other config or policy
 	// that should map 1-to-1 with a given HTTP listener, such as the Envoy health check HTTP filter.
 	// +optional
 	HTTPSettings *HTTPSettings `json:"httpSettings,omitempty"`
+
+	// TCPSettings is intended to be used for configuring the Envoy `TcpProxy` of the TCP and TLS passthrough
+	// filter chains of a listener, i.e. the ones serving TCPRoutes and TLSRoutes.
+	// +optional
+	TCPSettings *TCPSettings `json:"tcpSettings,omitempty"`
 }
 
 // ProxyProtocolConfig configures the PROXY protocol listener filter.
 // The presence of this configuration enables PROXY protocol support.
 type ProxyProtocolConfig struct {
 }
+
+// +kubebuilder:validation:XValidation:rule="!has(self.clientIPHeader) || (!has(self.useRemoteAddress) && !has(self.xffNumTrustedHops))",message="clientIPHeader cannot be combined with useRemoteAddress or xffNumTrustedHops"
 type HTTPSettings struct {
 	// AccessLoggingConfig contains various settings for Envoy's access logging service.
 	// See here for more information: https://www.envoyproxy.io/docs/envoy/v1.33.0/api-v3/config/accesslog/v3/accesslog.proto
@@ -162,6 +178,20 @@ type HTTPSettings struct {
 	// +optional
 	XffNumTrustedHops *int32 `json:"xffNumTrustedHops,omitempty"`
 
+	// SkipXffAppend disables appending the address of the downstream connection to the X-Forwarded-For header.
+	// By default, Envoy appends it, so that the header can be trusted by the next hops.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-skip-xff-append
+	// +optional
+	SkipXffAppend *bool `json:"skipXffAppend,omitempty"`
+
+	// ClientIPHeader detects the original client IP address from a request header set by a trusted proxy in
+	// front of the gateway, e.g. CF-Connecting-IP or True-Client-IP, instead of the X-Forwarded-For header.
+	// The detected address is used by the IP-based policies, such as rate limiting and RBAC.
+	// It cannot be combined with useRemoteAddress or xffNumTrustedHops, as Envoy does not support them together.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/http/original_ip_detection/custom_header/v3/custom_header.proto
+	// +optional
+	ClientIPHeader *ClientIPHeader `json:"clientIPHeader,omitempty"`
+
 	// ServerHeaderTransformation determines how the server header is transformed.
 	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-server-header-transformation
 	// +kubebuilder:validation:Enum=Overwrite;AppendIfAbsent;PassThrough
@@ -189,6 +219,13 @@ type HTTPSettings struct {
 	// +optional
 	PreserveHttp1HeaderCase *bool `json:"preserveHttp1HeaderCase,omitempty"`
 
+	// ProperCaseHttp1Headers determines whether to format the keys of HTTP1 headers in proper case, e.g. content-type
+	// is sent as Content-Type, for legacy clients that do not handle lowercased headers.
+	// Ignored if preserveHttp1HeaderCase is true.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/header_casing
+	// +optional
+	ProperCaseHttp1Headers *bool `json:"properCaseHttp1Headers,omitempty"`
+
 	// AcceptHTTP10 determines whether to accept incoming HTTP/1.0 and HTTP 0.9 requests.
 	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#config-core-v3-http1protocoloptions
 	// +optional
@@ -215,10 +252,104 @@ type HTTPSettings struct {
 	// +kubebuilder:validation:Maximum=8192
 	MaxRequestHeadersKb *int32 `json:"maxRequestHeadersKb,omitempty"`
 
+	// MaxRequestHeadersCount sets the maximum number of request headers that Envoy will accept.
+	// If unset, the Envoy default is 100.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-field-config-core-v3-httpprotocoloptions-max-headers-count
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestHeadersCount *int32 `json:"maxRequestHeadersCount,omitempty"`
+
+	// MaxRequestsPerConnection sets the maximum number of requests served over a single downstream connection,
+	// after which the connection is drained and closed. If unset, the number of requests is not limited.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/core/v3/protocol.proto#envoy-v3-api-field-config-core-v3-httpprotocoloptions-max-requests-per-connection
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestsPerConnection *int32 `json:"maxRequestsPerConnection,omitempty"`
+
+	// InvalidRequestAction defines how requests exceeding the header limits, or otherwise invalid, are rejected.
+	// If unset, defaults to CloseConnection.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/http_connection_manager/v3/http_connection_manager.proto#envoy-v3-api-field-extensions-filters-network-http-connection-manager-v3-httpconnectionmanager-stream-error-on-invalid-http-message
+	// +optional
+	InvalidRequestAction *InvalidRequestAction `json:"invalidRequestAction,omitempty"`
+
 	// UuidRequestIdConfig configures the behavior of the UUID request ID extension.
 	// This extension sets the x-request-id header to a UUID value.
 	// +optional
 	UuidRequestIdConfig *UuidRequestIdConfig `json:"uuidRequestIdConfig,omitempty"`
+
+	// ClientCertDetails configures how the details of the client certificates of mTLS connections are
+	// forwarded to the backends in the x-forwarded-client-cert (XFCC) header.
+	// If unset, the XFCC header is removed from the requests and not set.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/headers#x-forwarded-client-cert
+	// +optional
+	ClientCertDetails *ClientCertDetails `json:"clientCertDetails,omitempty"`
+
+	// OutageDrill simulates the unavailability of external services for a window of time, so that the
+	// failOpen/failClosed behavior of the filters calling them and the related alerting can be verified
+	// before a real outage.
+	// +optional
+	OutageDrill *OutageDrill `json:"outageDrill,omitempty"`
+
+	// LocalReply customizes the responses generated by the gateway itself, such as when no route matches
+	// the request, no healthy upstream is available or the request is denied by the external authorization
+	// service, so that the clients receive consistent, branded error responses.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_conn_man/local_reply
+	// +optional
+	LocalReply *LocalReply `json:"localReply,omitempty"`
+}
+
+type TCPSettings struct {
+	// AccessLog configures connection-level access logging for TCP and TLS passthrough connections.
+	// An entry is logged when a connection is closed, and optionally when it is established or periodically
+	// while it is open; see AccessLogOptions. The %ACCESS_LOG_TYPE% command operator tells these entries apart.
+	// File sinks without a format use a default format with the connection duration, bytes transferred and SNI.
+	// HTTP-specific settings, such as the additional headers of a grpcService or the status code, header and
+	// gRPC status filters, do not apply to TCP connections.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/tcp_proxy/v3/tcp_proxy.proto#envoy-v3-api-field-extensions-filters-network-tcp-proxy-v3-tcpproxy-access-log
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	AccessLog []AccessLog `json:"accessLog,omitempty"`
+
+	// AccessLogOptions configures when connection-level access log entries are emitted.
+	// +optional
+	AccessLogOptions *TCPAccessLogOptions `json:"accessLogOptions,omitempty"`
+
+	// IdleTimeout closes the connections with no bytes sent or received for this duration.
+	// If unspecified, Envoy's default of 1h is applied. A value of 0s disables the idle timeout.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/tcp_proxy/v3/tcp_proxy.proto#envoy-v3-api-field-extensions-filters-network-tcp-proxy-v3-tcpproxy-idle-timeout
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	IdleTimeout *metav1.Duration `json:"idleTimeout,omitempty"`
+
+	// MaxConnectionDuration closes the connections once they have been open for this duration, regardless of
+	// their activity. Must be at least 1ms.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/network/tcp_proxy/v3/tcp_proxy.proto#envoy-v3-api-field-extensions-filters-network-tcp-proxy-v3-tcpproxy-max-downstream-connection-duration
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="maxConnectionDuration must be at least 1ms"
+	MaxConnectionDuration *metav1.Duration `json:"maxConnectionDuration,omitempty"`
+
+	// ConnectionRateLimit limits the rate at which new connections are accepted, using a token bucket
+	// shared by the TCP and TLS passthrough filter chains of the listener. Each connection consumes a
+	// token, and connections accepted when the bucket is empty are closed immediately.
+	// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/listeners/network_filters/local_rate_limit_filter
+	// +optional
+	ConnectionRateLimit *TokenBucket `json:"connectionRateLimit,omitempty"`
+}
+
+// TCPAccessLogOptions configures when connection-level access log entries are emitted, in addition to the
+// entry logged when the connection is closed.
+type TCPAccessLogOptions struct {
+	// FlushOnConnected logs an entry when the upstream connection is established.
+	// +optional
+	FlushOnConnected *bool `json:"flushOnConnected,omitempty"`
+
+	// FlushInterval logs an entry periodically while the connection is open, which is useful for long-lived
+	// connections. Must be at least 1ms.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="flushInterval must be at least 1ms"
+	FlushInterval *metav1.Duration `json:"flushInterval,omitempty"`
 }
 
 // AccessLog represents the top-level access log configuration.
@@ -621,6 +752,10 @@ type CustomAttribute struct {
 	// An attribute to obtain the value from the metadata.
 	// +optional
 	Metadata *CustomAttributeMetadata `json:"metadata,omitempty"`
+
+	// An attribute to obtain the value from a CEL expression evaluated on the request.
+	// +optional
+	CEL *CustomAttributeCEL `json:"cel,omitempty"`
 }
 
 // Literal type attribute with a static value.
@@ -673,6 +808,16 @@ type CustomAttributeMetadata struct {
 	DefaultValue *string `json:"defaultValue,omitempty"`
 }
 
+// CEL type attribute with the expression to evaluate.
+// Ref: https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-cel
+type CustomAttributeCEL struct {
+	// CEL expression to evaluate to populate the attribute value, e.g. `request.headers['x-tenant-id']`.
+	// When the expression cannot be evaluated, the attribute value is populated with "-".
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Expression string `json:"expression"`
+}
+
 // Describes different types of metadata sources.
 // Ref: https://www.envoyproxy.io/docs/envoy/latest/api-v3/type/metadata/v3/metadata.proto#envoy-v3-api-msg-type-metadata-v3-metadatakind-request
 // +kubebuilder:validation:Enum=Request;Route;Cluster;Host
@@ -715,14 +860,29 @@ type TracingProvider struct {
 	// Tracing contains various settings for Envoy's OTel tracer.
 	// +optional
 	OpenTelemetry *OpenTelemetryTracingConfig `json:"openTelemetry,omitempty"`
+
+	// Zipkin contains the settings for Envoy's Zipkin tracer.
+	// +optional
+	Zipkin *ZipkinTracingConfig `json:"zipkin,omitempty"`
+
+	// Datadog contains the settings for Envoy's Datadog tracer.
+	// +optional
+	Datadog *DatadogTracingConfig `json:"datadog,omitempty"`
 }
 
 // OpenTelemetryTracingConfig represents the top-level Envoy's OpenTelemetry tracer.
 // See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/trace/v3/opentelemetry.proto.html
+// +kubebuilder:validation:ExactlyOneOf=grpcService;httpService
 type OpenTelemetryTracingConfig struct {
-	// Send traces to the gRPC service
-	// +required
-	GrpcService CommonGrpcService `json:"grpcService"`
+	// Send traces to the gRPC service using OTLP/gRPC.
+	// Mutually exclusive with HttpService.
+	// +optional
+	GrpcService *CommonGrpcService `json:"grpcService,omitempty"`
+
+	// Send traces to the HTTP service using OTLP/HTTP.
+	// Mutually exclusive with GrpcService.
+	// +optional
+	HttpService *OpenTelemetryHttpService `json:"httpService,omitempty"`
 
 	// The name for the service. This will be populated in the ResourceSpan Resource attributes
 	// Defaults to the envoy cluster name. Ie: `<gateway-name>.<gateway-namespace>`
@@ -739,6 +899,81 @@ type OpenTelemetryTracingConfig struct {
 	Sampler *Sampler `json:"sampler,omitempty"`
 }
 
+// OpenTelemetryHttpService is the OTLP/HTTP collector traces are sent to.
+type OpenTelemetryHttpService struct {
+	// The backend of the collector. Can be any type of supported backend (Kubernetes Service, kgateway Backend, etc..)
+	// +required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// Path is the path of the OTLP/HTTP traces endpoint. Defaults to /v1/traces.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/[^?#]*$`
+	// +kubebuilder:validation:MaxLength=1024
+	Path *string `json:"path,omitempty"`
+
+	// Timeout is the timeout of the requests exporting the traces. Defaults to 10s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Headers added to the requests exporting the traces, e.g. to authenticate with the collector.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	RequestHeaders []HeaderValue `json:"requestHeaders,omitempty"`
+}
+
+// ZipkinTracingConfig represents Envoy's Zipkin tracer.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/trace/v3/zipkin.proto
+type ZipkinTracingConfig struct {
+	// The backend of the Zipkin collector. Can be any type of supported backend (Kubernetes Service, kgateway Backend, etc..)
+	// +required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// CollectorEndpoint is the path of the collector API spans are sent to. Defaults to /api/v2/spans.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^/[^?#]*$`
+	// +kubebuilder:validation:MaxLength=1024
+	CollectorEndpoint *string `json:"collectorEndpoint,omitempty"`
+
+	// Encoding is the encoding of the spans sent to the collector. Defaults to JSON.
+	// +optional
+	Encoding *ZipkinEncoding `json:"encoding,omitempty"`
+
+	// TraceID128Bit generates 128-bit trace IDs instead of 64-bit ones. Defaults to false.
+	// +optional
+	TraceID128Bit *bool `json:"traceId128Bit,omitempty"`
+
+	// SharedSpanContext determines whether the client and server spans share the same span context.
+	// Defaults to true.
+	// +optional
+	SharedSpanContext *bool `json:"sharedSpanContext,omitempty"`
+}
+
+// ZipkinEncoding is the encoding of the spans sent to a Zipkin collector.
+// +kubebuilder:validation:Enum=JSON;Proto
+type ZipkinEncoding string
+
+const (
+	// ZipkinEncodingJSON sends the spans as JSON, using the Zipkin v2 API.
+	ZipkinEncodingJSON ZipkinEncoding = "JSON"
+	// ZipkinEncodingProto sends the spans as protobuf, using the Zipkin v2 API.
+	ZipkinEncodingProto ZipkinEncoding = "Proto"
+)
+
+// DatadogTracingConfig represents Envoy's Datadog tracer.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/trace/v3/datadog.proto
+type DatadogTracingConfig struct {
+	// The backend of the Datadog agent. Can be any type of supported backend (Kubernetes Service, kgateway Backend, etc..)
+	// +required
+	BackendRef gwv1.BackendRef `json:"backendRef"`
+
+	// The name for the service.
+	// Defaults to the envoy cluster name. Ie: `<gateway-name>.<gateway-namespace>`
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	ServiceName *string `json:"serviceName,omitempty"`
+}
+
 // ResourceDetector defines the list of supported ResourceDetectors
 // +kubebuilder:validation:MaxProperties=1
 // +kubebuilder:validation:MinProperties=1
@@ -805,6 +1040,80 @@ const (
 	PassThroughServerHeaderTransformation ServerHeaderTransformation = "PassThrough"
 )
 
+// InvalidRequestAction defines how invalid requests are rejected.
+// +kubebuilder:validation:Enum=CloseConnection;ResetStream
+type InvalidRequestAction string
+
+const (
+	// CloseConnectionInvalidRequestAction responds with an error and closes the HTTP/1.1 connection, or the whole
+	// HTTP/2 connection.
+	CloseConnectionInvalidRequestAction InvalidRequestAction = "CloseConnection"
+	// ResetStreamInvalidRequestAction only rejects the invalid request, leaving the connection open where possible.
+	// For HTTP/2, only the stream of the request is reset.
+	ResetStreamInvalidRequestAction InvalidRequestAction = "ResetStream"
+)
+
+// ClientIPHeader configures the detection of the original client IP address from a request header.
+type ClientIPHeader struct {
+	// Name is the name of the header holding the client IP address.
+	// +required
+	Name gwv1.HeaderName `json:"name"`
+
+	// Trusted marks the detected address as trusted, i.e. internal, as Envoy does for the addresses detected
+	// from the X-Forwarded-For header when useRemoteAddress is false. This affects the sanitization of the
+	// x-envoy-* headers and the internal_only_headers of the routes. Defaults to false.
+	// +optional
+	Trusted *bool `json:"trusted,omitempty"`
+}
+
+// ClientCertDetails configures the x-forwarded-client-cert (XFCC) header.
+// +kubebuilder:validation:XValidation:rule="self.forward == 'AppendForward' || self.forward == 'SanitizeSet' || !(has(self.subject) || has(self.cert) || has(self.chain) || has(self.dns) || has(self.uri))",message="the client certificate details can only be set when forward is AppendForward or SanitizeSet"
+type ClientCertDetails struct {
+	// Forward defines how the XFCC header of the requests is handled.
+	// +required
+	Forward ForwardClientCertDetails `json:"forward"`
+
+	// Subject adds the subject of the client certificate to the XFCC header.
+	// +optional
+	Subject *bool `json:"subject,omitempty"`
+
+	// Cert adds the URL encoded PEM client certificate to the XFCC header.
+	// +optional
+	Cert *bool `json:"cert,omitempty"`
+
+	// Chain adds the URL encoded PEM client certificate chain, including the client certificate, to the XFCC header.
+	// +optional
+	Chain *bool `json:"chain,omitempty"`
+
+	// DNS adds the DNS SANs of the client certificate to the XFCC header.
+	// +optional
+	DNS *bool `json:"dns,omitempty"`
+
+	// URI adds the URI SANs of the client certificate to the XFCC header.
+	// +optional
+	URI *bool `json:"uri,omitempty"`
+}
+
+// ForwardClientCertDetails defines how the x-forwarded-client-cert header of the requests is handled.
+// +kubebuilder:validation:Enum=Sanitize;ForwardOnly;AppendForward;SanitizeSet;AlwaysForwardOnly
+type ForwardClientCertDetails string
+
+const (
+	// SanitizeForwardClientCertDetails removes the XFCC header from the requests.
+	SanitizeForwardClientCertDetails ForwardClientCertDetails = "Sanitize"
+	// ForwardOnlyForwardClientCertDetails forwards the XFCC header of the requests received over mTLS.
+	ForwardOnlyForwardClientCertDetails ForwardClientCertDetails = "ForwardOnly"
+	// AppendForwardForwardClientCertDetails appends the details of the client certificate to the XFCC header
+	// of the requests received over mTLS.
+	AppendForwardForwardClientCertDetails ForwardClientCertDetails = "AppendForward"
+	// SanitizeSetForwardClientCertDetails replaces the XFCC header of the requests received over mTLS with the
+	// details of the client certificate.
+	SanitizeSetForwardClientCertDetails ForwardClientCertDetails = "SanitizeSet"
+	// AlwaysForwardOnlyForwardClientCertDetails always forwards the XFCC header of the requests, whether they
+	// are received over mTLS or not.
+	AlwaysForwardOnlyForwardClientCertDetails ForwardClientCertDetails = "AlwaysForwardOnly"
+)
+
 // EnvoyHealthCheck represents configuration for Envoy's health check filter.
 // The filter will be configured in No pass through mode, and will only match requests with the specified path.
 type EnvoyHealthCheck struct {
@@ -830,3 +1139,112 @@ type UuidRequestIdConfig struct {
 	// +optional
 	UseRequestIDForTraceSampling *bool `json:"useRequestIdForTraceSampling,omitempty"`
 }
+
+// OutageDrill simulates the unavailability of external services of the gateway for a window of time.
+// While the drill is active, the filters calling the services are pointed at a cluster without endpoints,
+// so that every call fails as it would during an outage, and the failure mode of each filter applies.
+type OutageDrill struct {
+	// Services are the external services to simulate the unavailability of.
+	// +required
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	Services []OutageDrillService `json:"services"`
+
+	// StartTime is the time at which the drill starts.
+	// +required
+	StartTime metav1.Time `json:"startTime"`
+
+	// Duration is the length of the drill. The maximum is 24h.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) <= duration('24h')",message="duration must not exceed 24h"
+	Duration metav1.Duration `json:"duration"`
+}
+
+// OutageDrillService is an external service whose unavailability can be simulated.
+// +kubebuilder:validation:Enum=ExtAuth;ExtProc;RateLimit
+type OutageDrillService string
+
+const (
+	// OutageDrillServiceExtAuth is the external authorization service.
+	OutageDrillServiceExtAuth OutageDrillService = "ExtAuth"
+	// OutageDrillServiceExtProc is the external processing service.
+	OutageDrillServiceExtProc OutageDrillService = "ExtProc"
+	// OutageDrillServiceRateLimit is the global rate limit service.
+	OutageDrillServiceRateLimit OutageDrillService = "RateLimit"
+)
+
+// LocalReply customizes the responses generated by the gateway itself.
+type LocalReply struct {
+	// Mappers rewrite the local replies they match. They are evaluated in order, and only the first
+	// matching mapper is applied.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Mappers []LocalReplyMapper `json:"mappers"`
+}
+
+// LocalReplyMapper rewrites the status code and body of the local replies it matches.
+// +kubebuilder:validation:XValidation:rule="has(self.statusCode) || has(self.body)",message="at least one of statusCode or body must be set"
+type LocalReplyMapper struct {
+	// Match selects the local replies to rewrite.
+	// +required
+	Match LocalReplyMatch `json:"match"`
+
+	// StatusCode replaces the status code of the reply.
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+
+	// Body replaces the body of the reply.
+	// +optional
+	Body *LocalReplyBody `json:"body,omitempty"`
+}
+
+// LocalReplyMatch selects local replies. A reply matches when it matches all the fields that are set.
+// +kubebuilder:validation:XValidation:rule="has(self.statusCodes) || has(self.responseFlags)",message="at least one of statusCodes or responseFlags must be set"
+type LocalReplyMatch struct {
+	// StatusCodes matches the replies with one of the status codes.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Minimum=100
+	// +kubebuilder:validation:items:Maximum=599
+	StatusCodes []int32 `json:"statusCodes,omitempty"`
+
+	// ResponseFlags matches the replies with one of the Envoy response flags, e.g. NR when no route
+	// matches the request, UH when no healthy upstream is available or UAEX when the request is denied by
+	// the external authorization service.
+	// See here for the list of flags: https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#config-access-log-format-response-flags
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ResponseFlags []string `json:"responseFlags,omitempty"`
+}
+
+// LocalReplyBody is the body of a rewritten local reply.
+// The body is an Envoy format string: the command operators it contains, such as %RESPONSE_CODE%,
+// %RESPONSE_CODE_DETAILS%, %REQ(:path)% or %LOCAL_REPLY_BODY%, are substituted when the reply is sent.
+// See here for more information: https://www.envoyproxy.io/docs/envoy/latest/configuration/observability/access_log/usage#command-operators
+// +kubebuilder:validation:ExactlyOneOf=inline;configMapRef
+type LocalReplyBody struct {
+	// Inline is the body.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=16384
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef references a ConfigMap holding the body, in the same namespace as the policy.
+	// The ConfigMap must have a data key named 'body' that contains the body.
+	// +optional
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+
+	// ContentType is the content type of the body. Defaults to text/plain.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	ContentType *string `json:"contentType,omitempty"`
+}
@@ -0,0 +1,31 @@
+package kgateway
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// Lua runs a Lua script on the requests and responses of the targeted resources.
+// The script must define an envoy_on_request function, an envoy_on_response function, or both.
+// See https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/lua_filter for the API
+// available to scripts.
+//
+// +kubebuilder:validation:ExactlyOneOf=inline;configMapRef;disable
+type Lua struct {
+	// Inline is the Lua script.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=65536
+	Inline *string `json:"inline,omitempty"`
+
+	// ConfigMapRef references a ConfigMap holding the Lua script, in the same namespace as the TrafficPolicy.
+	// The ConfigMap must have a data key named 'lua' that contains the script.
+	// +optional
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+
+	// Disable the Lua script.
+	// Can be used to disable Lua policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
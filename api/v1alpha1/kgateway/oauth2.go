@@ -2,6 +2,7 @@ package kgateway
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
@@ -102,6 +103,10 @@ type OAuth2Provider struct {
 	// +optional
 	Cookies *OAuth2CookieConfig `json:"cookies,omitempty"`
 
+	// Session specifies the configuration for the user session established after a successful login.
+	// +optional
+	Session *OAuth2SessionConfig `json:"session,omitempty"`
+
 	// DenyRedirectMatcher specifies the matcher to match requests that should be denied redirects to the authorization endpoint.
 	// Matching requests will receive a 401 Unauthorized response instead of being redirected.
 	// This is useful for AJAX requests where redirects should be avoided.
@@ -150,6 +155,32 @@ type OAuth2CookieConfig struct {
 	DisableRefreshTokenSetCookie *bool `json:"disableRefreshTokenSetCookie,omitempty"`
 }
 
+// OAuth2SessionConfig specifies the configuration for the user session.
+type OAuth2SessionConfig struct {
+	// UseRefreshToken specifies whether to use the refresh token, if returned by the token endpoint,
+	// to obtain a new access token when it expires instead of redirecting the user to the authorization endpoint.
+	// Defaults to true.
+	// Refer to https://datatracker.ietf.org/doc/html/rfc6749#section-6 for more details.
+	// +optional
+	UseRefreshToken *bool `json:"useRefreshToken,omitempty"`
+
+	// AccessTokenExpiry specifies the lifetime of the access token cookie when the token endpoint does not
+	// return an expires_in value.
+	// Defaults to 0s, in which case the user must log in again on every request once the access token expires.
+	// +optional
+	//
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	AccessTokenExpiry *metav1.Duration `json:"accessTokenExpiry,omitempty"`
+
+	// RefreshTokenExpiry specifies the lifetime of the refresh token cookie when the refresh token is not a JWT
+	// with an exp claim.
+	// Defaults to 7 days.
+	// +optional
+	//
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	RefreshTokenExpiry *metav1.Duration `json:"refreshTokenExpiry,omitempty"`
+}
+
 // OAuth2Credentials specifies the Oauth2 client credentials.
 type OAuth2Credentials struct {
 	// ClientID specifies the client ID issued to the client during the registration process.
@@ -13,6 +13,7 @@ type RetryOnCondition string
 // Retry defines the retry policy
 //
 // +kubebuilder:validation:XValidation:rule="has(self.retryOn) || has(self.statusCodes)",message="retryOn or statusCodes must be set."
+// +kubebuilder:validation:XValidation:rule="!has(self.backoffMaxInterval) || duration(self.backoffMaxInterval) >= (has(self.backoffBaseInterval) ? duration(self.backoffBaseInterval) : duration('25ms'))",message="retry.backoffMaxInterval must be greater than or equal to retry.backoffBaseInterval, which defaults to 25ms."
 type Retry struct {
 	// RetryOn specifies the conditions under which a retry should be attempted.
 	// +optional
@@ -49,7 +50,7 @@ type Retry struct {
 	// BackoffBaseInterval specifies the base interval used with a fully jittered exponential back-off between retries.
 	// Defaults to 25ms if not set.
 	// Given a backoff base interval B and retry number N, the back-off for the retry is in the range [0, (2^N-1)*B].
-	// The backoff interval is capped at a max of 10 times the base interval.
+	// The backoff interval is capped at a max of 10 times the base interval, unless BackoffMaxInterval is set.
 	// E.g., given a value of 25ms, the first retry will be delayed randomly by 0-24ms, the 2nd by 0-74ms,
 	// the 3rd by 0-174ms, and so on, and capped to a max of 10 times the base interval (250ms).
 	// +optional
@@ -58,4 +59,13 @@ type Retry struct {
 	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
 	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="retry.backoffBaseInterval must be at least 1ms."
 	BackoffBaseInterval *metav1.Duration `json:"backoffBaseInterval,omitempty"`
+
+	// BackoffMaxInterval specifies the maximum interval between retries.
+	// Defaults to 10 times the BackoffBaseInterval if not set.
+	// Must not be less than the BackoffBaseInterval, including its 25ms default.
+	// +optional
+	//
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1ms')",message="retry.backoffMaxInterval must be at least 1ms."
+	BackoffMaxInterval *metav1.Duration `json:"backoffMaxInterval,omitempty"`
 }
@@ -40,24 +40,42 @@ type TrafficPolicyList struct {
 }
 
 // TrafficPolicySpec defines the desired state of a traffic policy.
+// +kubebuilder:validation:AtMostOneOf=autoHostRewrite;hostRewrite;hostRewriteHeader
 // +kubebuilder:validation:XValidation:rule="!has(self.autoHostRewrite) || ((has(self.targetRefs) && self.targetRefs.all(r, r.kind == 'HTTPRoute')) || (has(self.targetSelectors) && self.targetSelectors.all(r, r.kind == 'HTTPRoute')))",message="autoHostRewrite can only be used when targeting HTTPRoute resources"
 // +kubebuilder:validation:XValidation:rule="has(self.retry) && has(self.timeouts) ? (has(self.retry.perTryTimeout) && has(self.timeouts.request) ? duration(self.retry.perTryTimeout) < duration(self.timeouts.request) : true) : true",message="retry.perTryTimeout must be less than timeouts.request"
 // +kubebuilder:validation:XValidation:rule="has(self.retry) && has(self.targetRefs) ? self.targetRefs.all(r, (r.kind == 'Gateway' ? has(r.sectionName) : true )) : true",message="targetRefs[].sectionName must be set when targeting Gateway resources with retry policy"
 // +kubebuilder:validation:XValidation:rule="has(self.retry) && has(self.targetSelectors) ? self.targetSelectors.all(r, (r.kind == 'Gateway' ? has(r.sectionName) : true )) : true",message="targetSelectors[].sectionName must be set when targeting Gateway resources with retry policy"
+// +kubebuilder:validation:XValidation:rule="!has(self.authComposition) || (has(self.authComposition.anyOf) ? self.authComposition.anyOf : self.authComposition.allOf).all(m, m == 'JWT' ? has(self.jwtAuth) : (m == 'APIKey' ? has(self.apiKeyAuth) : has(self.basicAuth)))",message="authComposition may only list the authentication mechanisms configured in the policy"
 type TrafficPolicySpec struct {
 	// TargetRefs specifies the target resources by reference to attach the policy to.
+	// Only the timeouts apply to TCPRoute and TLSRoute targets; the other fields set in a policy
+	// targeting them are reported with the UnsupportedField reason in the policy status.
 	// +optional
 	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
-	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind.endsWith('ListenerSet')))",message="targetRefs may only reference Gateway, HTTPRoute, or ListenerSet resources"
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind == 'GRPCRoute' || r.kind == 'TCPRoute' || r.kind == 'TLSRoute' || r.kind.endsWith('ListenerSet')))",message="targetRefs may only reference Gateway, HTTPRoute, GRPCRoute, TCPRoute, TLSRoute, or ListenerSet resources"
 	TargetRefs []shared.LocalPolicyTargetReferenceWithSectionName `json:"targetRefs,omitempty"`
 
 	// TargetSelectors specifies the target selectors to select resources to attach the policy to.
 	// +optional
-	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind.endsWith('ListenerSet')))",message="targetSelectors may only reference Gateway, HTTPRoute, or ListenerSet resources"
+	// +kubebuilder:validation:XValidation:rule="self.all(r, (r.kind == 'Gateway' || r.kind == 'HTTPRoute' || r.kind == 'GRPCRoute' || r.kind == 'TCPRoute' || r.kind == 'TLSRoute' || r.kind.endsWith('ListenerSet')))",message="targetSelectors may only reference Gateway, HTTPRoute, GRPCRoute, TCPRoute, TLSRoute, or ListenerSet resources"
 	TargetSelectors []shared.LocalPolicyTargetSelectorWithSectionName `json:"targetSelectors,omitempty"`
 
+	// ExpiresAt is the time after which the policy is automatically deactivated and
+	// no longer attached to its targets, e.g. for a temporary allowlist or bypass.
+	// As the expiry approaches, the Accepted condition of the policy reports the
+	// Expiring reason; once expired, the policy reports the Expired reason.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Priority of the policy when it is merged with the other TrafficPolicies attached to the same resource.
+	// Policies with a higher priority are preferred in a merge conflict. Policies with the same priority are
+	// ordered by creation time, oldest first, and then alphabetically by namespace and name.
+	// Takes precedence over the kgateway.dev/policy-weight annotation. Defaults to 0.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
+
 	// Transformation is used to mutate and transform requests and responses
 	// before forwarding them to the destination.
 	// +optional
@@ -96,14 +114,74 @@ type TrafficPolicySpec struct {
 	// +optional
 	AutoHostRewrite *bool `json:"autoHostRewrite,omitempty"`
 
+	// HostRewrite rewrites the Host header to the given value.
+	// NOTE: If `hostRewrite` is set on a route that also has a [URLRewrite filter](https://gateway-api.sigs.k8s.io/reference/spec/#httpurlrewritefilter)
+	// configured to override the `hostname`, the `hostname` value will be used and `hostRewrite` will be ignored.
+	// +optional
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	HostRewrite *string `json:"hostRewrite,omitempty"`
+
+	// HostRewriteHeader rewrites the Host header to the value of the given request header.
+	// The Host header is not rewritten when the request does not have this header.
+	// NOTE: If `hostRewriteHeader` is set on a route that also has a [URLRewrite filter](https://gateway-api.sigs.k8s.io/reference/spec/#httpurlrewritefilter)
+	// configured to override the `hostname`, the `hostname` value will be used and `hostRewriteHeader` will be ignored.
+	// +optional
+	HostRewriteHeader *gwv1.HeaderName `json:"hostRewriteHeader,omitempty"`
+
 	// Buffer can be used to set the maximum request size that will be buffered.
 	// Requests exceeding this size will return a 413 response.
 	// +optional
 	Buffer *Buffer `json:"buffer,omitempty"`
 
-	// Timeouts defines the timeouts for requests
+	// FaultInjection injects delays and aborts into a percentage of requests before they are forwarded
+	// to the backend. It can be used to test the resilience of clients and backends.
+	// +optional
+	FaultInjection *FaultInjection `json:"faultInjection,omitempty"`
+
+	// Mirroring duplicates a percentage of requests to a secondary backend, for example to shadow
+	// production traffic to a new version of a service. Mirrored requests are fire-and-forget: the
+	// responses of the mirror backend are ignored.
 	// It is applicable to HTTPRoutes and ignored for other targeted kinds.
 	// +optional
+	Mirroring *RequestMirroring `json:"mirroring,omitempty"`
+
+	// Caching caches responses to GET requests in the gateway, to offload repeated requests from backends.
+	// Responses are cached according to their Cache-Control and Expires headers.
+	// The settings other than the ttl are shared by all the routes of a listener: policies with different settings
+	// attached to the same Gateway are reported with the Conflicted reason, and only one of them is applied.
+	// +optional
+	Caching *Caching `json:"caching,omitempty"`
+
+	// AdmissionControl rejects a share of the requests when their success rate drops, so that the gateway
+	// sheds load from failing backends instead of only relying on static circuit breakers.
+	// The success rate is computed over all the requests of a listener, not per route, and the settings are
+	// shared by all of its routes: policies with different settings attached to the same Gateway are reported
+	// with the Conflicted reason, and only one of them is applied.
+	// +optional
+	AdmissionControl *AdmissionControl `json:"admissionControl,omitempty"`
+
+	// Lua runs a Lua script on the request and response path, to cover needs not supported by the other fields.
+	// +optional
+	Lua *Lua `json:"lua,omitempty"`
+
+	// Wasm runs a WebAssembly module implementing the Proxy-Wasm ABI on the request and response path, to ship
+	// custom filters without rebuilding the gateway.
+	// +optional
+	Wasm *Wasm `json:"wasm,omitempty"`
+
+	// Tap captures the full requests and responses, including their bodies, for example to debug a route
+	// during an incident. Captures may contain sensitive data such as credentials.
+	// Tap must be enabled in the controller with the KGW_ENABLE_TAP setting; otherwise, policies setting it
+	// are rejected.
+	// +optional
+	Tap *Tap `json:"tap,omitempty"`
+
+	// Timeouts defines the timeouts for requests
+	// It is applicable to HTTPRoutes, GRPCRoutes, TCPRoutes and TLSRoutes, and ignored for other targeted kinds.
+	// On TCPRoutes and TLSRoutes, only StreamIdle and MaxStreamDuration apply, to the proxied connections.
+	// +optional
 	Timeouts *shared.Timeouts `json:"timeouts,omitempty"`
 
 	// Retry defines the policy for retrying requests.
@@ -116,9 +194,23 @@ type TrafficPolicySpec struct {
 	// RBAC policies applied at different attachment points in the configuration
 	// hierarchy are not cumulative, and only the most specific policy is enforced. This means an RBAC policy
 	// attached to a route will override any RBAC policies applied to the gateway or listener.
+	// An RBAC policy with the Audit action does not override an enforced policy: the enforced policy is
+	// still applied, and the Audit rule is evaluated alongside it.
 	// +optional
+	// +kubebuilder:validation:XValidation:rule="!has(self.policy.matchers) || self.policy.matchers.all(m, !has(m.mcp))",message="mcp matchers are only supported by the MCP authorization of backends"
 	RBAC *shared.Authorization `json:"rbac,omitempty"`
 
+	// RequestValidation rejects the requests that do not satisfy a CEL expression, for lightweight
+	// enforcement of the request contract without an external processing service.
+	// +optional
+	RequestValidation *RequestValidation `json:"requestValidation,omitempty"`
+
+	// BodyMatch restricts the targeted routes to the requests whose JSON body has a field of a given value,
+	// for APIs such as JSON-RPC where the method is carried in the payload rather than the path.
+	// It is applicable to HTTPRoutes and ignored for other targeted kinds.
+	// +optional
+	BodyMatch *BodyMatch `json:"bodyMatch,omitempty"`
+
 	// JWT specifies the JWT authentication configuration for the policy.
 	// This defines the JWT providers and their configurations.
 	// +optional
@@ -129,12 +221,23 @@ type TrafficPolicySpec struct {
 	// +optional
 	UrlRewrite *URLRewrite `json:"urlRewrite,omitempty"`
 
+	// Redirect redirects the requests instead of forwarding them to the backends.
+	// It is ignored on the routes that already redirect the requests with a RequestRedirect filter or
+	// respond to them directly.
+	// +optional
+	Redirect *shared.RequestRedirect `json:"redirect,omitempty"`
+
 	// Compression configures response compression (per-route) and request/response
 	// decompression (listener-level insertion triggered by route enable).
 	// The response compression configuration is only honored for HTTPRoute targets.
 	// +optional
 	Compression *Compression `json:"compression,omitempty"`
 
+	// GrpcWeb translates gRPC-Web requests to gRPC, so that browser clients can call gRPC backends through the gateway.
+	// The backends must be reachable over HTTP/2, e.g. with the `grpc` or `kubernetes.io/h2c` app protocol.
+	// +optional
+	GrpcWeb *GrpcWeb `json:"grpcWeb,omitempty"`
+
 	// BasicAuth specifies the HTTP basic authentication configuration for the policy.
 	// This controls authentication using username/password credentials in the Authorization header.
 	// +optional
@@ -144,6 +247,14 @@ type TrafficPolicySpec struct {
 	// +optional
 	APIKeyAuth *APIKeyAuth `json:"apiKeyAuth,omitempty"`
 
+	// AuthComposition combines the jwtAuth, apiKeyAuth and basicAuth mechanisms of this policy, for example to
+	// accept requests with either a valid JWT or a valid API key. If unset, requests must be authenticated by all
+	// the configured mechanisms.
+	// NOTE: On Envoy, a credential is detected by its header, query parameter or cookie, and JWTs are only detected
+	// in the headers of their providers, which default to the Authorization header with the Bearer prefix.
+	// +optional
+	AuthComposition *shared.AuthenticationComposition `json:"authComposition,omitempty"`
+
 	// OAuth2 specifies the configuration to use for OAuth2/OIDC.
 	// Note: the OAuth2 filter does not protect against Cross-Site-Request-Forgery attacks on domains with cached
 	// authentication (in the form of cookies). It is recommended to pair this with the CSRF policy to prevent
@@ -302,6 +413,21 @@ type LocalRateLimitPolicy struct {
 	// +kubebuilder:validation:Minimum=0
 	// +kubebuilder:validation:Maximum=100
 	PercentEnforced *int32 `json:"percentEnforced,omitempty"`
+
+	// XRateLimitHeaders configures the standard version to use for the X-RateLimit headers
+	// (X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset) added to the responses.
+	// See [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/filters/http/local_ratelimit/v3/local_rate_limit.proto#envoy-v3-api-field-extensions-filters-http-local-ratelimit-v3-localratelimit-enable-x-ratelimit-headers) for more info.
+	// Disabled by default.
+	// +kubebuilder:validation:Enum=Off;DraftVersion03
+	// +optional
+	XRateLimitHeaders *XRateLimitHeadersStandard `json:"xRateLimitHeaders,omitempty"`
+
+	// LimitExceededStatusCode is the HTTP status code of the responses to rate limited requests.
+	// It must be a standard HTTP status code. Defaults to 429 (Too Many Requests).
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	LimitExceededStatusCode *int32 `json:"limitExceededStatusCode,omitempty"`
 }
 
 // TokenBucket defines the configuration for a token bucket rate-limiting mechanism.
@@ -343,8 +469,50 @@ type RateLimitPolicy struct {
 	// ExtensionRef references a GatewayExtension that provides the global rate limit service.
 	// +required
 	ExtensionRef shared.NamespacedObjectReference `json:"extensionRef"`
+
+	// Stage is the rate limit stage of the descriptors. Policies with different stages are evaluated
+	// by separate calls to the rate limit service, allowing a request to be limited by each of them.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
+	Stage *int32 `json:"stage,omitempty"`
+
+	// Mode determines whether the decisions of the rate limit service are enforced.
+	// In Shadow mode, the rate limit service is called and its decisions are recorded in the statistics,
+	// but requests over the limit are not rejected. This allows validating limits before enforcing them.
+	// Defaults to Enforce.
+	// +optional
+	Mode *RateLimitMode `json:"mode,omitempty"`
+
+	// FailureMode determines how the requests are handled when the rate limit service is unavailable.
+	// Overrides the failOpen setting of the GatewayExtension.
+	// +optional
+	FailureMode *RateLimitFailureMode `json:"failureMode,omitempty"`
 }
 
+// RateLimitMode defines whether the decisions of the rate limit service are enforced.
+// +kubebuilder:validation:Enum=Enforce;Shadow
+type RateLimitMode string
+
+const (
+	// RateLimitModeEnforce rejects the requests over the limit.
+	RateLimitModeEnforce RateLimitMode = "Enforce"
+	// RateLimitModeShadow only records the decisions of the rate limit service, letting the requests through.
+	RateLimitModeShadow RateLimitMode = "Shadow"
+)
+
+// RateLimitFailureMode defines how the requests are handled when the rate limit service is unavailable.
+// +kubebuilder:validation:Enum=FailOpen;FailClosed
+type RateLimitFailureMode string
+
+const (
+	// RateLimitFailureModeFailOpen lets the requests through.
+	RateLimitFailureModeFailOpen RateLimitFailureMode = "FailOpen"
+	// RateLimitFailureModeFailClosed rejects the requests.
+	RateLimitFailureModeFailClosed RateLimitFailureMode = "FailClosed"
+)
+
 // RateLimitDescriptor defines a descriptor for rate limiting.
 // A descriptor is a group of entries that form a single rate limit rule.
 type RateLimitDescriptor struct {
@@ -572,6 +740,357 @@ type Buffer struct {
 	Disable *shared.PolicyDisable `json:"disable,omitempty"`
 }
 
+// FaultInjection configures the faults to inject into requests.
+// +kubebuilder:validation:AtLeastOneOf=delay;abort;disable
+// +kubebuilder:validation:XValidation:rule="!has(self.disable) || (!has(self.delay) && !has(self.abort))",message="disable cannot be combined with delay or abort"
+type FaultInjection struct {
+	// Delay injects a fixed delay before forwarding requests.
+	// +optional
+	Delay *FaultDelay `json:"delay,omitempty"`
+
+	// Abort responds to requests with the given status code instead of forwarding them.
+	// If both a delay and an abort are injected into a request, the delay is applied first.
+	// +optional
+	Abort *FaultAbort `json:"abort,omitempty"`
+
+	// Disable the fault injection filter.
+	// Can be used to disable fault injection policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// RequestMirroring configures the backend requests are mirrored to.
+type RequestMirroring struct {
+	// BackendRef references the backend requests are mirrored to.
+	// +required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// Percentage of requests to mirror.
+	// If unset, all requests are mirrored.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// Caching configures HTTP response caching.
+// The storage, key, varyHeaders and maxBodySize settings apply to a Gateway listener as a whole: when several
+// policies attached to routes of the same listener set them differently, the settings of the first route
+// translated are used. The ttl and disable settings apply to each targeted resource.
+// +kubebuilder:validation:XValidation:rule="!has(self.disable) || (!has(self.ttl) && !has(self.key) && !has(self.varyHeaders) && !has(self.maxBodySize) && !has(self.storage))",message="disable cannot be combined with other fields"
+type Caching struct {
+	// TTL is the freshness lifetime of cached responses that do not set a Cache-Control header.
+	// It is applied by adding a `Cache-Control: max-age` header to such responses to GET and HEAD requests
+	// with a status code that is cacheable by default (e.g. 200, 301 or 404).
+	// If unset, only responses that are explicitly cacheable are cached.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s)){1,3}$')",message="invalid duration value"
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// Key configures how the cache key is composed from the request.
+	// By default, the key is composed of the scheme, host, path and query string of the request.
+	// +optional
+	Key *CacheKey `json:"key,omitempty"`
+
+	// VaryHeaders is the list of request headers responses are allowed to vary on.
+	// Responses with a Vary header referencing any other header are not cached.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=16
+	VaryHeaders []gwv1.HeaderName `json:"varyHeaders,omitempty"`
+
+	// MaxBodySize is the maximum size of a response body to cache. Larger responses are not cached.
+	// If unset, the storage limit applies.
+	// Example format: "1Mi", "512Ki"
+	// +optional
+	// +kubebuilder:validation:XValidation:message="maxBodySize must be greater than 0 and less than 4Gi",rule="(type(self) == int && int(self) > 0 && int(self) < 4294967296) || (type(self) == string && quantity(self).isGreaterThan(quantity('0')) && quantity(self).isLessThan(quantity('4Gi')))"
+	MaxBodySize *resource.Quantity `json:"maxBodySize,omitempty"`
+
+	// Storage is where the cached responses are stored.
+	// Only in-memory storage, local to each proxy replica, is currently supported: Envoy does not provide an
+	// external (e.g. Redis) backed HTTP cache.
+	// +optional
+	// +kubebuilder:default=InMemory
+	Storage *CacheStorage `json:"storage,omitempty"`
+
+	// Disable response caching.
+	// Can be used to disable caching policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// RequestValidation rejects the requests for which a CEL expression does not evaluate to true.
+type RequestValidation struct {
+	// Expression is the CEL expression evaluated on the attributes of the request, such as request.method,
+	// request.path and request.headers. For example: `request.method == 'GET' || 'content-type' in request.headers`.
+	// The request body is not available to the expression.
+	// See [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/intro/arch_overview/advanced/attributes#request-attributes) for the available attributes.
+	// +required
+	Expression shared.CELExpression `json:"expression"`
+
+	// StatusCode is the HTTP status code of the responses to rejected requests.
+	// Defaults to 400 (Bad Request).
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
+
+// BodyMatch matches the requests on a field of their JSON body.
+// The body of the requests with a JSON content type is buffered to extract the field, then the route is
+// selected again. The body is buffered up to the maximum request size of the buffer policy of the route
+// matched by the request headers, or else up to the per-connection buffer limit of the listener, which
+// can be set with a ListenerPolicy. Requests with a larger body are rejected with a 413 response.
+// A rule with a body match does not take precedence over a rule with the same matches and no body match,
+// so it must be listed first in the HTTPRoute.
+type BodyMatch struct {
+	// JSONPointer is the [RFC 6901](https://datatracker.ietf.org/doc/html/rfc6901) JSON pointer to the field
+	// of the request body, for example `/method` or `/params/name`. Only the members of objects can be
+	// selected: array indices are not supported.
+	// +required
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^(/[^/]+)+$`
+	JSONPointer string `json:"jsonPointer"`
+
+	// Value is the value the field must be equal to. Numbers and booleans are compared with their JSON
+	// representation, for example `42` or `true`.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Value string `json:"value"`
+}
+
+// AdmissionControl configures the probabilistic rejection of requests based on their success rate.
+// The rejection probability grows as the success rate over the sampling window drops below the threshold.
+// A request is considered successful unless it results in a 5xx HTTP status or an error gRPC status.
+// The settings apply to a Gateway listener as a whole: when several policies attached to routes of the same
+// listener set them differently, the settings of the first route translated are used. The disable setting
+// applies to each targeted resource.
+// See [envoy docs](https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/admission_control_filter) for more info.
+// +kubebuilder:validation:XValidation:rule="!has(self.disable) || (!has(self.samplingWindow) && !has(self.successRateThreshold) && !has(self.aggression) && !has(self.rpsThreshold) && !has(self.maxRejectionProbability))",message="disable cannot be combined with other fields"
+type AdmissionControl struct {
+	// SamplingWindow is the time window over which the success rate is computed.
+	// Defaults to 30s.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="samplingWindow must be at least 1s"
+	SamplingWindow *metav1.Duration `json:"samplingWindow,omitempty"`
+
+	// SuccessRateThreshold is the success rate percentage below which requests start being rejected.
+	// Defaults to 95.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SuccessRateThreshold *int32 `json:"successRateThreshold,omitempty"`
+
+	// Aggression controls how quickly the rejection probability grows as the success rate drops.
+	// A value of 1.0 makes the probability grow linearly, larger values reject more requests at
+	// success rates close to the threshold. Must be a decimal number of at least 1.0.
+	// Defaults to 1.0.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self.matches('^[0-9]+(\\\\.[0-9]+)?$') && double(self) >= 1.0",message="aggression must be a decimal number of at least 1.0"
+	Aggression *string `json:"aggression,omitempty"`
+
+	// RpsThreshold is the number of requests per second below which no request is rejected,
+	// so that a few failures under low traffic do not trigger load shedding.
+	// Defaults to 0.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RpsThreshold *int32 `json:"rpsThreshold,omitempty"`
+
+	// MaxRejectionProbability is the maximum percentage of requests rejected, so that some requests
+	// still reach the backends and the success rate can recover.
+	// Defaults to 80.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	MaxRejectionProbability *int32 `json:"maxRejectionProbability,omitempty"`
+
+	// Disable admission control.
+	// Can be used to disable admission control policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// CacheStorage is the storage of cached responses.
+// +kubebuilder:validation:Enum=InMemory
+type CacheStorage string
+
+const (
+	// CacheStorageInMemory stores cached responses in the memory of each proxy replica.
+	CacheStorageInMemory CacheStorage = "InMemory"
+)
+
+// CacheKey configures the composition of the cache key.
+// +kubebuilder:validation:XValidation:rule="!has(self.includedQueryParameters) || !has(self.excludedQueryParameters)",message="includedQueryParameters and excludedQueryParameters are mutually exclusive"
+type CacheKey struct {
+	// ExcludeScheme excludes the scheme of the request from the cache key, so that HTTP and HTTPS requests
+	// share the cache entries.
+	// +optional
+	ExcludeScheme *bool `json:"excludeScheme,omitempty"`
+
+	// ExcludeHost excludes the host of the request from the cache key, so that all the hosts of a route
+	// share the cache entries.
+	// +optional
+	ExcludeHost *bool `json:"excludeHost,omitempty"`
+
+	// IncludedQueryParameters is the list of query parameters included in the cache key.
+	// If set, all other query parameters are excluded.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	IncludedQueryParameters []string `json:"includedQueryParameters,omitempty"`
+
+	// ExcludedQueryParameters is the list of query parameters excluded from the cache key.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MaxItems=32
+	ExcludedQueryParameters []string `json:"excludedQueryParameters,omitempty"`
+}
+
+// Tap configures the capture of requests and responses.
+// The match, sink and maxBufferedBodySize settings apply to a Gateway listener as a whole: when several policies
+// attached to routes of the same listener set them differently, the settings of the first route translated are used.
+// The disable setting applies to each targeted resource.
+// +kubebuilder:validation:XValidation:rule="!has(self.disable) || (!has(self.match) && !has(self.sink) && !has(self.maxBufferedBodySize))",message="disable cannot be combined with other fields"
+// +kubebuilder:validation:XValidation:rule="has(self.disable) || has(self.sink)",message="sink must be set"
+// +kubebuilder:validation:XValidation:rule="!has(self.match) || !has(self.sink) || !has(self.sink.admin)",message="match cannot be combined with the admin sink"
+type Tap struct {
+	// Match selects the requests to capture. All the conditions must match.
+	// If unset, all requests are captured.
+	// Not supported with the admin sink, for which the match is sent with the request to the admin endpoint.
+	// +optional
+	Match *TapMatch `json:"match,omitempty"`
+
+	// Sink is where the captures are written.
+	// +optional
+	Sink *TapSink `json:"sink,omitempty"`
+
+	// MaxBufferedBodySize is the maximum size of the request and response bodies captured.
+	// Larger bodies are truncated. If unset, Envoy captures up to 1KiB of each body.
+	// Example format: "1Mi", "512Ki"
+	// +optional
+	// +kubebuilder:validation:XValidation:message="maxBufferedBodySize must be greater than 0 and less than 4Gi",rule="(type(self) == int && int(self) > 0 && int(self) < 4294967296) || (type(self) == string && quantity(self).isGreaterThan(quantity('0')) && quantity(self).isLessThan(quantity('4Gi')))"
+	MaxBufferedBodySize *resource.Quantity `json:"maxBufferedBodySize,omitempty"`
+
+	// Disable the tap.
+	// Can be used to disable tap policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// TapMatch selects the requests to capture.
+type TapMatch struct {
+	// RequestHeaders are the request headers a request must match to be captured.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	RequestHeaders []gwv1.HTTPHeaderMatch `json:"requestHeaders,omitempty"`
+
+	// ResponseHeaders are the response headers a response must match for the request to be captured.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	ResponseHeaders []gwv1.HTTPHeaderMatch `json:"responseHeaders,omitempty"`
+}
+
+// TapSink configures where captures are written.
+// +kubebuilder:validation:ExactlyOneOf=file;admin
+type TapSink struct {
+	// File writes each capture to a separate file in the /var/run/tap directory of the proxy container.
+	// The directory is a memory-backed volume of 128Mi: once it is full, further captures are dropped.
+	// +optional
+	File *FileTapSink `json:"file,omitempty"`
+
+	// Admin streams the captures to the clients of the /tap endpoint of the Envoy admin interface.
+	// Nothing is captured until a client requests captures for the configId, with the match of the requests
+	// to capture and the format of the captures.
+	// +optional
+	Admin *AdminTapSink `json:"admin,omitempty"`
+}
+
+// FileTapSink writes captures to files.
+type FileTapSink struct {
+	// PathPrefix is the path prefix of the files captures are written to. Each file is named with the prefix
+	// followed by the id of the capture and an extension matching the format.
+	// It must be in the /var/run/tap directory, e.g. /var/run/tap/checkout.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	// +kubebuilder:validation:XValidation:rule="self.startsWith('/var/run/tap/') && !self.contains('..')",message="pathPrefix must be in the /var/run/tap directory"
+	PathPrefix string `json:"pathPrefix"`
+
+	// Streaming writes each capture as a series of events while the request is in progress, instead of as a
+	// single trace once the response completes.
+	// +optional
+	Streaming *bool `json:"streaming,omitempty"`
+
+	// Format is the format of the captures.
+	// +optional
+	// +kubebuilder:default=JsonBodyAsString
+	Format *TapFormat `json:"format,omitempty"`
+}
+
+// AdminTapSink streams captures to the Envoy admin interface.
+type AdminTapSink struct {
+	// ConfigID identifies the tap in the requests to the /tap admin endpoint.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	ConfigID string `json:"configId"`
+}
+
+// TapFormat is the format of the captures.
+// +kubebuilder:validation:Enum=JsonBodyAsString;JsonBodyAsBytes;ProtoBinary;ProtoText
+type TapFormat string
+
+const (
+	// TapFormatJsonBodyAsString writes JSON captures, with the bodies as strings.
+	TapFormatJsonBodyAsString TapFormat = "JsonBodyAsString"
+	// TapFormatJsonBodyAsBytes writes JSON captures, with the bodies base64-encoded.
+	TapFormatJsonBodyAsBytes TapFormat = "JsonBodyAsBytes"
+	// TapFormatProtoBinary writes binary protobuf captures.
+	TapFormatProtoBinary TapFormat = "ProtoBinary"
+	// TapFormatProtoText writes text protobuf captures.
+	TapFormatProtoText TapFormat = "ProtoText"
+)
+
+// FaultDelay configures the delay injected into requests.
+type FaultDelay struct {
+	// FixedDelay is the duration requests are delayed for.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	FixedDelay metav1.Duration `json:"fixedDelay"`
+
+	// Percentage of requests to delay.
+	// If unset, all requests are delayed.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
+// FaultAbort configures the abort injected into requests.
+type FaultAbort struct {
+	// StatusCode is the HTTP status code to respond with.
+	// +required
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode int32 `json:"statusCode"`
+
+	// Percentage of requests to abort.
+	// If unset, all requests are aborted.
+	// +optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Percentage *int32 `json:"percentage,omitempty"`
+}
+
 // Compression configures HTTP gzip compression and decompression behavior.
 // +kubebuilder:validation:AtLeastOneOf=responseCompression;requestDecompression
 type Compression struct {
@@ -595,6 +1114,14 @@ type Compression struct {
 	RequestDecompression *RequestDecompression `json:"requestDecompression,omitempty"`
 }
 
+// GrpcWeb configures the translation of gRPC-Web requests to gRPC.
+type GrpcWeb struct {
+	// Disable gRPC-Web translation.
+	// Can be used to disable gRPC-Web policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
 // ResponseCompression configures response compression.
 type ResponseCompression struct {
 	// Disables compression.
@@ -0,0 +1,97 @@
+package kgateway
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// Wasm runs a WebAssembly (Wasm) module implementing the Proxy-Wasm ABI as an HTTP filter on the requests and
+// responses of the targeted resources, after the authentication and authorization filters.
+// See https://github.com/proxy-wasm/spec for the ABI available to modules.
+//
+// +kubebuilder:validation:ExactlyOneOf=image;http;disable
+type Wasm struct {
+	// Image is an OCI image holding the module. The image is pulled by the controller, which serves the module
+	// to Envoy on the wasm port of its Service. The policy is not applied until the image is pulled.
+	// +optional
+	Image *WasmImageSource `json:"image,omitempty"`
+
+	// HTTP is a URL serving the module. The module is fetched by Envoy.
+	// +optional
+	HTTP *WasmHTTPSource `json:"http,omitempty"`
+
+	// RootID is the root ID of the filter to run, for modules implementing several filters.
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	RootID *string `json:"rootId,omitempty"`
+
+	// Config is the configuration passed to the module, serialized as JSON.
+	// +optional
+	Config *runtime.RawExtension `json:"config,omitempty"`
+
+	// FailurePolicy defines how the requests are handled when the module fails to load or crashes.
+	// Defaults to FailClosed.
+	// +optional
+	FailurePolicy *WasmFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Disable the Wasm modules.
+	// Can be used to disable Wasm policies applied at a higher level in the config hierarchy.
+	// +optional
+	Disable *shared.PolicyDisable `json:"disable,omitempty"`
+}
+
+// WasmImageSource is an OCI image holding a Wasm module, either as a Wasm OCI artifact or as an image with a
+// single layer holding a .wasm file.
+type WasmImageSource struct {
+	// Ref is the reference of the image, for example ghcr.io/example/filter:v1.
+	// Images referenced by tag are pulled again every 5 minutes, and the policy is updated when the module
+	// changed.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=512
+	Ref string `json:"ref"`
+
+	// PullSecretRef references a Secret of type kubernetes.io/dockerconfigjson, in the same namespace as the
+	// TrafficPolicy, holding the credentials to pull the image.
+	// +optional
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty"`
+
+	// SHA256 is the expected hex encoded SHA-256 checksum of the module.
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[a-f0-9]{64}$`
+	SHA256 *string `json:"sha256,omitempty"`
+}
+
+// WasmHTTPSource is a URL serving a Wasm module.
+type WasmHTTPSource struct {
+	// URL is the URL of the module. It must be a full FQDN with protocol, host and path.
+	// For example, https://example.com/filter.wasm
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
+
+	// BackendRef is the reference to the backend serving the module.
+	// +required
+	BackendRef gwv1.BackendObjectReference `json:"backendRef"`
+
+	// SHA256 is the hex encoded SHA-256 checksum of the module, verified by Envoy.
+	// +required
+	// +kubebuilder:validation:Pattern=`^[a-f0-9]{64}$`
+	SHA256 string `json:"sha256"`
+}
+
+// WasmFailurePolicy defines how the requests are handled when a Wasm module fails.
+// +kubebuilder:validation:Enum=FailOpen;FailClosed
+type WasmFailurePolicy string
+
+const (
+	// WasmFailurePolicyFailOpen skips the module, letting the requests through.
+	WasmFailurePolicyFailOpen WasmFailurePolicy = "FailOpen"
+	// WasmFailurePolicyFailClosed rejects the requests.
+	WasmFailurePolicyFailClosed WasmFailurePolicy = "FailClosed"
+)
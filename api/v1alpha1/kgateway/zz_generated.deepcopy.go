@@ -190,6 +190,66 @@ func (in *AccessLogGrpcService) DeepCopy() *AccessLogGrpcService {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdminTapSink) DeepCopyInto(out *AdminTapSink) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdminTapSink.
+func (in *AdminTapSink) DeepCopy() *AdminTapSink {
+	if in == nil {
+		return nil
+	}
+	out := new(AdminTapSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControl) DeepCopyInto(out *AdmissionControl) {
+	*out = *in
+	if in.SamplingWindow != nil {
+		in, out := &in.SamplingWindow, &out.SamplingWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SuccessRateThreshold != nil {
+		in, out := &in.SuccessRateThreshold, &out.SuccessRateThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Aggression != nil {
+		in, out := &in.Aggression, &out.Aggression
+		*out = new(string)
+		**out = **in
+	}
+	if in.RpsThreshold != nil {
+		in, out := &in.RpsThreshold, &out.RpsThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRejectionProbability != nil {
+		in, out := &in.MaxRejectionProbability, &out.MaxRejectionProbability
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControl.
+func (in *AdmissionControl) DeepCopy() *AdmissionControl {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AlwaysOnConfig) DeepCopyInto(out *AlwaysOnConfig) {
 	*out = *in
@@ -267,6 +327,21 @@ func (in *AuthorizationResponse) DeepCopyInto(out *AuthorizationResponse) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.HeadersToAppendToBackend != nil {
+		in, out := &in.HeadersToAppendToBackend, &out.HeadersToAppendToBackend
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HeadersToClient != nil {
+		in, out := &in.HeadersToClient, &out.HeadersToClient
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HeadersToClientOnSuccess != nil {
+		in, out := &in.HeadersToClientOnSuccess, &out.HeadersToClientOnSuccess
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationResponse.
@@ -461,6 +536,11 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 		*out = new(CommonHttpProtocolOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTPProtocol != nil {
+		in, out := &in.HTTPProtocol, &out.HTTPProtocol
+		*out = new(BackendHTTPProtocol)
+		**out = **in
+	}
 	if in.Http1ProtocolOptions != nil {
 		in, out := &in.Http1ProtocolOptions, &out.Http1ProtocolOptions
 		*out = new(Http1ProtocolOptions)
@@ -476,6 +556,11 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 		*out = new(TLS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(UpstreamProxyProtocol)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LoadBalancer != nil {
 		in, out := &in.LoadBalancer, &out.LoadBalancer
 		*out = new(LoadBalancer)
@@ -496,6 +581,16 @@ func (in *BackendConfigPolicySpec) DeepCopyInto(out *BackendConfigPolicySpec) {
 		*out = new(CircuitBreakers)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(SessionAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TrafficDistribution != nil {
+		in, out := &in.TrafficDistribution, &out.TrafficDistribution
+		*out = new(TrafficDistribution)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendConfigPolicySpec.
@@ -653,6 +748,21 @@ func (in *BasicAuthPolicy) DeepCopy() *BasicAuthPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BodyMatch) DeepCopyInto(out *BodyMatch) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BodyMatch.
+func (in *BodyMatch) DeepCopy() *BodyMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(BodyMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BodyTransformation) DeepCopyInto(out *BodyTransformation) {
 	*out = *in
@@ -745,6 +855,86 @@ func (in *CSRFPolicy) DeepCopy() *CSRFPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheKey) DeepCopyInto(out *CacheKey) {
+	*out = *in
+	if in.ExcludeScheme != nil {
+		in, out := &in.ExcludeScheme, &out.ExcludeScheme
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ExcludeHost != nil {
+		in, out := &in.ExcludeHost, &out.ExcludeHost
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IncludedQueryParameters != nil {
+		in, out := &in.IncludedQueryParameters, &out.IncludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludedQueryParameters != nil {
+		in, out := &in.ExcludedQueryParameters, &out.ExcludedQueryParameters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheKey.
+func (in *CacheKey) DeepCopy() *CacheKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Caching) DeepCopyInto(out *Caching) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(CacheKey)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VaryHeaders != nil {
+		in, out := &in.VaryHeaders, &out.VaryHeaders
+		*out = make([]apisv1.HeaderName, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxBodySize != nil {
+		in, out := &in.MaxBodySize, &out.MaxBodySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(CacheStorage)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Caching.
+func (in *Caching) DeepCopy() *Caching {
+	if in == nil {
+		return nil
+	}
+	out := new(Caching)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreakers) DeepCopyInto(out *CircuitBreakers) {
 	*out = *in
@@ -780,6 +970,66 @@ func (in *CircuitBreakers) DeepCopy() *CircuitBreakers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertDetails) DeepCopyInto(out *ClientCertDetails) {
+	*out = *in
+	if in.Subject != nil {
+		in, out := &in.Subject, &out.Subject
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cert != nil {
+		in, out := &in.Cert, &out.Cert
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Chain != nil {
+		in, out := &in.Chain, &out.Chain
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertDetails.
+func (in *ClientCertDetails) DeepCopy() *ClientCertDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientIPHeader) DeepCopyInto(out *ClientIPHeader) {
+	*out = *in
+	if in.Trusted != nil {
+		in, out := &in.Trusted, &out.Trusted
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientIPHeader.
+func (in *ClientIPHeader) DeepCopy() *ClientIPHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientIPHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonAccessLogGrpcService) DeepCopyInto(out *CommonAccessLogGrpcService) {
 	*out = *in
@@ -1007,6 +1257,11 @@ func (in *CustomAttribute) DeepCopyInto(out *CustomAttribute) {
 		*out = new(CustomAttributeMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CEL != nil {
+		in, out := &in.CEL, &out.CEL
+		*out = new(CustomAttributeCEL)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttribute.
@@ -1019,6 +1274,21 @@ func (in *CustomAttribute) DeepCopy() *CustomAttribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomAttributeCEL) DeepCopyInto(out *CustomAttributeCEL) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomAttributeCEL.
+func (in *CustomAttributeCEL) DeepCopy() *CustomAttributeCEL {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomAttributeCEL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomAttributeEnvironment) DeepCopyInto(out *CustomAttributeEnvironment) {
 	*out = *in
@@ -1095,6 +1365,27 @@ func (in *CustomAttributeMetadata) DeepCopy() *CustomAttributeMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTracingConfig) DeepCopyInto(out *DatadogTracingConfig) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTracingConfig.
+func (in *DatadogTracingConfig) DeepCopy() *DatadogTracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectResponse) DeepCopyInto(out *DirectResponse) {
 	*out = *in
@@ -1122,6 +1413,21 @@ func (in *DirectResponse) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DirectResponseBodyRef) DeepCopyInto(out *DirectResponseBodyRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseBodyRef.
+func (in *DirectResponseBodyRef) DeepCopy() *DirectResponseBodyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DirectResponseBodyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DirectResponseList) DeepCopyInto(out *DirectResponseList) {
 	*out = *in
@@ -1162,6 +1468,16 @@ func (in *DirectResponseSpec) DeepCopyInto(out *DirectResponseSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.BodyRef != nil {
+		in, out := &in.BodyRef, &out.BodyRef
+		*out = new(DirectResponseBodyRef)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DirectResponseSpec.
@@ -1232,6 +1548,11 @@ func (in *DynamicForwardProxyBackend) DeepCopyInto(out *DynamicForwardProxyBacke
 		*out = new(bool)
 		**out = **in
 	}
+	if in.DnsCache != nil {
+		in, out := &in.DnsCache, &out.DnsCache
+		*out = new(DynamicForwardProxyDnsCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicForwardProxyBackend.
@@ -1244,6 +1565,46 @@ func (in *DynamicForwardProxyBackend) DeepCopy() *DynamicForwardProxyBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DynamicForwardProxyDnsCache) DeepCopyInto(out *DynamicForwardProxyDnsCache) {
+	*out = *in
+	if in.LookupFamily != nil {
+		in, out := &in.LookupFamily, &out.LookupFamily
+		*out = new(DnsLookupFamily)
+		**out = **in
+	}
+	if in.RefreshRate != nil {
+		in, out := &in.RefreshRate, &out.RefreshRate
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RespectDnsTtl != nil {
+		in, out := &in.RespectDnsTtl, &out.RespectDnsTtl
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HostTtl != nil {
+		in, out := &in.HostTtl, &out.HostTtl
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxHosts != nil {
+		in, out := &in.MaxHosts, &out.MaxHosts
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DynamicForwardProxyDnsCache.
+func (in *DynamicForwardProxyDnsCache) DeepCopy() *DynamicForwardProxyDnsCache {
+	if in == nil {
+		return nil
+	}
+	out := new(DynamicForwardProxyDnsCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvironmentResourceDetectorConfig) DeepCopyInto(out *EnvironmentResourceDetectorConfig) {
 	*out = *in
@@ -1527,6 +1888,11 @@ func (in *ExtProcPolicy) DeepCopyInto(out *ExtProcPolicy) {
 		*out = new(ProcessingMode)
 		**out = **in
 	}
+	if in.FailOpen != nil {
+		in, out := &in.FailOpen, &out.FailOpen
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Disable != nil {
 		in, out := &in.Disable, &out.Disable
 		*out = new(shared.PolicyDisable)
@@ -1606,21 +1972,92 @@ func (in *ExtSvcRetryPolicy) DeepCopy() *ExtSvcRetryPolicy {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FileSink) DeepCopyInto(out *FileSink) {
+func (in *FaultAbort) DeepCopyInto(out *FaultAbort) {
 	*out = *in
-	if in.StringFormat != nil {
-		in, out := &in.StringFormat, &out.StringFormat
-		*out = new(string)
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
 		**out = **in
 	}
-	if in.JsonFormat != nil {
-		in, out := &in.JsonFormat, &out.JsonFormat
-		*out = new(runtime.RawExtension)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbort.
+func (in *FaultAbort) DeepCopy() *FaultAbort {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultAbort)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultDelay) DeepCopyInto(out *FaultDelay) {
+	*out = *in
+	out.FixedDelay = in.FixedDelay
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelay.
+func (in *FaultDelay) DeepCopy() *FaultDelay {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultDelay)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
+	*out = *in
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelay)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbort)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjection.
+func (in *FaultInjection) DeepCopy() *FaultInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileSink) DeepCopyInto(out *FileSink) {
+	*out = *in
+	if in.StringFormat != nil {
+		in, out := &in.StringFormat, &out.StringFormat
+		*out = new(string)
+		**out = **in
+	}
+	if in.JsonFormat != nil {
+		in, out := &in.JsonFormat, &out.JsonFormat
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileSink.
 func (in *FileSink) DeepCopy() *FileSink {
 	if in == nil {
 		return nil
@@ -1630,6 +2067,31 @@ func (in *FileSink) DeepCopy() *FileSink {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FileTapSink) DeepCopyInto(out *FileTapSink) {
+	*out = *in
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(TapFormat)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FileTapSink.
+func (in *FileTapSink) DeepCopy() *FileTapSink {
+	if in == nil {
+		return nil
+	}
+	out := new(FileTapSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FilterType) DeepCopyInto(out *FilterType) {
 	*out = *in
@@ -2025,6 +2487,26 @@ func (in *GrpcStatusFilter) DeepCopy() *GrpcStatusFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrpcWeb) DeepCopyInto(out *GrpcWeb) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrpcWeb.
+func (in *GrpcWeb) DeepCopy() *GrpcWeb {
+	if in == nil {
+		return nil
+	}
+	out := new(GrpcWeb)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPListenerPolicy) DeepCopyInto(out *HTTPListenerPolicy) {
 	*out = *in
@@ -2152,6 +2634,16 @@ func (in *HTTPSettings) DeepCopyInto(out *HTTPSettings) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.SkipXffAppend != nil {
+		in, out := &in.SkipXffAppend, &out.SkipXffAppend
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ClientIPHeader != nil {
+		in, out := &in.ClientIPHeader, &out.ClientIPHeader
+		*out = new(ClientIPHeader)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServerHeaderTransformation != nil {
 		in, out := &in.ServerHeaderTransformation, &out.ServerHeaderTransformation
 		*out = new(ServerHeaderTransformation)
@@ -2177,6 +2669,11 @@ func (in *HTTPSettings) DeepCopyInto(out *HTTPSettings) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ProperCaseHttp1Headers != nil {
+		in, out := &in.ProperCaseHttp1Headers, &out.ProperCaseHttp1Headers
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AcceptHttp10 != nil {
 		in, out := &in.AcceptHttp10, &out.AcceptHttp10
 		*out = new(bool)
@@ -2197,11 +2694,41 @@ func (in *HTTPSettings) DeepCopyInto(out *HTTPSettings) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaxRequestHeadersCount != nil {
+		in, out := &in.MaxRequestHeadersCount, &out.MaxRequestHeadersCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRequestsPerConnection != nil {
+		in, out := &in.MaxRequestsPerConnection, &out.MaxRequestsPerConnection
+		*out = new(int32)
+		**out = **in
+	}
+	if in.InvalidRequestAction != nil {
+		in, out := &in.InvalidRequestAction, &out.InvalidRequestAction
+		*out = new(InvalidRequestAction)
+		**out = **in
+	}
 	if in.UuidRequestIdConfig != nil {
 		in, out := &in.UuidRequestIdConfig, &out.UuidRequestIdConfig
 		*out = new(UuidRequestIdConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientCertDetails != nil {
+		in, out := &in.ClientCertDetails, &out.ClientCertDetails
+		*out = new(ClientCertDetails)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OutageDrill != nil {
+		in, out := &in.OutageDrill, &out.OutageDrill
+		*out = new(OutageDrill)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LocalReply != nil {
+		in, out := &in.LocalReply, &out.LocalReply
+		*out = new(LocalReply)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPSettings.
@@ -2649,6 +3176,11 @@ func (in *JWT) DeepCopyInto(out *JWT) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ProviderRequirement != nil {
+		in, out := &in.ProviderRequirement, &out.ProviderRequirement
+		*out = new(JWTProviderRequirement)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWT.
@@ -2848,6 +3380,11 @@ func (in *KubernetesProxyConfig) DeepCopyInto(out *KubernetesProxyConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ResourceNaming != nil {
+		in, out := &in.ResourceNaming, &out.ResourceNaming
+		*out = new(ResourceNaming)
+		(*in).DeepCopyInto(*out)
+	}
 	in.GatewayParametersOverlays.DeepCopyInto(&out.GatewayParametersOverlays)
 }
 
@@ -2896,11 +3433,21 @@ func (in *ListenerConfig) DeepCopyInto(out *ListenerConfig) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
 	if in.HTTPSettings != nil {
 		in, out := &in.HTTPSettings, &out.HTTPSettings
 		*out = new(HTTPSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.TCPSettings != nil {
+		in, out := &in.TCPSettings, &out.TCPSettings
+		*out = new(TCPSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerConfig.
@@ -3249,6 +3796,16 @@ func (in *LocalRateLimitPolicy) DeepCopyInto(out *LocalRateLimitPolicy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.XRateLimitHeaders != nil {
+		in, out := &in.XRateLimitHeaders, &out.XRateLimitHeaders
+		*out = new(XRateLimitHeadersStandard)
+		**out = **in
+	}
+	if in.LimitExceededStatusCode != nil {
+		in, out := &in.LimitExceededStatusCode, &out.LimitExceededStatusCode
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitPolicy.
@@ -3261,6 +3818,139 @@ func (in *LocalRateLimitPolicy) DeepCopy() *LocalRateLimitPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReply) DeepCopyInto(out *LocalReply) {
+	*out = *in
+	if in.Mappers != nil {
+		in, out := &in.Mappers, &out.Mappers
+		*out = make([]LocalReplyMapper, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReply.
+func (in *LocalReply) DeepCopy() *LocalReply {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReply)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyBody) DeepCopyInto(out *LocalReplyBody) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ContentType != nil {
+		in, out := &in.ContentType, &out.ContentType
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyBody.
+func (in *LocalReplyBody) DeepCopy() *LocalReplyBody {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyMapper) DeepCopyInto(out *LocalReplyMapper) {
+	*out = *in
+	in.Match.DeepCopyInto(&out.Match)
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(LocalReplyBody)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyMapper.
+func (in *LocalReplyMapper) DeepCopy() *LocalReplyMapper {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyMapper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalReplyMatch) DeepCopyInto(out *LocalReplyMatch) {
+	*out = *in
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResponseFlags != nil {
+		in, out := &in.ResponseFlags, &out.ResponseFlags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalReplyMatch.
+func (in *LocalReplyMatch) DeepCopy() *LocalReplyMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalReplyMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Lua) DeepCopyInto(out *Lua) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lua.
+func (in *Lua) DeepCopy() *Lua {
+	if in == nil {
+		return nil
+	}
+	out := new(Lua)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MetadataKey) DeepCopyInto(out *MetadataKey) {
 	*out = *in
@@ -3314,6 +4004,11 @@ func (in *MetadataOptions) DeepCopyInto(out *MetadataOptions) {
 		*out = new(MetadataNamespaces)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Receiving != nil {
+		in, out := &in.Receiving, &out.Receiving
+		*out = new(MetadataNamespaces)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataOptions.
@@ -3526,6 +4221,11 @@ func (in *OAuth2Provider) DeepCopyInto(out *OAuth2Provider) {
 		*out = new(OAuth2CookieConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Session != nil {
+		in, out := &in.Session, &out.Session
+		*out = new(OAuth2SessionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DenyRedirect != nil {
 		in, out := &in.DenyRedirect, &out.DenyRedirect
 		*out = new(OAuth2DenyRedirectMatcher)
@@ -3543,6 +4243,36 @@ func (in *OAuth2Provider) DeepCopy() *OAuth2Provider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2SessionConfig) DeepCopyInto(out *OAuth2SessionConfig) {
+	*out = *in
+	if in.UseRefreshToken != nil {
+		in, out := &in.UseRefreshToken, &out.UseRefreshToken
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccessTokenExpiry != nil {
+		in, out := &in.AccessTokenExpiry, &out.AccessTokenExpiry
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RefreshTokenExpiry != nil {
+		in, out := &in.RefreshTokenExpiry, &out.RefreshTokenExpiry
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2SessionConfig.
+func (in *OAuth2SessionConfig) DeepCopy() *OAuth2SessionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2SessionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OpenTelemetryAccessLogService) DeepCopyInto(out *OpenTelemetryAccessLogService) {
 	*out = *in
@@ -3580,9 +4310,51 @@ func (in *OpenTelemetryAccessLogService) DeepCopy() *OpenTelemetryAccessLogServi
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OpenTelemetryTracingConfig) DeepCopyInto(out *OpenTelemetryTracingConfig) {
+func (in *OpenTelemetryHttpService) DeepCopyInto(out *OpenTelemetryHttpService) {
 	*out = *in
-	in.GrpcService.DeepCopyInto(&out.GrpcService)
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]HeaderValue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenTelemetryHttpService.
+func (in *OpenTelemetryHttpService) DeepCopy() *OpenTelemetryHttpService {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenTelemetryHttpService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenTelemetryTracingConfig) DeepCopyInto(out *OpenTelemetryTracingConfig) {
+	*out = *in
+	if in.GrpcService != nil {
+		in, out := &in.GrpcService, &out.GrpcService
+		*out = new(CommonGrpcService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HttpService != nil {
+		in, out := &in.HttpService, &out.HttpService
+		*out = new(OpenTelemetryHttpService)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ServiceName != nil {
 		in, out := &in.ServiceName, &out.ServiceName
 		*out = new(string)
@@ -3612,6 +4384,28 @@ func (in *OpenTelemetryTracingConfig) DeepCopy() *OpenTelemetryTracingConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutageDrill) DeepCopyInto(out *OutageDrill) {
+	*out = *in
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]OutageDrillService, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutageDrill.
+func (in *OutageDrill) DeepCopy() *OutageDrill {
+	if in == nil {
+		return nil
+	}
+	out := new(OutageDrill)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
 	*out = *in
@@ -3937,6 +4731,21 @@ func (in *RateLimitPolicy) DeepCopyInto(out *RateLimitPolicy) {
 		}
 	}
 	in.ExtensionRef.DeepCopyInto(&out.ExtensionRef)
+	if in.Stage != nil {
+		in, out := &in.Stage, &out.Stage
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(RateLimitMode)
+		**out = **in
+	}
+	if in.FailureMode != nil {
+		in, out := &in.FailureMode, &out.FailureMode
+		*out = new(RateLimitFailureMode)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitPolicy.
@@ -3954,6 +4763,11 @@ func (in *RateLimitProvider) DeepCopyInto(out *RateLimitProvider) {
 	*out = *in
 	in.GrpcService.DeepCopyInto(&out.GrpcService)
 	out.Timeout = in.Timeout
+	if in.LimitExceededStatusCode != nil {
+		in, out := &in.LimitExceededStatusCode, &out.LimitExceededStatusCode
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitProvider.
@@ -4007,6 +4821,47 @@ func (in *RequestDecompression) DeepCopy() *RequestDecompression {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestMirroring) DeepCopyInto(out *RequestMirroring) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestMirroring.
+func (in *RequestMirroring) DeepCopy() *RequestMirroring {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestMirroring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestValidation) DeepCopyInto(out *RequestValidation) {
+	*out = *in
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestValidation.
+func (in *RequestValidation) DeepCopy() *RequestValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDetector) DeepCopyInto(out *ResourceDetector) {
 	*out = *in
@@ -4027,6 +4882,31 @@ func (in *ResourceDetector) DeepCopy() *ResourceDetector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceNaming) DeepCopyInto(out *ResourceNaming) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Suffix != nil {
+		in, out := &in.Suffix, &out.Suffix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceNaming.
+func (in *ResourceNaming) DeepCopy() *ResourceNaming {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceNaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResponseCompression) DeepCopyInto(out *ResponseCompression) {
 	*out = *in
@@ -4090,6 +4970,11 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.BackoffMaxInterval != nil {
+		in, out := &in.BackoffMaxInterval, &out.BackoffMaxInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -4348,6 +5233,51 @@ func (in *ServiceAccount) DeepCopy() *ServiceAccount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionAffinity) DeepCopyInto(out *SessionAffinity) {
+	*out = *in
+	if in.Cookie != nil {
+		in, out := &in.Cookie, &out.Cookie
+		*out = new(Cookie)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(SessionAffinityHeader)
+		**out = **in
+	}
+	if in.Strict != nil {
+		in, out := &in.Strict, &out.Strict
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionAffinity.
+func (in *SessionAffinity) DeepCopy() *SessionAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionAffinityHeader) DeepCopyInto(out *SessionAffinityHeader) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionAffinityHeader.
+func (in *SessionAffinityHeader) DeepCopy() *SessionAffinityHeader {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionAffinityHeader)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SlowStart) DeepCopyInto(out *SlowStart) {
 	*out = *in
@@ -4504,6 +5434,31 @@ func (in *StatusCodeFilter) DeepCopy() *StatusCodeFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPAccessLogOptions) DeepCopyInto(out *TCPAccessLogOptions) {
+	*out = *in
+	if in.FlushOnConnected != nil {
+		in, out := &in.FlushOnConnected, &out.FlushOnConnected
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlushInterval != nil {
+		in, out := &in.FlushInterval, &out.FlushInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPAccessLogOptions.
+func (in *TCPAccessLogOptions) DeepCopy() *TCPAccessLogOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPAccessLogOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	*out = *in
@@ -4534,6 +5489,48 @@ func (in *TCPKeepalive) DeepCopy() *TCPKeepalive {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPSettings) DeepCopyInto(out *TCPSettings) {
+	*out = *in
+	if in.AccessLog != nil {
+		in, out := &in.AccessLog, &out.AccessLog
+		*out = make([]AccessLog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AccessLogOptions != nil {
+		in, out := &in.AccessLogOptions, &out.AccessLogOptions
+		*out = new(TCPAccessLogOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IdleTimeout != nil {
+		in, out := &in.IdleTimeout, &out.IdleTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConnectionDuration != nil {
+		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ConnectionRateLimit != nil {
+		in, out := &in.ConnectionRateLimit, &out.ConnectionRateLimit
+		*out = new(TokenBucket)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPSettings.
+func (in *TCPSettings) DeepCopy() *TCPSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLS) DeepCopyInto(out *TLS) {
 	*out = *in
@@ -4664,6 +5661,95 @@ func (in *TLSParameters) DeepCopy() *TLSParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tap) DeepCopyInto(out *Tap) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(TapMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(TapSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxBufferedBodySize != nil {
+		in, out := &in.MaxBufferedBodySize, &out.MaxBufferedBodySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tap.
+func (in *Tap) DeepCopy() *Tap {
+	if in == nil {
+		return nil
+	}
+	out := new(Tap)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TapMatch) DeepCopyInto(out *TapMatch) {
+	*out = *in
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = make([]apisv1.HTTPHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TapMatch.
+func (in *TapMatch) DeepCopy() *TapMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(TapMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TapSink) DeepCopyInto(out *TapSink) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(FileTapSink)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Admin != nil {
+		in, out := &in.Admin, &out.Admin
+		*out = new(AdminTapSink)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TapSink.
+func (in *TapSink) DeepCopy() *TapSink {
+	if in == nil {
+		return nil
+	}
+	out := new(TapSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenBucket) DeepCopyInto(out *TokenBucket) {
 	*out = *in
@@ -4746,6 +5832,16 @@ func (in *TracingProvider) DeepCopyInto(out *TracingProvider) {
 		*out = new(OpenTelemetryTracingConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Zipkin != nil {
+		in, out := &in.Zipkin, &out.Zipkin
+		*out = new(ZipkinTracingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Datadog != nil {
+		in, out := &in.Datadog, &out.Datadog
+		*out = new(DatadogTracingConfig)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingProvider.
@@ -4758,6 +5854,26 @@ func (in *TracingProvider) DeepCopy() *TracingProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficDistribution) DeepCopyInto(out *TrafficDistribution) {
+	*out = *in
+	if in.FailoverThreshold != nil {
+		in, out := &in.FailoverThreshold, &out.FailoverThreshold
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficDistribution.
+func (in *TrafficDistribution) DeepCopy() *TrafficDistribution {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficDistribution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficPolicy) DeepCopyInto(out *TrafficPolicy) {
 	*out = *in
@@ -4834,6 +5950,15 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Priority != nil {
+		in, out := &in.Priority, &out.Priority
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Transformation != nil {
 		in, out := &in.Transformation, &out.Transformation
 		*out = new(TransformationPolicy)
@@ -4874,11 +5999,56 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HostRewrite != nil {
+		in, out := &in.HostRewrite, &out.HostRewrite
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostRewriteHeader != nil {
+		in, out := &in.HostRewriteHeader, &out.HostRewriteHeader
+		*out = new(apisv1.HeaderName)
+		**out = **in
+	}
 	if in.Buffer != nil {
 		in, out := &in.Buffer, &out.Buffer
 		*out = new(Buffer)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FaultInjection != nil {
+		in, out := &in.FaultInjection, &out.FaultInjection
+		*out = new(FaultInjection)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mirroring != nil {
+		in, out := &in.Mirroring, &out.Mirroring
+		*out = new(RequestMirroring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Caching != nil {
+		in, out := &in.Caching, &out.Caching
+		*out = new(Caching)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionControl != nil {
+		in, out := &in.AdmissionControl, &out.AdmissionControl
+		*out = new(AdmissionControl)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Lua != nil {
+		in, out := &in.Lua, &out.Lua
+		*out = new(Lua)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Wasm != nil {
+		in, out := &in.Wasm, &out.Wasm
+		*out = new(Wasm)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tap != nil {
+		in, out := &in.Tap, &out.Tap
+		*out = new(Tap)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeouts != nil {
 		in, out := &in.Timeouts, &out.Timeouts
 		*out = new(shared.Timeouts)
@@ -4894,6 +6064,16 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(shared.Authorization)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RequestValidation != nil {
+		in, out := &in.RequestValidation, &out.RequestValidation
+		*out = new(RequestValidation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BodyMatch != nil {
+		in, out := &in.BodyMatch, &out.BodyMatch
+		*out = new(BodyMatch)
+		**out = **in
+	}
 	if in.JWTAuth != nil {
 		in, out := &in.JWTAuth, &out.JWTAuth
 		*out = new(JWTAuth)
@@ -4904,11 +6084,21 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(URLRewrite)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Redirect != nil {
+		in, out := &in.Redirect, &out.Redirect
+		*out = new(shared.RequestRedirect)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Compression != nil {
 		in, out := &in.Compression, &out.Compression
 		*out = new(Compression)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.GrpcWeb != nil {
+		in, out := &in.GrpcWeb, &out.GrpcWeb
+		*out = new(GrpcWeb)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
 		*out = new(BasicAuthPolicy)
@@ -4919,6 +6109,11 @@ func (in *TrafficPolicySpec) DeepCopyInto(out *TrafficPolicySpec) {
 		*out = new(APIKeyAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AuthComposition != nil {
+		in, out := &in.AuthComposition, &out.AuthComposition
+		*out = new(shared.AuthenticationComposition)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OAuth2 != nil {
 		in, out := &in.OAuth2, &out.OAuth2
 		*out = new(OAuth2Policy)
@@ -5036,6 +6231,26 @@ func (in *UpgradeConfig) DeepCopy() *UpgradeConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamProxyProtocol) DeepCopyInto(out *UpstreamProxyProtocol) {
+	*out = *in
+	if in.Version != nil {
+		in, out := &in.Version, &out.Version
+		*out = new(ProxyProtocolVersion)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamProxyProtocol.
+func (in *UpstreamProxyProtocol) DeepCopy() *UpstreamProxyProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamProxyProtocol)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UuidRequestIdConfig) DeepCopyInto(out *UuidRequestIdConfig) {
 	*out = *in
@@ -5060,3 +6275,125 @@ func (in *UuidRequestIdConfig) DeepCopy() *UuidRequestIdConfig {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Wasm) DeepCopyInto(out *Wasm) {
+	*out = *in
+	if in.Image != nil {
+		in, out := &in.Image, &out.Image
+		*out = new(WasmImageSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(WasmHTTPSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RootID != nil {
+		in, out := &in.RootID, &out.RootID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FailurePolicy != nil {
+		in, out := &in.FailurePolicy, &out.FailurePolicy
+		*out = new(WasmFailurePolicy)
+		**out = **in
+	}
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(shared.PolicyDisable)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Wasm.
+func (in *Wasm) DeepCopy() *Wasm {
+	if in == nil {
+		return nil
+	}
+	out := new(Wasm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmHTTPSource) DeepCopyInto(out *WasmHTTPSource) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmHTTPSource.
+func (in *WasmHTTPSource) DeepCopy() *WasmHTTPSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmHTTPSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WasmImageSource) DeepCopyInto(out *WasmImageSource) {
+	*out = *in
+	if in.PullSecretRef != nil {
+		in, out := &in.PullSecretRef, &out.PullSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.SHA256 != nil {
+		in, out := &in.SHA256, &out.SHA256
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WasmImageSource.
+func (in *WasmImageSource) DeepCopy() *WasmImageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WasmImageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZipkinTracingConfig) DeepCopyInto(out *ZipkinTracingConfig) {
+	*out = *in
+	in.BackendRef.DeepCopyInto(&out.BackendRef)
+	if in.CollectorEndpoint != nil {
+		in, out := &in.CollectorEndpoint, &out.CollectorEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(ZipkinEncoding)
+		**out = **in
+	}
+	if in.TraceID128Bit != nil {
+		in, out := &in.TraceID128Bit, &out.TraceID128Bit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SharedSpanContext != nil {
+		in, out := &in.SharedSpanContext, &out.SharedSpanContext
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZipkinTracingConfig.
+func (in *ZipkinTracingConfig) DeepCopy() *ZipkinTracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ZipkinTracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,47 @@
+package shared
+
+// AuthenticationComposition defines how the authentication mechanisms configured in a policy are combined.
+// +kubebuilder:validation:ExactlyOneOf=anyOf;allOf
+type AuthenticationComposition struct {
+	// AnyOf accepts requests authenticated by at least one of the listed mechanisms, for example to accept
+	// either a valid JWT or a valid API key.
+	// A request presenting a credential for one of the mechanisms is still rejected if that credential is invalid,
+	// and a request presenting no credential for any of the mechanisms is rejected.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=3
+	AnyOf []AuthenticationMechanism `json:"anyOf,omitempty"`
+
+	// AllOf requires requests to be authenticated by all the listed mechanisms.
+	// This is the behavior when no composition is set.
+	// +optional
+	// +listType=set
+	// +kubebuilder:validation:MinItems=2
+	// +kubebuilder:validation:MaxItems=3
+	AllOf []AuthenticationMechanism `json:"allOf,omitempty"`
+}
+
+// AuthenticationMechanism is an authentication mechanism that can be combined with others.
+// +kubebuilder:validation:Enum=JWT;APIKey;BasicAuth
+type AuthenticationMechanism string
+
+const (
+	// AuthenticationMechanismJWT authenticates requests with a JWT.
+	AuthenticationMechanismJWT AuthenticationMechanism = "JWT"
+	// AuthenticationMechanismAPIKey authenticates requests with an API key.
+	AuthenticationMechanismAPIKey AuthenticationMechanism = "APIKey"
+	// AuthenticationMechanismBasicAuth authenticates requests with a username and password.
+	AuthenticationMechanismBasicAuth AuthenticationMechanism = "BasicAuth"
+)
+
+// Mechanisms returns the combined mechanisms, and whether any of them is enough to authenticate a request.
+func (in *AuthenticationComposition) Mechanisms() (mechanisms []AuthenticationMechanism, anyOf bool) {
+	if in == nil {
+		return nil, false
+	}
+	if len(in.AnyOf) > 0 {
+		return in.AnyOf, true
+	}
+	return in.AllOf, false
+}
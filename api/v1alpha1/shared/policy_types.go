@@ -12,10 +12,14 @@ const (
 	//
 	// Possible reasons for this condition to be True are:
 	// * Valid
+	// * Expiring
+	// * UnsupportedField
+	// * Conflicted
 	//
 	// Possible reasons for this condition to be False are:
 	// * Pending
 	// * Invalid
+	// * Expired
 	//
 	PolicyConditionAccepted PolicyConditionType = "Accepted"
 
@@ -28,6 +32,7 @@ const (
 	// Possible reasons for this condition to be False are:
 	// * Pending
 	// * Overridden
+	// * Expired
 	//
 	PolicyConditionAttached PolicyConditionType = "Attached"
 
@@ -55,9 +60,31 @@ const (
 	// PolicyReasonPending is used with the "Accepted" or "Attached" condition when the policy has been referenced but not yet fully processed by the controller.
 	PolicyReasonPending PolicyConditionReason = "Pending"
 
+	// PolicyReasonTargetNotFound is used with the "Attached" condition when a targeted resource does not exist,
+	// but another resource of the same kind matched by the policy targetSelectors does, which suggests the
+	// targeted resource was renamed.
+	PolicyReasonTargetNotFound PolicyConditionReason = "TargetNotFound"
+
 	// PolicyReasonPartiallyValid is used with the "Accepted" condition when the policy has been accepted by the system,
 	// but some of the referenced resources are not valid.
 	PolicyReasonPartiallyValid PolicyConditionReason = "PartiallyValid"
+
+	// PolicyReasonUnsupportedField is used with the "Accepted" condition when the policy sets fields that are not
+	// supported by the data plane of the targeted resources. The message lists the fields that were not applied.
+	PolicyReasonUnsupportedField PolicyConditionReason = "UnsupportedField"
+
+	// PolicyReasonConflicted is used with the "Accepted" condition when the policy has been accepted by the system,
+	// but some of its settings are shared by all the routes of a listener and conflict with the settings of another
+	// policy attached to the same ancestor, so that only one of them is applied.
+	PolicyReasonConflicted PolicyConditionReason = "Conflicted"
+
+	// PolicyReasonExpiring is used with the "Accepted" condition when the policy has been accepted by the system,
+	// but its expiresAt time is approaching, after which the policy will be deactivated.
+	PolicyReasonExpiring PolicyConditionReason = "Expiring"
+
+	// PolicyReasonExpired is used with the "Accepted" and "Attached" conditions when the expiresAt time of the
+	// policy has passed, and the policy has been deactivated.
+	PolicyReasonExpired PolicyConditionReason = "Expired"
 )
 
 // PolicyDisable is used to disable a policy.
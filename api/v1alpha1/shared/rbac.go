@@ -9,7 +9,10 @@ type Authorization struct {
 
 	// Action defines whether the rule allows or denies the request if matched.
 	// If unspecified, the default is "Allow".
-	// +kubebuilder:validation:Enum=Allow;Deny
+	// "Audit" evaluates the rule as a "Deny" rule without enforcing it, which is useful to roll out a new rule safely.
+	// Audit is only supported by Envoy, which records the result in the rbac shadow stats and in the
+	// shadow_effective_policy_id and shadow_engine_result dynamic metadata of the request.
+	// +kubebuilder:validation:Enum=Allow;Deny;Audit
 	// +kubebuilder:default=Allow
 	// +optional
 	Action AuthorizationPolicyAction `json:"action,omitempty"`
@@ -22,14 +25,108 @@ type Authorization struct {
 type CELExpression string
 
 // AuthorizationPolicy defines a single Authorization rule.
+// +kubebuilder:validation:AtLeastOneOf=matchExpressions;matchers
 type AuthorizationPolicy struct {
 	// MatchExpressions defines a set of conditions that must be satisfied for the rule to match.
 	// These expression should be in the form of a Common Expression Language (CEL) expression.
 	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=256
+	// +optional
+	MatchExpressions []CELExpression `json:"matchExpressions,omitempty"`
+
+	// Matchers defines a set of typed conditions, evaluated like matchExpressions.
+	// They are a more readable alternative to CEL expressions for common conditions.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +optional
+	Matchers []AuthorizationMatcher `json:"matchers,omitempty"`
+}
+
+// AuthorizationMatcher defines a typed condition of an Authorization rule.
+// The condition is satisfied when all the fields that are set match the request.
+// +kubebuilder:validation:MinProperties=1
+type AuthorizationMatcher struct {
+	// Methods matches the requests with one of the HTTP methods.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Methods []string `json:"methods,omitempty"`
+
+	// Paths matches the requests with one of the paths, excluding the query string.
+	// A path ending with `*` matches the requests with the preceding prefix, e.g. `/api/*`.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Paths []string `json:"paths,omitempty"`
+
+	// Headers matches the requests with all the headers.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Headers []AuthorizationHeaderMatch `json:"headers,omitempty"`
+
+	// SourceCIDRs matches the requests from a client address in one of the CIDR ranges, e.g. `10.0.0.0/8`.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:XValidation:rule="isCIDR(self)",message="sourceCIDRs must be valid CIDR ranges, e.g. 10.0.0.0/8"
+	SourceCIDRs []string `json:"sourceCIDRs,omitempty"`
+
+	// JWTClaims matches the requests authenticated with a JWT with all the claims.
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	JWTClaims []AuthorizationJWTClaimMatch `json:"jwtClaims,omitempty"`
+
+	// MCP matches the MCP requests targeting one of the tools, prompts or resources.
+	// It is only supported by the MCP authorization of agentgateway backends.
+	// +optional
+	MCP *AuthorizationMCPMatch `json:"mcp,omitempty"`
+}
+
+// AuthorizationHeaderMatch matches a request header.
+type AuthorizationHeaderMatch struct {
+	// Name of the header.
 	// +required
-	MatchExpressions []CELExpression `json:"matchExpressions"`
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value the header must be equal to. If unset, the header only needs to be present.
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// AuthorizationJWTClaimMatch matches a claim of the JWT authenticating the request.
+// +kubebuilder:validation:ExactlyOneOf=equals;contains
+type AuthorizationJWTClaimMatch struct {
+	// Name of the claim.
+	// +required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Equals matches a claim equal to the value.
+	// +optional
+	Equals *string `json:"equals,omitempty"`
+
+	// Contains matches a list claim containing the value, e.g. one of the groups of the user.
+	// +optional
+	Contains *string `json:"contains,omitempty"`
+}
+
+// AuthorizationMCPMatch matches the MCP requests targeting one of the tools, prompts or resources.
+// +kubebuilder:validation:AtLeastOneOf=tools;prompts;resources
+type AuthorizationMCPMatch struct {
+	// Tools matches the requests to list or call one of the tools, e.g. `delete_database`.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	Tools []string `json:"tools,omitempty"`
+
+	// Prompts matches the requests to list or get one of the prompts.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	Prompts []string `json:"prompts,omitempty"`
+
+	// Resources matches the requests to list or read one of the resources, by name.
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	Resources []string `json:"resources,omitempty"`
 }
 
 // AuthorizationPolicyAction defines the action to take when the RBACPolicies matches.
@@ -40,4 +137,7 @@ const (
 	AuthorizationPolicyActionAllow AuthorizationPolicyAction = "Allow"
 	// AuthorizationPolicyActionDeny denies the action to take when the RBACPolicies matches.
 	AuthorizationPolicyActionDeny AuthorizationPolicyAction = "Deny"
+	// AuthorizationPolicyActionAudit records the requests that would be denied when the RBACPolicies matches,
+	// without denying them.
+	AuthorizationPolicyActionAudit AuthorizationPolicyAction = "Audit"
 )
@@ -0,0 +1,57 @@
+package shared
+
+import gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+// RequestRedirect redirects the requests to another URL. The parts of the URL that are not set are kept from
+// the request.
+// Unlike the Gateway API RequestRedirect filter, the path can be rewritten with a regular expression and the
+// query parameters can be stripped.
+//
+// +kubebuilder:validation:AtLeastOneOf=scheme;hostname;port;pathRegex;stripQuery;statusCode
+type RequestRedirect struct {
+	// Scheme is the scheme of the redirect URL.
+	// +optional
+	//
+	// +kubebuilder:validation:Enum=http;https
+	Scheme *string `json:"scheme,omitempty"`
+
+	// Hostname is the hostname of the redirect URL.
+	// +optional
+	Hostname *gwv1.PreciseHostname `json:"hostname,omitempty"`
+
+	// Port is the port of the redirect URL.
+	// +optional
+	Port *gwv1.PortNumber `json:"port,omitempty"`
+
+	// PathRegex rewrites the path of the redirect URL by substituting the parts of the request path that
+	// match the regular expression.
+	// +optional
+	PathRegex *RedirectPathRegex `json:"pathRegex,omitempty"`
+
+	// StripQuery removes the query parameters of the request from the redirect URL.
+	// +optional
+	StripQuery *bool `json:"stripQuery,omitempty"`
+
+	// StatusCode is the HTTP status code of the redirect response. Defaults to 302.
+	// +optional
+	//
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
+
+// RedirectPathRegex rewrites the path of a redirect with a regular expression.
+type RedirectPathRegex struct {
+	// Pattern is the RE2 regular expression matching the parts of the request path to substitute.
+	// +required
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=1024
+	Pattern string `json:"pattern"`
+
+	// Substitution is the replacement of the matched parts of the path. It can include backreferences to
+	// the capture groups of the pattern, e.g. \1.
+	// +required
+	//
+	// +kubebuilder:validation:MaxLength=1024
+	Substitution string `json:"substitution"`
+}
@@ -7,6 +7,7 @@ import (
 
 // Control-plane Authorization rules not specific to policies:
 // +kubebuilder:rbac:groups=authentication.k8s.io,resources=tokenreviews,verbs=create
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
 
 // Select the object by Name and Namespace.
 // You can target only one object at a time.
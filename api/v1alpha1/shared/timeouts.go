@@ -19,4 +19,20 @@ type Timeouts struct {
 	//
 	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
 	StreamIdle *metav1.Duration `json:"streamIdle,omitempty"`
+
+	// MaxStreamDuration specifies the maximum duration of a stream, from the time the request headers are
+	// received until the stream is closed, regardless of its activity. Unlike Request, it also bounds
+	// long-lived streams such as server-sent events or gRPC streams that keep exchanging data.
+	// A value of 0 effectively disables the timeout.
+	// +optional
+	//
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	MaxStreamDuration *metav1.Duration `json:"maxStreamDuration,omitempty"`
+
+	// PerTryIdle specifies a timeout for the idle upstream stream of each attempt, including the first one.
+	// An attempt without any activity for this duration is reset, and retried if a retry policy applies.
+	// +optional
+	//
+	// +kubebuilder:validation:XValidation:rule="matches(self, '^([0-9]{1,5}(h|m|s|ms)){1,4}$')",message="invalid duration value"
+	PerTryIdle *metav1.Duration `json:"perTryIdle,omitempty"`
 }
@@ -10,6 +10,31 @@ import (
 	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthenticationComposition) DeepCopyInto(out *AuthenticationComposition) {
+	*out = *in
+	if in.AnyOf != nil {
+		in, out := &in.AnyOf, &out.AnyOf
+		*out = make([]AuthenticationMechanism, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllOf != nil {
+		in, out := &in.AllOf, &out.AllOf
+		*out = make([]AuthenticationMechanism, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthenticationComposition.
+func (in *AuthenticationComposition) DeepCopy() *AuthenticationComposition {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthenticationComposition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Authorization) DeepCopyInto(out *Authorization) {
 	*out = *in
@@ -26,6 +51,130 @@ func (in *Authorization) DeepCopy() *Authorization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationHeaderMatch) DeepCopyInto(out *AuthorizationHeaderMatch) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationHeaderMatch.
+func (in *AuthorizationHeaderMatch) DeepCopy() *AuthorizationHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationJWTClaimMatch) DeepCopyInto(out *AuthorizationJWTClaimMatch) {
+	*out = *in
+	if in.Equals != nil {
+		in, out := &in.Equals, &out.Equals
+		*out = new(string)
+		**out = **in
+	}
+	if in.Contains != nil {
+		in, out := &in.Contains, &out.Contains
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationJWTClaimMatch.
+func (in *AuthorizationJWTClaimMatch) DeepCopy() *AuthorizationJWTClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationJWTClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationMCPMatch) DeepCopyInto(out *AuthorizationMCPMatch) {
+	*out = *in
+	if in.Tools != nil {
+		in, out := &in.Tools, &out.Tools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Prompts != nil {
+		in, out := &in.Prompts, &out.Prompts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationMCPMatch.
+func (in *AuthorizationMCPMatch) DeepCopy() *AuthorizationMCPMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationMCPMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationMatcher) DeepCopyInto(out *AuthorizationMatcher) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]AuthorizationHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SourceCIDRs != nil {
+		in, out := &in.SourceCIDRs, &out.SourceCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.JWTClaims != nil {
+		in, out := &in.JWTClaims, &out.JWTClaims
+		*out = make([]AuthorizationJWTClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MCP != nil {
+		in, out := &in.MCP, &out.MCP
+		*out = new(AuthorizationMCPMatch)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationMatcher.
+func (in *AuthorizationMatcher) DeepCopy() *AuthorizationMatcher {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationMatcher)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizationPolicy) DeepCopyInto(out *AuthorizationPolicy) {
 	*out = *in
@@ -34,6 +183,13 @@ func (in *AuthorizationPolicy) DeepCopyInto(out *AuthorizationPolicy) {
 		*out = make([]CELExpression, len(*in))
 		copy(*out, *in)
 	}
+	if in.Matchers != nil {
+		in, out := &in.Matchers, &out.Matchers
+		*out = make([]AuthorizationMatcher, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicy.
@@ -291,6 +447,66 @@ func (in *PolicyStatus) DeepCopy() *PolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedirectPathRegex) DeepCopyInto(out *RedirectPathRegex) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedirectPathRegex.
+func (in *RedirectPathRegex) DeepCopy() *RedirectPathRegex {
+	if in == nil {
+		return nil
+	}
+	out := new(RedirectPathRegex)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestRedirect) DeepCopyInto(out *RequestRedirect) {
+	*out = *in
+	if in.Scheme != nil {
+		in, out := &in.Scheme, &out.Scheme
+		*out = new(string)
+		**out = **in
+	}
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(apisv1.PreciseHostname)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(apisv1.PortNumber)
+		**out = **in
+	}
+	if in.PathRegex != nil {
+		in, out := &in.PathRegex, &out.PathRegex
+		*out = new(RedirectPathRegex)
+		**out = **in
+	}
+	if in.StripQuery != nil {
+		in, out := &in.StripQuery, &out.StripQuery
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StatusCode != nil {
+		in, out := &in.StatusCode, &out.StatusCode
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestRedirect.
+func (in *RequestRedirect) DeepCopy() *RequestRedirect {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestRedirect)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StringMatcher) DeepCopyInto(out *StringMatcher) {
 	*out = *in
@@ -349,6 +565,16 @@ func (in *Timeouts) DeepCopyInto(out *Timeouts) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaxStreamDuration != nil {
+		in, out := &in.MaxStreamDuration, &out.MaxStreamDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.PerTryIdle != nil {
+		in, out := &in.PerTryIdle, &out.PerTryIdle
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Timeouts.
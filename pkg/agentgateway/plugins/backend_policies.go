@@ -21,6 +21,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/jwks_url"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/sslutils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/celutils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 )
 
@@ -90,8 +91,12 @@ func translateBackendPolicyToAgw(
 	}
 
 	if s := backend.MCP; s != nil {
-		if backend.MCP.Authorization != nil {
-			pol := translateBackendMCPAuthorization(policy, policyTarget)
+		if backend.MCP.Authorization != nil || backend.MCP.Filter != nil {
+			pol, err := translateBackendMCPAuthorization(policy, policyTarget)
+			if err != nil {
+				logger.Error("error processing backend mcp authorization", "err", err)
+				errs = append(errs, err)
+			}
 			agwPolicies = append(agwPolicies, pol...)
 		}
 
@@ -257,18 +262,33 @@ func translateBackendHTTP(policy *agentgateway.AgentgatewayPolicy, target *api.P
 	return []AgwPolicy{{Policy: tp}}
 }
 
-func translateBackendMCPAuthorization(policy *agentgateway.AgentgatewayPolicy, target *api.PolicyTarget) []AgwPolicy {
+func translateBackendMCPAuthorization(policy *agentgateway.AgentgatewayPolicy, target *api.PolicyTarget) ([]AgwPolicy, error) {
 	backend := policy.Spec.Backend
-	if backend == nil || backend.MCP == nil || backend.MCP.Authorization == nil {
-		return nil
+	if backend == nil || backend.MCP == nil {
+		return nil, nil
 	}
 	auth := backend.MCP.Authorization
+	if auth != nil && auth.Action == shared.AuthorizationPolicyActionAudit {
+		// Audit rules are not supported and are dropped; the status reports the unsupported action
+		auth = nil
+	}
+	if auth == nil && backend.MCP.Filter == nil {
+		return nil, nil
+	}
 	var allowPolicies, denyPolicies []string
-	if auth.Action == shared.AuthorizationPolicyActionDeny {
-		denyPolicies = append(denyPolicies, cast(auth.Policy.MatchExpressions)...)
-	} else {
-		allowPolicies = append(allowPolicies, cast(auth.Policy.MatchExpressions)...)
+	if auth != nil {
+		expressions, err := authorizationExpressions(auth, agentgatewayMCPAuthorizationAttributes)
+		if err != nil {
+			return nil, err
+		}
+		if auth.Action == shared.AuthorizationPolicyActionDeny {
+			denyPolicies = append(denyPolicies, expressions...)
+		} else {
+			allowPolicies = append(allowPolicies, expressions...)
+		}
 	}
+	// The filter is translated to deny rules, so that the hidden items stay hidden whatever the allow rules are
+	denyPolicies = append(denyPolicies, mcpFilterExpressions(backend.MCP.Filter)...)
 
 	mcpPolicy := &api.Policy{
 		Key:    policy.Namespace + "/" + policy.Name + mcpAuthorizationPolicySuffix + attachmentName(target),
@@ -290,7 +310,35 @@ func translateBackendMCPAuthorization(policy *agentgateway.AgentgatewayPolicy, t
 		"policy", policy.Name,
 		"agentgateway_policy", mcpPolicy.Name)
 
-	return []AgwPolicy{{Policy: mcpPolicy}}
+	return []AgwPolicy{{Policy: mcpPolicy}}, nil
+}
+
+// mcpFilterExpressions returns the CEL expressions matching the MCP items hidden by the filter.
+func mcpFilterExpressions(filter *agentgateway.MCPFilter) []string {
+	if filter == nil {
+		return nil
+	}
+	var expressions []string
+	if allow := filter.Allow; allow != nil {
+		expressions = appendMCPFilterExpression(expressions, "mcp.tool", allow.Tools, true)
+		expressions = appendMCPFilterExpression(expressions, "mcp.prompt", allow.Prompts, true)
+		expressions = appendMCPFilterExpression(expressions, "mcp.resource", allow.Resources, true)
+	}
+	if deny := filter.Deny; deny != nil {
+		expressions = appendMCPFilterExpression(expressions, "mcp.tool", deny.Tools, false)
+		expressions = appendMCPFilterExpression(expressions, "mcp.prompt", deny.Prompts, false)
+		expressions = appendMCPFilterExpression(expressions, "mcp.resource", deny.Resources, false)
+	}
+	return expressions
+}
+
+// appendMCPFilterExpression appends the expression matching the items of a kind with one of the names, or with none
+// of them when the names are an allowlist.
+func appendMCPFilterExpression(expressions []string, item string, names []string, allowlist bool) []string {
+	if len(names) == 0 {
+		return expressions
+	}
+	return append(expressions, celutils.MCPItemExpression(item, names, allowlist))
 }
 
 func translateBackendMCPAuthentication(ctx PolicyCtx, policy *agentgateway.AgentgatewayPolicy, target *api.PolicyTarget) ([]AgwPolicy, error) {
@@ -579,8 +627,9 @@ func translateRouteType(rt agentgateway.RouteType) api.BackendPolicySpec_Ai_Rout
 
 func buildAwsAuthPolicy(krtctx krt.HandlerContext, auth *agentgateway.AwsAuth, secrets krt.Collection[*corev1.Secret], namespace string) (*api.BackendAuthPolicy, error) {
 	var errs []error
-	if auth == nil {
-		logger.Warn("using implicit AWS auth for AI backend")
+	if auth == nil || auth.SecretRef == nil {
+		// the credentials are resolved by agentgateway with the default AWS SDK credential chain
+		logger.Debug("using implicit AWS auth for backend")
 		return &api.BackendAuthPolicy{
 			Kind: &api.BackendAuthPolicy_Aws{
 				Aws: &api.Aws{
@@ -1,12 +1,16 @@
 package plugins
 
 import (
+	"strings"
+
 	"istio.io/istio/pilot/pkg/model/kstatus"
 	"istio.io/istio/pkg/maps"
 	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/slices"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/policysupport"
 )
 
 type condition struct {
@@ -133,3 +137,8 @@ func setConditions(generation int64, existingConditions []metav1.Condition, cond
 	}
 	return existingConditions
 }
+
+// joinUnsupportedFields formats the unsupported fields of a policy for its status message.
+func joinUnsupportedFields(fields []policysupport.UnsupportedField) string {
+	return strings.Join(slices.Map(fields, policysupport.UnsupportedField.String), ", ")
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/http"
 	"strconv"
 	"strings"
 	"time"
@@ -34,10 +35,13 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/jwks_url"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/policysupport"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/celutils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
 )
 
@@ -57,7 +61,10 @@ const (
 	jwtPolicySuffix             = ":jwt"
 	basicAuthPolicySuffix       = ":basicauth"
 	apiKeyPolicySuffix          = ":apikeyauth" //nolint:gosec
+	authCompositionPolicySuffix = ":auth-composition"
 	directResponseSuffix        = ":direct-response"
+	redirectPolicySuffix        = ":redirect"
+	mirroringPolicySuffix       = ":mirroring"
 )
 
 var logger = logging.New("agentgateway/plugins")
@@ -113,8 +120,10 @@ type PolicyCtx struct {
 
 type ResolvedTarget struct {
 	AgentgatewayTarget *api.PolicyTarget
-	AncestorRefs       []gwv1.ParentReference
-	AttachmentError    string
+	// Kind is the kind of the targeted resource
+	Kind            string
+	AncestorRefs    []gwv1.ParentReference
+	AttachmentError string
 }
 
 // TranslateAgentgatewayPolicy generates policies for a single traffic policy
@@ -126,6 +135,7 @@ func TranslateAgentgatewayPolicy(
 	var agwPolicies []AgwPolicy
 
 	pctx := PolicyCtx{Krt: ctx, Collections: agw}
+	expiry := pluginsdkutils.NewPolicyExpiry(ctx, policy.Spec.ExpiresAt, time.Now())
 
 	var policyTargets []ResolvedTarget
 	// TODO: add selectors
@@ -167,6 +177,7 @@ func TranslateAgentgatewayPolicy(
 
 		policyTargets = append(policyTargets, ResolvedTarget{
 			AgentgatewayTarget: policyTarget,
+			Kind:               gk.Kind,
 			AncestorRefs:       ancestorRefs,
 			AttachmentError:    attachmentErr,
 		})
@@ -174,17 +185,42 @@ func TranslateAgentgatewayPolicy(
 
 	var ancestors []gwv1.PolicyAncestorStatus
 	for _, policyTarget := range policyTargets {
-		translatedPolicies, err := translatePolicyToAgw(pctx, policy, policyTarget.AgentgatewayTarget)
+		var (
+			translatedPolicies []AgwPolicy
+			err                error
+		)
+		// expired policies are deactivated by not translating them
+		if !expiry.IsExpired() {
+			translatedPolicies, err = translatePolicyToAgw(pctx, policy, policyTarget.AgentgatewayTarget)
+		}
 		agwPolicies = append(agwPolicies, translatedPolicies...)
+		unsupported := policysupport.Unsupported(wellknown.AgentgatewayPolicyGVK.Kind, policysupport.Agentgateway, policy.Spec, policyTarget.Kind)
 		var conds []metav1.Condition
-		if err != nil {
+		if expiry.IsExpired() {
+			meta.SetStatusCondition(&conds, metav1.Condition{
+				Type:    string(shared.PolicyConditionAccepted),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(shared.PolicyReasonExpired),
+				Message: expiry.Message(),
+			})
+			meta.SetStatusCondition(&conds, metav1.Condition{
+				Type:    string(shared.PolicyConditionAttached),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(shared.PolicyReasonExpired),
+				Message: expiry.Message(),
+			})
+		} else if err != nil {
+			message := err.Error()
+			if len(unsupported) > 0 {
+				message += "; fields not supported by agentgateway: " + joinUnsupportedFields(unsupported)
+			}
 			// If we produced some policies alongside errors, treat as partial validity
 			if len(translatedPolicies) > 0 {
 				meta.SetStatusCondition(&conds, metav1.Condition{
 					Type:    string(shared.PolicyConditionAccepted),
 					Status:  metav1.ConditionTrue,
 					Reason:  string(shared.PolicyReasonPartiallyValid),
-					Message: err.Error(),
+					Message: message,
 				})
 			} else {
 				// No policies produced and error present -> invalid
@@ -192,7 +228,7 @@ func TranslateAgentgatewayPolicy(
 					Type:    string(shared.PolicyConditionAccepted),
 					Status:  metav1.ConditionTrue,
 					Reason:  string(shared.PolicyReasonInvalid),
-					Message: err.Error(),
+					Message: message,
 				})
 				meta.SetStatusCondition(&conds, metav1.Condition{
 					Type:    string(shared.PolicyConditionAttached),
@@ -201,6 +237,30 @@ func TranslateAgentgatewayPolicy(
 					Message: "Policy is not attached due to invalid status",
 				})
 			}
+		} else if len(unsupported) > 0 {
+			// report the fields agentgateway ignores with a dedicated reason, so they are not mistaken for
+			// configuration errors
+			meta.SetStatusCondition(&conds, metav1.Condition{
+				Type:    string(shared.PolicyConditionAccepted),
+				Status:  metav1.ConditionTrue,
+				Reason:  string(shared.PolicyReasonUnsupportedField),
+				Message: "fields not supported by agentgateway: " + joinUnsupportedFields(unsupported),
+			})
+			if len(translatedPolicies) > 0 {
+				meta.SetStatusCondition(&conds, metav1.Condition{
+					Type:    string(shared.PolicyConditionAttached),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(shared.PolicyReasonAttached),
+					Message: reporter.PolicyAttachedMsg,
+				})
+			} else {
+				meta.SetStatusCondition(&conds, metav1.Condition{
+					Type:    string(shared.PolicyConditionAttached),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(shared.PolicyReasonPending),
+					Message: "Policy is not attached as none of its fields is supported by agentgateway",
+				})
+			}
 		} else {
 			// Check for partial validity
 			// Build success conditions per ancestor
@@ -216,11 +276,19 @@ func TranslateAgentgatewayPolicy(
 				Reason:  string(shared.PolicyReasonAttached),
 				Message: reporter.PolicyAttachedMsg,
 			})
+			if expiry.IsExpiring() {
+				meta.SetStatusCondition(&conds, metav1.Condition{
+					Type:    string(shared.PolicyConditionAccepted),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(shared.PolicyReasonExpiring),
+					Message: expiry.Message(),
+				})
+			}
 		}
 
 		// If we cannot resolve this policy target to a Gateway (e.g., missing HTTPRoute),
 		// report the policy as not attached instead of falling back to a higher-cardinality ancestor.
-		if policyTarget.AttachmentError != "" {
+		if policyTarget.AttachmentError != "" && !expiry.IsExpired() {
 			meta.SetStatusCondition(&conds, metav1.Condition{
 				Type:    string(shared.PolicyConditionAccepted),
 				Status:  metav1.ConditionTrue,
@@ -426,6 +494,7 @@ func translateTrafficPolicyToAgw(
 	if traffic == nil {
 		return nil, nil
 	}
+	traffic = applyAuthenticationComposition(traffic)
 
 	agwPolicies := make([]AgwPolicy, 0)
 	var errs []error
@@ -456,7 +525,11 @@ func translateTrafficPolicyToAgw(
 
 	// Convert Authorization policy if present
 	if traffic.Authorization != nil {
-		rbacPolicies := processAuthorizationPolicy(traffic.Authorization, basePolicyName, policyName, policyTarget)
+		rbacPolicies, err := processAuthorizationPolicy(traffic.Authorization, basePolicyName, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing Authorization policy", "error", err)
+			errs = append(errs, err)
+		}
 		agwPolicies = append(agwPolicies, rbacPolicies...)
 	}
 
@@ -515,11 +588,25 @@ func translateTrafficPolicyToAgw(
 		agwPolicies = append(agwPolicies, retriesPolicies...)
 	}
 
+	if traffic.Mirroring != nil {
+		mirroringPolicies, err := processMirroringPolicy(ctx, traffic.Mirroring, basePolicyName, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing mirroring policy", "error", err)
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, mirroringPolicies...)
+	}
+
 	if traffic.DirectResponse != nil {
 		directRespPolicies := processDirectResponse(traffic.DirectResponse, basePolicyName, policyName, policyTarget)
 		agwPolicies = append(agwPolicies, directRespPolicies...)
 	}
 
+	if traffic.Redirect != nil {
+		redirectPolicies := processRedirect(traffic.Redirect, basePolicyName, policyName, policyTarget)
+		agwPolicies = append(agwPolicies, redirectPolicies...)
+	}
+
 	if traffic.JWTAuthentication != nil {
 		jwtAuthenticationPolicies, err := processJWTAuthenticationPolicy(ctx, traffic.JWTAuthentication, basePolicyName, policyName, policyTarget)
 		if err != nil {
@@ -546,9 +633,136 @@ func translateTrafficPolicyToAgw(
 		}
 		agwPolicies = append(agwPolicies, basicAuthenticationPolicies...)
 	}
+
+	if traffic.AuthenticationComposition != nil {
+		compositionPolicies, err := processAuthenticationCompositionPolicy(traffic, basePolicyName, policyName, policyTarget)
+		if err != nil {
+			logger.Error("error processing authentication composition", "err", err)
+			errs = append(errs, err)
+		}
+		agwPolicies = append(agwPolicies, compositionPolicies...)
+	}
 	return agwPolicies, errors.Join(errs...)
 }
 
+// applyAuthenticationComposition returns the traffic policy with the modes of the composed authentication mechanisms
+// set for the composition: with anyOf, each mechanism validates its credential when present, and
+// processAuthenticationCompositionPolicy rejects the requests without any credential; with allOf, each mechanism
+// requires its credential.
+func applyAuthenticationComposition(traffic *agentgateway.Traffic) *agentgateway.Traffic {
+	mechanisms, anyOf := traffic.AuthenticationComposition.Mechanisms()
+	if len(mechanisms) == 0 {
+		return traffic
+	}
+	out := traffic.DeepCopy()
+	for _, m := range mechanisms {
+		switch m {
+		case shared.AuthenticationMechanismJWT:
+			if out.JWTAuthentication != nil {
+				out.JWTAuthentication.Mode = agentgateway.JWTAuthenticationModeStrict
+				if anyOf {
+					out.JWTAuthentication.Mode = agentgateway.JWTAuthenticationModeOptional
+				}
+			}
+		case shared.AuthenticationMechanismAPIKey:
+			if out.APIKeyAuthentication != nil {
+				out.APIKeyAuthentication.Mode = agentgateway.APIKeyAuthenticationModeStrict
+				if anyOf {
+					out.APIKeyAuthentication.Mode = agentgateway.APIKeyAuthenticationModeOptional
+				}
+			}
+		case shared.AuthenticationMechanismBasicAuth:
+			if out.BasicAuthentication != nil {
+				out.BasicAuthentication.Mode = agentgateway.BasicAuthenticationModeStrict
+				if anyOf {
+					out.BasicAuthentication.Mode = agentgateway.BasicAuthenticationModeOptional
+				}
+			}
+		}
+	}
+	return out
+}
+
+// processAuthenticationCompositionPolicy creates the policy denying the requests authenticated by none of the
+// mechanisms of an anyOf composition. An allOf composition needs no policy, as each mechanism is strict.
+// A request is authenticated by JWT when the validated token has the issuer claim, which is only required
+// when every provider sets an issuer: otherwise JWT is left out of the mechanisms authenticating the request,
+// so the requests are still denied without another credential, and an error is returned.
+func processAuthenticationCompositionPolicy(
+	traffic *agentgateway.Traffic,
+	basePolicyName string,
+	policy types.NamespacedName,
+	policyTarget *api.PolicyTarget,
+) ([]AgwPolicy, error) {
+	mechanisms, anyOf := traffic.AuthenticationComposition.Mechanisms()
+	if !anyOf {
+		return nil, nil
+	}
+
+	var (
+		authenticated []string
+		err           error
+	)
+	for _, m := range mechanisms {
+		switch m {
+		case shared.AuthenticationMechanismJWT:
+			if traffic.JWTAuthentication == nil {
+				continue
+			}
+			if !jwtProvidersHaveIssuer(traffic.JWTAuthentication.Providers) {
+				err = errors.New("authentication composition: JWT can only be combined with anyOf when every JWT provider sets an issuer")
+				continue
+			}
+			authenticated = append(authenticated, "has(jwt.iss)")
+		case shared.AuthenticationMechanismAPIKey:
+			authenticated = append(authenticated, "has(apiKey.key)")
+		case shared.AuthenticationMechanismBasicAuth:
+			authenticated = append(authenticated, "has(basicAuth.username)")
+		}
+	}
+
+	pol := &api.Policy{
+		Key:    basePolicyName + authCompositionPolicySuffix + attachmentName(policyTarget),
+		Name:   TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
+		Target: policyTarget,
+		Kind: &api.Policy_Traffic{
+			Traffic: &api.TrafficPolicySpec{
+				Kind: &api.TrafficPolicySpec_Authorization{
+					Authorization: &api.TrafficPolicySpec_RBAC{
+						Deny: []string{denyUnauthenticated(authenticated)},
+					},
+				},
+			},
+		},
+	}
+
+	logger.Debug("generated authentication composition policy",
+		"policy", basePolicyName,
+		"agentgateway_policy", pol.Name,
+		"target", policyTarget)
+
+	return []AgwPolicy{{Policy: pol}}, err
+}
+
+// jwtProvidersHaveIssuer returns whether all the providers set an issuer, so that the validated tokens have one.
+func jwtProvidersHaveIssuer(providers []agentgateway.JWTProvider) bool {
+	for _, p := range providers {
+		if p.Issuer == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// denyUnauthenticated returns the expression matching the requests authenticated by none of the expressions,
+// or all the requests if there is none.
+func denyUnauthenticated(authenticated []string) string {
+	if len(authenticated) == 0 {
+		return "true"
+	}
+	return "!(" + strings.Join(authenticated, " || ") + ")"
+}
+
 func processRetriesPolicy(retry *agentgateway.Retry, basePolicyName string, policy types.NamespacedName, target *api.PolicyTarget) ([]AgwPolicy, error) {
 	translatedRetry := &api.Retry{}
 
@@ -593,6 +807,43 @@ func processRetriesPolicy(retry *agentgateway.Retry, basePolicyName string, poli
 	return []AgwPolicy{{Policy: retryPolicy}}, nil
 }
 
+func processMirroringPolicy(ctx PolicyCtx, mirroring *agentgateway.RequestMirroring, basePolicyName string, policy types.NamespacedName, target *api.PolicyTarget) ([]AgwPolicy, error) {
+	be, err := buildBackendRef(ctx, mirroring.BackendRef, policy.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build mirroring backend: %w", err)
+	}
+
+	percentage := float64(100)
+	if mirroring.Percentage != nil {
+		percentage = float64(*mirroring.Percentage)
+	}
+
+	mirroringPolicy := &api.Policy{
+		Key:    basePolicyName + mirroringPolicySuffix + attachmentName(target),
+		Name:   TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
+		Target: target,
+		Kind: &api.Policy_Traffic{
+			Traffic: &api.TrafficPolicySpec{
+				Kind: &api.TrafficPolicySpec_RequestMirror{
+					RequestMirror: &api.RequestMirrors{
+						Mirrors: []*api.RequestMirrors_Mirror{{
+							Backend:    be,
+							Percentage: percentage,
+						}},
+					},
+				},
+			},
+		},
+	}
+
+	logger.Debug("generated mirroring policy",
+		"policy", basePolicyName,
+		"agentgateway_policy", mirroringPolicy.Name,
+		"target", target)
+
+	return []AgwPolicy{{Policy: mirroringPolicy}}, nil
+}
+
 func processDirectResponse(directResponse *agentgateway.DirectResponse, basePolicyName string, policy types.NamespacedName, target *api.PolicyTarget) []AgwPolicy {
 	tp := &api.TrafficPolicySpec{
 		Kind: &api.TrafficPolicySpec_DirectResponse{
@@ -624,6 +875,44 @@ func processDirectResponse(directResponse *agentgateway.DirectResponse, basePoli
 	return []AgwPolicy{{Policy: directRespPolicy}}
 }
 
+// processRedirect converts a redirect to an agentgateway RequestRedirect policy.
+// The path regex and query stripping are rejected by the CRD validation, as agentgateway does not support them.
+func processRedirect(redirect *shared.RequestRedirect, basePolicyName string, policy types.NamespacedName, target *api.PolicyTarget) []AgwPolicy {
+	rr := &api.RequestRedirect{}
+	if redirect.Scheme != nil {
+		rr.Scheme = *redirect.Scheme
+	}
+	if redirect.Hostname != nil {
+		rr.Host = string(*redirect.Hostname)
+	}
+	if redirect.Port != nil {
+		rr.Port = uint32(*redirect.Port) //nolint:gosec // G115: Gateway API PortNumber is int32 with validation 1-65535, always safe
+	}
+	// the status code defaults to 302, set it explicitly rather than relying on the default of agentgateway
+	rr.Status = http.StatusFound
+	if redirect.StatusCode != nil {
+		rr.Status = uint32(*redirect.StatusCode) //nolint:gosec // G115: kubebuilder validation ensures a redirect status code
+	}
+
+	redirectPolicy := &api.Policy{
+		Key:    basePolicyName + redirectPolicySuffix + attachmentName(target),
+		Name:   TypedResourceFromName(wellknown.AgentgatewayPolicyGVK.Kind, policy),
+		Target: target,
+		Kind: &api.Policy_Traffic{
+			Traffic: &api.TrafficPolicySpec{
+				Kind: &api.TrafficPolicySpec_RequestRedirect{RequestRedirect: rr},
+			},
+		},
+	}
+
+	logger.Debug("generated redirect policy",
+		"policy", basePolicyName,
+		"agentgateway_policy", redirectPolicy.Name,
+		"target", target)
+
+	return []AgwPolicy{{Policy: redirectPolicy}}
+}
+
 func processJWTAuthenticationPolicy(ctx PolicyCtx, jwt *agentgateway.JWTAuthentication, basePolicyName string, policy types.NamespacedName, target *api.PolicyTarget) ([]AgwPolicy, error) {
 	p := &api.TrafficPolicySpec_JWT{}
 
@@ -1085,18 +1374,28 @@ func castPtr[T ~string](item *T) *string {
 	return ptr.Of(string(*item))
 }
 
-// processAuthorizationPolicy processes Authorization configuration and creates corresponding Agw policies
+// processAuthorizationPolicy processes Authorization configuration and creates corresponding Agw policies.
+// When the rule cannot be translated, the error is returned with a policy denying all the requests.
 func processAuthorizationPolicy(
 	auth *shared.Authorization,
 	basePolicyName string,
 	policy types.NamespacedName,
 	policyTarget *api.PolicyTarget,
-) []AgwPolicy {
+) ([]AgwPolicy, error) {
 	var allowPolicies, denyPolicies []string
-	if auth.Action == shared.AuthorizationPolicyActionDeny {
-		denyPolicies = append(denyPolicies, cast(auth.Policy.MatchExpressions)...)
-	} else {
-		allowPolicies = append(allowPolicies, cast(auth.Policy.MatchExpressions)...)
+	expressions, err := authorizationExpressions(auth, agentgatewayAuthorizationAttributes)
+	switch {
+	case auth.Action == shared.AuthorizationPolicyActionAudit:
+		// Audit rules are not supported, and dropping them does not allow more requests; the status reports the
+		// unsupported action
+		return nil, nil
+	case err != nil:
+		// Dropping the rule would allow the requests an Allow rule does not match, so deny all the requests instead
+		denyPolicies = []string{"true"}
+	case auth.Action == shared.AuthorizationPolicyActionDeny:
+		denyPolicies = append(denyPolicies, expressions...)
+	default:
+		allowPolicies = append(allowPolicies, expressions...)
 	}
 
 	pol := &api.Policy{
@@ -1120,7 +1419,36 @@ func processAuthorizationPolicy(
 		"agentgateway_policy", pol.Name,
 		"target", policyTarget)
 
-	return []AgwPolicy{{Policy: pol}}
+	return []AgwPolicy{{Policy: pol}}, err
+}
+
+// agentgatewayAuthorizationAttributes are the agentgateway request attributes used by the typed authorization matchers.
+var agentgatewayAuthorizationAttributes = celutils.AuthorizationAttributes{
+	Path:          "request.path",
+	JWTClaims:     "jwt",
+	SourceAddress: "source.address",
+}
+
+// agentgatewayMCPAuthorizationAttributes are the attributes used by the typed matchers of the MCP authorization of
+// backends, which can also match the MCP tool, prompt or resource targeted by the request.
+var agentgatewayMCPAuthorizationAttributes = celutils.AuthorizationAttributes{
+	Path:          "request.path",
+	JWTClaims:     "jwt",
+	SourceAddress: "source.address",
+	MCP:           "mcp",
+}
+
+// authorizationExpressions returns the CEL expressions of the policy, including the ones compiled from its typed matchers.
+func authorizationExpressions(auth *shared.Authorization, attrs celutils.AuthorizationAttributes) ([]string, error) {
+	expressions := cast(auth.Policy.MatchExpressions)
+	for _, m := range auth.Policy.Matchers {
+		expression, err := celutils.AuthorizationMatcherExpression(m, attrs)
+		if err != nil {
+			return nil, err
+		}
+		expressions = append(expressions, expression)
+	}
+	return expressions, nil
 }
 
 func getFrontendPolicyName(trafficPolicyNs, trafficPolicyName string) string {
@@ -1141,11 +1469,8 @@ func processRateLimitPolicy(ctx PolicyCtx, rl *agentgateway.RateLimits, basePoli
 	var errs []error
 
 	// Process local rate limiting if present
-	if rl.Local != nil {
-		localPolicy := processLocalRateLimitPolicy(rl.Local, basePolicyName, policy, policyTarget)
-		if localPolicy != nil {
-			agwPolicies = append(agwPolicies, *localPolicy)
-		}
+	if len(rl.Local) > 0 {
+		agwPolicies = append(agwPolicies, processLocalRateLimitPolicy(rl.Local, basePolicyName, policy, policyTarget))
 	}
 
 	// Process global rate limiting if present
@@ -1161,9 +1486,9 @@ func processRateLimitPolicy(ctx PolicyCtx, rl *agentgateway.RateLimits, basePoli
 	return agwPolicies, errors.Join(errs...)
 }
 
-// processLocalRateLimitPolicy processes local rate limiting configuration
-func processLocalRateLimitPolicy(limits []agentgateway.LocalRateLimit, basePolicyName string, policy types.NamespacedName, policyTarget *api.PolicyTarget) *AgwPolicy {
-	// TODO: support multiple
+// processLocalRateLimitPolicy processes local rate limiting configuration. agentgateway keeps a single local rate
+// limit per target, so only the first limit is translated; the others are reported as unsupported in the status.
+func processLocalRateLimitPolicy(limits []agentgateway.LocalRateLimit, basePolicyName string, policy types.NamespacedName, policyTarget *api.PolicyTarget) AgwPolicy {
 	limit := limits[0]
 
 	rule := &api.TrafficPolicySpec_LocalRateLimit{
@@ -1201,7 +1526,7 @@ func processLocalRateLimitPolicy(limits []agentgateway.LocalRateLimit, basePolic
 		},
 	}
 
-	return &AgwPolicy{Policy: localRateLimitPolicy}
+	return AgwPolicy{Policy: localRateLimitPolicy}
 }
 
 func processGlobalRateLimitPolicy(
@@ -0,0 +1,86 @@
+// Package testing provides helpers to unit test the translation of agentgateway plugins without a cluster.
+package testing
+
+import (
+	"cmp"
+	"slices"
+
+	"github.com/agentgateway/agentgateway/go/api"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/test"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/testutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/agentgatewaysyncer"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+)
+
+// PluginFactory builds a plugin from the collections of the objects under test, such as plugins.NewAgentPlugin.
+type PluginFactory func(agw *plugins.AgwCollections) plugins.AgwPlugin
+
+// Output is what a plugin produces for a set of objects.
+type Output struct {
+	// Policies are the policies contributed by the plugin, sorted by key.
+	Policies []*api.Policy
+	// Resources are the resources added by the plugin and its policies, as sent to the proxies, sorted by name.
+	Resources []ir.AgwResource
+	// Statuses are the statuses the plugin reports for its policy objects.
+	Statuses map[utils.TypedNamespacedName]gwv1.PolicyStatus
+}
+
+// Translate runs the plugin built by newPlugin against the objects and returns what it produces.
+// Objects can be Kubernetes objects or YAML documents, as accepted by krttest.NewMock.
+//
+// The plugin is given no policy ancestors, so policies targeting backends report no ancestor statuses. Routes are not
+// translated, so all the policies are global, including the ones targeting a route.
+func Translate(t test.Failer, newPlugin PluginFactory, objects ...any) Output {
+	stop := test.NewStop(t)
+	krtopts := krtutil.NewKrtOptions(stop, new(krt.DebugHandler))
+
+	agw := testutils.BuildMockCollection(t, objects)
+	agw.KrtOpts = krtopts
+	plugin := newPlugin(agw)
+
+	ancestors := krt.NewStaticCollection[*utils.AncestorBackend](nil, nil, krtopts.ToOptions("AncestorBackend")...)
+	policies, statuses := agentgatewaysyncer.AgwPolicyCollection(plugin, ancestors, nil, krtopts)
+
+	out := Output{
+		Statuses: map[utils.TypedNamespacedName]gwv1.PolicyStatus{},
+	}
+	policies.WaitUntilSynced(stop)
+	for _, res := range policies.List() {
+		out.Resources = append(out.Resources, res)
+		out.Policies = append(out.Policies, res.Resource.GetPolicy())
+	}
+	if ext := plugin.AddResourceExtension; ext != nil {
+		for _, col := range []krt.Collection[ir.AgwResource]{ext.Binds, ext.Listeners, ext.Routes} {
+			if col == nil {
+				continue
+			}
+			col.WaitUntilSynced(stop)
+			out.Resources = append(out.Resources, col.List()...)
+		}
+	}
+	for gk, col := range statuses {
+		col.WaitUntilSynced(stop)
+		for _, st := range col.List() {
+			key := utils.TypedNamespacedName{
+				NamespacedName: types.NamespacedName{Namespace: st.Obj.GetNamespace(), Name: st.Obj.GetName()},
+				Kind:           gk.Kind,
+			}
+			out.Statuses[key] = st.Status
+		}
+	}
+
+	slices.SortFunc(out.Policies, func(a, b *api.Policy) int {
+		return cmp.Compare(a.GetKey(), b.GetKey())
+	})
+	slices.SortFunc(out.Resources, func(a, b ir.AgwResource) int {
+		return cmp.Compare(a.ResourceName(), b.ResourceName())
+	})
+	return out
+}
@@ -0,0 +1,62 @@
+package testing_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
+	agwtesting "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/testing"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/utils"
+)
+
+const gateway = `apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: test
+  namespace: default
+spec:
+  gatewayClassName: agentgateway
+  listeners:
+  - name: http
+    protocol: HTTP
+    port: 8080
+`
+
+const csrfPolicy = `apiVersion: agentgateway.dev/v1alpha1
+kind: AgentgatewayPolicy
+metadata:
+  name: agw
+  namespace: default
+spec:
+  targetRefs:
+  - kind: Gateway
+    name: test
+    group: gateway.networking.k8s.io
+  traffic:
+    csrf:
+      additionalOrigins:
+      - https://example.com
+`
+
+func TestTranslate(t *testing.T) {
+	r := require.New(t)
+
+	out := agwtesting.Translate(t, plugins.NewAgentPlugin, gateway, csrfPolicy)
+
+	r.Len(out.Policies, 1)
+	r.Equal("traffic/default/agw:csrf:default/test", out.Policies[0].GetKey())
+	r.Equal([]string{"https://example.com"}, out.Policies[0].GetTraffic().GetCsrf().GetAdditionalOrigins())
+	r.Len(out.Resources, 1)
+	r.Equal("policy/traffic/default/agw:csrf:default/test", out.Resources[0].ResourceName())
+
+	st, ok := out.Statuses[utils.TypedNamespacedName{
+		NamespacedName: types.NamespacedName{Namespace: "default", Name: "agw"},
+		Kind:           "AgentgatewayPolicy",
+	}]
+	r.True(ok)
+	r.Len(st.Ancestors, 1)
+	r.Equal(string(shared.PolicyReasonValid), st.Ancestors[0].Conditions[0].Reason)
+}
@@ -5,6 +5,7 @@ import (
 
 	"istio.io/istio/pkg/config/schema/kubeclient"
 	"istio.io/istio/pkg/kube/kubetypes"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
@@ -160,4 +161,19 @@ func RegisterTypes() {
 			return c.(Client).Kgateway().GatewayAgentgateway().AgentgatewayParameters(namespace)
 		},
 	)
+
+	// kubernetes types not registered by istio
+	kubeclient.Register(
+		wellknown.ClusterTrustBundleGVR,
+		wellknown.ClusterTrustBundleGVK,
+		func(c kubeclient.ClientGetter, _ string, o metav1.ListOptions) (runtime.Object, error) {
+			return c.Kube().CertificatesV1beta1().ClusterTrustBundles().List(context.Background(), o)
+		},
+		func(c kubeclient.ClientGetter, _ string, o metav1.ListOptions) (watch.Interface, error) {
+			return c.Kube().CertificatesV1beta1().ClusterTrustBundles().Watch(context.Background(), o)
+		},
+		func(c kubeclient.ClientGetter, _ string) kubetypes.WriteAPI[*certificatesv1beta1.ClusterTrustBundle] {
+			return c.Kube().CertificatesV1beta1().ClusterTrustBundles()
+		},
+	)
 }
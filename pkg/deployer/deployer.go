@@ -19,7 +19,9 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	inf "sigs.k8s.io/gateway-api-inference-extension/api/v1"
@@ -404,6 +406,59 @@ func (d *Deployer) DeployObjsWithSource(ctx context.Context, objs []client.Objec
 	return nil
 }
 
+// PruneStaleObjs deletes the objects of existing that are controlled by owner and of the same kind as,
+// but not part of, objs. This removes the resources previously generated for the owner when the
+// names of its generated resources change, e.g. after the GatewayParameters resourceNaming is updated.
+// existing holds the objects of the owner namespace by kind, and is expected to be read from an
+// informer cache so that pruning does not list the objects from the API server on every reconcile.
+func (d *Deployer) PruneStaleObjs(
+	ctx context.Context,
+	owner client.Object,
+	objs []client.Object,
+	existing map[schema.GroupVersionKind][]client.Object,
+) error {
+	desired := map[schema.GroupVersionKind]sets.Set[string]{}
+	for _, obj := range objs {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		if !kubeutils.IsNamespacedGVK(gvk) || obj.GetNamespace() != owner.GetNamespace() {
+			continue
+		}
+		if desired[gvk] == nil {
+			desired[gvk] = sets.New[string]()
+		}
+		desired[gvk].Insert(obj.GetName())
+	}
+
+	for gvk, names := range desired {
+		var c dynamic.ResourceInterface
+		for _, obj := range existing[gvk] {
+			if obj.GetNamespace() != owner.GetNamespace() || names.Has(obj.GetName()) {
+				continue
+			}
+			ref := metav1.GetControllerOf(obj)
+			if ref == nil || ref.UID != owner.GetUID() {
+				continue
+			}
+			if c == nil {
+				gvr, err := d.gvkToGVR(gvk)
+				if err != nil {
+					return fmt.Errorf("error getting GVR for %s: %w", gvk.String(), err)
+				}
+				c = d.client.Dynamic().Resource(gvr).Namespace(owner.GetNamespace())
+			}
+			logger.Info("deleting stale object",
+				"kind", gvk.String(),
+				"namespace", obj.GetNamespace(),
+				"name", obj.GetName(),
+				"owner", owner.GetName())
+			if err := c.Delete(ctx, obj.GetName(), metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+				return fmt.Errorf("failed to delete stale object %s %s/%s: %w", gvk.String(), obj.GetNamespace(), obj.GetName(), err)
+			}
+		}
+	}
+	return nil
+}
+
 func (d *Deployer) gvkToGVR(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
 	// 1. Try our lib
 	gvr, err := wellknown.GVKToGVR(gvk)
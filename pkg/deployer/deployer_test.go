@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -2854,4 +2855,47 @@ var _ = Describe("DeployObjs", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(usedFieldManager).To(Equal(wellknown.DefaultAgwControllerName))
 	})
+
+	It("prunes stale objects controlled by the owner", func() {
+		gw := &gwv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-gw", Namespace: ns, UID: "12345"},
+		}
+		controlledBy := func(uid string) []metav1.OwnerReference {
+			return []metav1.OwnerReference{{
+				APIVersion: wellknown.GatewayGVK.GroupVersion().String(),
+				Kind:       wellknown.GatewayGVK.Kind,
+				Name:       "test-gw",
+				UID:        k8stypes.UID(uid),
+				Controller: ptr.To(true),
+			}}
+		}
+		configMap := func(name string, owners []metav1.OwnerReference) *corev1.ConfigMap {
+			return &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{Kind: gvk.ConfigMap.Kind, APIVersion: gvk.ConfigMap.GroupVersion()},
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns, OwnerReferences: owners},
+			}
+		}
+		current := configMap("kgw-test-gw", controlledBy("12345"))
+		stale := configMap("test-gw", controlledBy("12345"))
+		otherOwner := configMap("other-gw", controlledBy("67890"))
+		unowned := configMap("user-config", nil)
+
+		fc := fake.NewClient(GinkgoT(), current.DeepCopy(), stale.DeepCopy(), otherOwner.DeepCopy(), unowned.DeepCopy())
+		d := getDeployer(fc, nil)
+		fc.RunAndWait(context.Background().Done())
+
+		existing := map[schema.GroupVersionKind][]client.Object{
+			wellknown.ConfigMapGVK: {current, stale, otherOwner, unowned},
+		}
+		err := d.PruneStaleObjs(ctx, gw, []client.Object{current}, existing)
+		Expect(err).ToNot(HaveOccurred())
+
+		cms, err := fc.Dynamic().Resource(corev1.SchemeGroupVersion.WithResource("configmaps")).Namespace(ns).List(ctx, metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		var names []string
+		for _, cm := range cms.Items {
+			names = append(names, cm.GetName())
+		}
+		Expect(names).To(ConsistOf("kgw-test-gw", "other-gw", "user-config"))
+	})
 })
@@ -24,6 +24,10 @@ type Inputs struct {
 	WaypointGatewayClassName   string
 	AgentgatewayClassName      string
 	AgentgatewayControllerName string
+	// PropagatedLabelPrefixes is the allowlist of Gateway label key prefixes propagated onto generated resources.
+	PropagatedLabelPrefixes []string
+	// PropagatedAnnotationPrefixes is the allowlist of Gateway annotation key prefixes propagated onto generated resources.
+	PropagatedAnnotationPrefixes []string
 }
 
 // UpdateSecurityContexts updates the security contexts in the gateway parameters.
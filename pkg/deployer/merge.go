@@ -40,6 +40,7 @@ func DeepMergeGatewayParameters(dst, src *kgateway.GatewayParameters) {
 	dstKube.Istio = deepMergeIstioIntegration(dstKube.GetIstio(), srcKube.GetIstio())
 	dstKube.Stats = deepMergeStatsConfig(dstKube.GetStats(), srcKube.GetStats())
 	dstKube.OmitDefaultSecurityContext = MergePointers(dstKube.GetOmitDefaultSecurityContext(), srcKube.GetOmitDefaultSecurityContext())
+	dstKube.ResourceNaming = deepMergeResourceNaming(dstKube.GetResourceNaming(), srcKube.GetResourceNaming())
 }
 
 // MergePointers will decide whether to use dst or src without dereferencing or recursing
@@ -122,6 +123,22 @@ func deepMergeStatsConfig(dst *kgateway.StatsConfig, src *kgateway.StatsConfig)
 	return dst
 }
 
+func deepMergeResourceNaming(dst, src *kgateway.ResourceNaming) *kgateway.ResourceNaming {
+	// nil src override means just use dst
+	if src == nil {
+		return dst
+	}
+
+	if dst == nil {
+		return src
+	}
+
+	dst.Prefix = MergePointers(dst.GetPrefix(), src.GetPrefix())
+	dst.Suffix = MergePointers(dst.GetSuffix(), src.GetSuffix())
+
+	return dst
+}
+
 func deepMergePodTemplate(dst, src *kgateway.Pod) *kgateway.Pod {
 	// nil src override means just use dst
 	if src == nil {
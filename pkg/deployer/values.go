@@ -3,6 +3,7 @@ package deployer
 import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -92,6 +93,9 @@ type HelmGateway struct {
 	// stats values
 	Stats *HelmStatsConfig `json:"stats,omitempty"`
 
+	// tap values
+	Tap *HelmTap `json:"tap,omitempty"`
+
 	// LogFormat specifies the logging format for agentgateway (Json or Text)
 	LogFormat *string `json:"logFormat,omitempty"`
 	// RawConfig provides opaque config to be merged into config.yaml
@@ -147,6 +151,11 @@ type HelmDnsResolver struct {
 	UdpMaxQueries *int32 `json:"udpMaxQueries,omitempty"`
 }
 
+// HelmTap configures the volume the tap file sinks of the proxy write captures to.
+type HelmTap struct {
+	SizeLimit *resource.Quantity `json:"sizeLimit,omitempty"`
+}
+
 type HelmIstio struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
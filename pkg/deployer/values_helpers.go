@@ -1,6 +1,8 @@
 package deployer
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/netip"
@@ -72,6 +74,23 @@ func GetPortsValues(gw *ir.GatewayForDeployer, gwp *kgateway.GatewayParameters,
 	return gwPorts
 }
 
+// maxResourceNameLength is the maximum length of a generated resource name. Some
+// Kubernetes name fields are limited to 63 characters (by the DNS naming spec).
+const maxResourceNameLength = 63
+
+// GetResourceName returns the name of the Kubernetes resources generated for the Gateway,
+// applying the prefix and suffix configured in naming. Names that would exceed 63 characters
+// are truncated and suffixed with a stable hash of the full name, matching the
+// `kgateway.gateway.safeLabelValue` helm helper.
+func GetResourceName(gwName string, naming *kgateway.ResourceNaming) string {
+	name := ptr.Deref(naming.GetPrefix(), "") + gwName + ptr.Deref(naming.GetSuffix(), "")
+	if len(name) <= maxResourceNameLength {
+		return name
+	}
+	hash := sha256.Sum256([]byte(name))
+	return strings.TrimSuffix(name[:50], "-") + "-" + hex.EncodeToString(hash[:])[:12]
+}
+
 func SanitizePortName(name string) string {
 	nonAlphanumericRegex := regexp.MustCompile(`[^a-zA-Z0-9-]+`)
 	str := nonAlphanumericRegex.ReplaceAllString(name, "-")
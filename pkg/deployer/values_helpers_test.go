@@ -8,6 +8,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 )
 
 func TestComponentLogLevelsToString(t *testing.T) {
@@ -232,3 +234,38 @@ func TestSetLoadBalancerIPFromGateway(t *testing.T) {
 		})
 	}
 }
+
+func TestGetResourceName(t *testing.T) {
+	tests := []struct {
+		name   string
+		gwName string
+		naming *kgateway.ResourceNaming
+		want   string
+	}{
+		{
+			name:   "no naming uses the gateway name",
+			gwName: "gw",
+			want:   "gw",
+		},
+		{
+			name:   "prefix and suffix",
+			gwName: "gw",
+			naming: &kgateway.ResourceNaming{Prefix: ptr.To("kgw-"), Suffix: ptr.To("-proxy")},
+			want:   "kgw-gw-proxy",
+		},
+		{
+			name:   "long names are truncated with a stable hash",
+			gwName: "a-very-long-gateway-name-that-is-close-to-the-limit-already",
+			naming: &kgateway.ResourceNaming{Suffix: ptr.To("-proxy")},
+			want:   "a-very-long-gateway-name-that-is-close-to-the-limi-e55d64218ce0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetResourceName(tt.gwName, tt.naming)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, len(got), maxResourceNameLength)
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+// adminResource is the virtual resource of the gateway.kgateway.dev group that the callers of the admin server
+// are authorized against. The name of the resource is the path of the endpoint without the leading slash, e.g.
+// snapshots/xds, and the verb is get for reads and create for actions, such as forcing a push:
+//
+//	rules:
+//	- apiGroups: ["gateway.kgateway.dev"]
+//	  resources: ["admin"]
+//	  resourceNames: ["snapshots/xds", "snapshots/xds/resync"]
+//	  verbs: ["get", "create"]
+const adminResource = "admin"
+
+const bearerTokenPrefix = "Bearer "
+
+// adminAuthorizer authenticates the callers of the admin server with their Kubernetes bearer token, through a
+// TokenReview, and authorizes them through a SubjectAccessReview, so that cluster RBAC governs who can read
+// the gateway configuration or force pushes.
+type adminAuthorizer struct {
+	kubeClient kubernetes.Interface
+}
+
+func newAdminAuthorizer(kubeClient kubernetes.Interface) *adminAuthorizer {
+	return &adminAuthorizer{kubeClient: kubeClient}
+}
+
+// wrap returns a handler only calling next for authorized requests.
+func (a *adminAuthorizer) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), bearerTokenPrefix)
+		if !ok || token == "" {
+			writeJSONError(w, http.StatusUnauthorized, errors.New("a Kubernetes bearer token is required"), r)
+			return
+		}
+
+		user, err := a.authenticate(r.Context(), token)
+		if err != nil {
+			slog.Debug("admin server authentication failed", "path", r.URL.Path, "error", err)
+			writeJSONError(w, http.StatusUnauthorized, err, r)
+			return
+		}
+
+		verb, name := adminVerb(r.Method), strings.TrimPrefix(r.URL.Path, "/")
+		allowed, err := a.authorize(r.Context(), user, verb, name)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err, r)
+			return
+		}
+		if !allowed {
+			slog.Debug("admin server authorization denied", "user", user.Username, "verb", verb, "name", name)
+			writeJSONError(w, http.StatusForbidden, fmt.Errorf("user %q cannot %s %s %q", user.Username, verb, adminResource, name), r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (a *adminAuthorizer) authenticate(ctx context.Context, token string) (authenticationv1.UserInfo, error) {
+	review, err := a.kubeClient.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return authenticationv1.UserInfo{}, fmt.Errorf("failed to review the token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		if review.Status.Error != "" {
+			return authenticationv1.UserInfo{}, fmt.Errorf("invalid token: %s", review.Status.Error)
+		}
+		return authenticationv1.UserInfo{}, errors.New("invalid token")
+	}
+	return review.Status.User, nil
+}
+
+func (a *adminAuthorizer) authorize(ctx context.Context, user authenticationv1.UserInfo, verb, name string) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	review, err := a.kubeClient.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Group:    kgateway.GroupName,
+				Resource: adminResource,
+				Name:     name,
+				Verb:     verb,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, fmt.Errorf("failed to review the access: %w", err)
+	}
+	return review.Status.Allowed, nil
+}
+
+// adminVerb returns the RBAC verb of the request: get for reads, and create for actions.
+func adminVerb(method string) string {
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return "get"
+	default:
+		return "create"
+	}
+}
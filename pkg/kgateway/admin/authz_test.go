@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+// newFakeAuthzClient returns a client authenticating the "valid" token as user "alice", and allowing alice to
+// get snapshots/xds only.
+func newFakeAuthzClient() *fake.Clientset {
+	client := fake.NewClientset()
+	client.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenReview)
+		if review.Spec.Token == "valid" {
+			review.Status = authenticationv1.TokenReviewStatus{
+				Authenticated: true,
+				User:          authenticationv1.UserInfo{Username: "alice", Groups: []string{"system:authenticated"}},
+			}
+		}
+		return true, review, nil
+	})
+	client.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SubjectAccessReview)
+		attrs := review.Spec.ResourceAttributes
+		review.Status.Allowed = review.Spec.User == "alice" &&
+			attrs.Group == "gateway.kgateway.dev" &&
+			attrs.Resource == adminResource &&
+			attrs.Name == "snapshots/xds" &&
+			attrs.Verb == "get"
+		return true, review, nil
+	})
+	return client
+}
+
+func TestAdminAuthorizer(t *testing.T) {
+	handler := newAdminAuthorizer(newFakeAuthzClient()).wrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		token  string
+		want   int
+	}{
+		{name: "no token", method: http.MethodGet, path: "/snapshots/xds", want: http.StatusUnauthorized},
+		{name: "invalid token", method: http.MethodGet, path: "/snapshots/xds", token: "invalid", want: http.StatusUnauthorized},
+		{name: "allowed", method: http.MethodGet, path: "/snapshots/xds", token: "valid", want: http.StatusOK},
+		{name: "other endpoint", method: http.MethodGet, path: "/snapshots/krt", token: "valid", want: http.StatusForbidden},
+		{name: "action", method: http.MethodPost, path: "/snapshots/xds", token: "valid", want: http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
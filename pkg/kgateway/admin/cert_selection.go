@@ -0,0 +1,156 @@
+package admin
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/sslutils"
+)
+
+// The cert selection report shows, for each listener of each proxy, the filter chain and certificates Envoy
+// selects for each server name (SNI), in the order Envoy matches them.
+func addCertSelectionHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, cache cache.SnapshotCache) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if cache == nil {
+			writeJSON(w, map[string]string{"error": "Envoy xDS cache not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+		writeJSON(w, completeSnapshotResponse(getCertSelection(cache)), r)
+	})
+	profiles[path] = func() string { return "SNI to certificate mapping of the TLS listeners (Envoy only)" }
+}
+
+// listenerCertSelection is the SNI to certificate mapping of a listener.
+type listenerCertSelection struct {
+	Listener string                    `json:"listener"`
+	Mappings []serverNameCertSelection `json:"mappings"`
+}
+
+// serverNameCertSelection is the filter chain and certificates selected for a server name. An empty server name is
+// the filter chain matching the server names no other filter chain matches.
+type serverNameCertSelection struct {
+	ServerName  string `json:"serverName"`
+	FilterChain string `json:"filterChain"`
+	// Certificates are the certificates of the filter chain, in the order Envoy prefers them for the server name.
+	Certificates []certificateSelection `json:"certificates,omitempty"`
+	// Overlapping are the hostnames matched equally well by more than one certificate of the filter chain.
+	Overlapping []string `json:"overlapping,omitempty"`
+}
+
+type certificateSelection struct {
+	// Index is the index of the certificate in the filter chain.
+	Index int `json:"index"`
+	sslutils.CertificateInfo
+	Match string `json:"match"`
+	Error string `json:"error,omitempty"`
+}
+
+func getCertSelection(xdsCache cache.SnapshotCache) map[string]any {
+	cacheKeys := xdsCache.GetStatusKeys()
+	out := make(map[string]any, len(cacheKeys))
+	for _, k := range cacheKeys {
+		snap, err := getXdsSnapshot(xdsCache, k)
+		if err != nil {
+			out[k] = err.Error()
+			continue
+		}
+		var listeners []listenerCertSelection
+		for _, res := range snap.GetResources(resource.ListenerType) {
+			l, ok := res.(*envoylistenerv3.Listener)
+			if !ok {
+				continue
+			}
+			if selection := certSelectionForListener(l); len(selection.Mappings) > 0 {
+				listeners = append(listeners, selection)
+			}
+		}
+		slices.SortFunc(listeners, func(a, b listenerCertSelection) int {
+			return strings.Compare(a.Listener, b.Listener)
+		})
+		out[k] = listeners
+	}
+	return out
+}
+
+func certSelectionForListener(l *envoylistenerv3.Listener) listenerCertSelection {
+	out := listenerCertSelection{Listener: l.GetName()}
+	for _, fc := range l.GetFilterChains() {
+		tlsContext := downstreamTlsContext(fc)
+		if tlsContext == nil {
+			continue
+		}
+		certs, errs := certificateInfos(tlsContext)
+		overlapping := sslutils.OverlappingCertificateHostnames(certs)
+		serverNames := fc.GetFilterChainMatch().GetServerNames()
+		if len(serverNames) == 0 {
+			serverNames = []string{""}
+		}
+		for _, serverName := range serverNames {
+			mapping := serverNameCertSelection{
+				ServerName:  serverName,
+				FilterChain: fc.GetName(),
+				Overlapping: overlapping,
+			}
+			for _, i := range sslutils.SelectCertificates(serverName, certs) {
+				mapping.Certificates = append(mapping.Certificates, certificateSelection{
+					Index:           i,
+					CertificateInfo: certs[i],
+					Match:           certificateMatchName(certs[i].Match(serverName)),
+					Error:           errs[i],
+				})
+			}
+			out.Mappings = append(out.Mappings, mapping)
+		}
+	}
+	slices.SortStableFunc(out.Mappings, func(a, b serverNameCertSelection) int {
+		return sslutils.CompareServerNames(a.ServerName, b.ServerName)
+	})
+	return out
+}
+
+// downstreamTlsContext returns the TLS context of a filter chain terminating TLS, or nil.
+func downstreamTlsContext(fc *envoylistenerv3.FilterChain) *envoytlsv3.DownstreamTlsContext {
+	typedConfig := fc.GetTransportSocket().GetTypedConfig()
+	if typedConfig == nil {
+		return nil
+	}
+	out := &envoytlsv3.DownstreamTlsContext{}
+	if err := typedConfig.UnmarshalTo(out); err != nil {
+		return nil
+	}
+	return out
+}
+
+// certificateInfos parses the inline certificates of a TLS context. The certificates that cannot be parsed are
+// kept with an error, so the indexes match the ones of the filter chain.
+func certificateInfos(tlsContext *envoytlsv3.DownstreamTlsContext) ([]sslutils.CertificateInfo, []string) {
+	tlsCertificates := tlsContext.GetCommonTlsContext().GetTlsCertificates()
+	certs := make([]sslutils.CertificateInfo, len(tlsCertificates))
+	errs := make([]string, len(tlsCertificates))
+	for i, c := range tlsCertificates {
+		info, err := sslutils.ParseCertificateInfo(c.GetCertificateChain().GetInlineBytes())
+		if err != nil {
+			errs[i] = err.Error()
+			continue
+		}
+		certs[i] = info
+	}
+	return certs, errs
+}
+
+func certificateMatchName(m sslutils.CertificateMatch) string {
+	switch m {
+	case sslutils.CertificateMatchExact:
+		return "Exact"
+	case sslutils.CertificateMatchWildcard:
+		return "Wildcard"
+	default:
+		return "None"
+	}
+}
@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestCertSelectionForListener(t *testing.T) {
+	r := require.New(t)
+
+	listener := &envoylistenerv3.Listener{
+		Name: "listener~443",
+		FilterChains: []*envoylistenerv3.FilterChain{
+			{
+				Name:             "wildcard",
+				FilterChainMatch: &envoylistenerv3.FilterChainMatch{ServerNames: []string{"*.example.com"}},
+				TransportSocket:  tlsTransportSocket(t, "*.example.com"),
+			},
+			{
+				Name:             "foo",
+				FilterChainMatch: &envoylistenerv3.FilterChainMatch{ServerNames: []string{"foo.example.com"}},
+				TransportSocket:  tlsTransportSocket(t, "*.example.com", "foo.example.com", "foo.example.com"),
+			},
+			{
+				Name:            "default",
+				TransportSocket: tlsTransportSocket(t, "default.example.org"),
+			},
+			{
+				Name: "plaintext",
+			},
+		},
+	}
+
+	out := certSelectionForListener(listener)
+
+	r.Equal("listener~443", out.Listener)
+	r.Len(out.Mappings, 3)
+
+	r.Equal("foo.example.com", out.Mappings[0].ServerName)
+	r.Equal("foo", out.Mappings[0].FilterChain)
+	r.Equal([]string{"foo.example.com"}, out.Mappings[0].Overlapping)
+	r.Len(out.Mappings[0].Certificates, 3)
+	r.Equal(1, out.Mappings[0].Certificates[0].Index)
+	r.Equal("Exact", out.Mappings[0].Certificates[0].Match)
+	r.Equal(2, out.Mappings[0].Certificates[1].Index)
+	r.Equal(0, out.Mappings[0].Certificates[2].Index)
+	r.Equal("Wildcard", out.Mappings[0].Certificates[2].Match)
+
+	r.Equal("*.example.com", out.Mappings[1].ServerName)
+	r.Equal("wildcard", out.Mappings[1].FilterChain)
+	r.Empty(out.Mappings[1].Overlapping)
+	r.Equal("Exact", out.Mappings[1].Certificates[0].Match)
+
+	r.Equal("", out.Mappings[2].ServerName)
+	r.Equal("default", out.Mappings[2].FilterChain)
+	r.Equal([]string{"default.example.org"}, out.Mappings[2].Certificates[0].Hostnames)
+	r.Equal("None", out.Mappings[2].Certificates[0].Match)
+}
+
+// tlsTransportSocket returns a transport socket terminating TLS with a self-signed certificate per hostname.
+func tlsTransportSocket(t *testing.T, hostnames ...string) *envoycorev3.TransportSocket {
+	t.Helper()
+	common := &envoytlsv3.CommonTlsContext{}
+	for _, h := range hostnames {
+		common.TlsCertificates = append(common.TlsCertificates, &envoytlsv3.TlsCertificate{
+			CertificateChain: &envoycorev3.DataSource{
+				Specifier: &envoycorev3.DataSource_InlineBytes{InlineBytes: selfSignedCert(t, h)},
+			},
+		})
+	}
+	typedConfig, err := anypb.New(&envoytlsv3.DownstreamTlsContext{CommonTlsContext: common})
+	require.NoError(t, err)
+	return &envoycorev3.TransportSocket{
+		Name:       "envoy.transport_sockets.tls",
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}
+}
+
+func selfSignedCert(t *testing.T, hostname string) []byte {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: hostname},
+		DNSNames:     []string{hostname},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -0,0 +1,44 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/configexport"
+)
+
+// The config export endpoint exports the xDS configuration of each Gateway to KGW_CONFIG_EXPORT_DIR on demand,
+// in addition to the periodic export, and returns the Gateways whose exported configuration changed. Only the leader
+// replica exports, once the snapshots are synced; other replicas respond with 503.
+//
+//	POST   exports the configuration of all Gateways
+func addConfigExportHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, exporter *configexport.Exporter) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if exporter == nil {
+			writeJSON(w, map[string]string{"error": "config export not enabled (KGW_CONFIG_EXPORT_DIR is not set or Envoy controller is disabled)"}, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		changed, err := exporter.Export()
+		if errors.Is(err, configexport.ErrNotLeader) || errors.Is(err, configexport.ErrNotSynced) {
+			writeJSONError(w, http.StatusServiceUnavailable, err, r)
+			return
+		}
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err, r)
+			return
+		}
+		gateways := make([]string, 0, len(changed))
+		for _, gw := range changed {
+			gateways = append(gateways, gw.String())
+		}
+		writeJSON(w, completeSnapshotResponse(gateways), r)
+	})
+	profiles[path] = func() string {
+		return "Config export: POST to export the xDS configuration of each Gateway (Envoy only)"
+	}
+}
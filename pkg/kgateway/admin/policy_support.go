@@ -0,0 +1,35 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/policysupport"
+)
+
+// The policy support report lists the fields of a policy kind honored by the Envoy and agentgateway dataplanes,
+// for users designing policies for both dataplanes.
+//
+//	GET ?kind=<kind>   reports the fields of the given kind, e.g. TrafficPolicy or HTTPRouteFilter
+//	GET                reports the fields of every known kind
+func addPolicySupportHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		kinds := policysupport.Kinds()
+		if kind := r.URL.Query().Get("kind"); kind != "" {
+			kinds = []string{kind}
+		}
+		out := make([]policysupport.KindSupport, 0, len(kinds))
+		for _, kind := range kinds {
+			ks, ok := policysupport.ForKind(kind)
+			if !ok {
+				writeJSONError(w, http.StatusNotFound, fmt.Errorf("unknown kind %q; expected one of %v", kind, policysupport.Kinds()), r)
+				return
+			}
+			out = append(out, ks)
+		}
+		writeJSON(w, completeSnapshotResponse(out), r)
+	})
+	profiles[path] = func() string {
+		return "Policy fields honored by Envoy and agentgateway: ?kind=<kind> to report a single kind"
+	}
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
+)
+
+// The route duplicates report lists hostnames and matches that are claimed by different routes attached
+// to more than one Gateway of the same GatewayClass, which may silently shadow each other.
+func addRouteDuplicatesHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, routeDuplicates func() []proxy_syncer.RouteDuplicate) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if routeDuplicates == nil {
+			writeJSON(w, map[string]string{"error": "route duplicates report not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+		writeJSON(w, completeSnapshotResponse(routeDuplicates()), r)
+	})
+	profiles[path] = func() string { return "Routes claiming the same hostname and match across Gateways (Envoy only)" }
+}
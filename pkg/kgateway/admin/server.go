@@ -12,17 +12,26 @@ import (
 
 	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/client-go/kubernetes"
 
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/configexport"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/controller"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/version"
 )
 
-func RunAdminServer(ctx context.Context, setupOpts *controller.SetupOpts) error {
+func RunAdminServer(ctx context.Context, setupOpts *controller.SetupOpts, kubeClient kubernetes.Interface) error {
 	// serverHandlers defines the custom handlers that the Admin Server will support
-	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache)
+	serverHandlers := getServerHandlers(ctx, setupOpts.KrtDebugger, setupOpts.Cache, setupOpts.RouteDuplicates, setupOpts.GatewaySnapshot, setupOpts.ConfigExporter)
 
-	startHandlers(ctx, serverHandlers)
+	// when enabled, callers must authenticate with a Kubernetes bearer token and are authorized with cluster RBAC
+	var authorizer *adminAuthorizer
+	if setupOpts.GlobalSettings != nil && setupOpts.GlobalSettings.AdminAuthz {
+		authorizer = newAdminAuthorizer(kubeClient)
+	}
+
+	startHandlers(ctx, authorizer, serverHandlers)
 
 	return nil
 }
@@ -33,12 +42,33 @@ type dynamicProfileDescription func() string
 
 // getServerHandlers returns the custom handlers for the Admin Server, which will be bound to the http.ServeMux
 // These endpoints serve as the basis for an Admin Interface for the Control Plane (https://github.com/kgateway-dev/kgateway/issues/6494)
-func getServerHandlers(_ context.Context, dbg *krt.DebugHandler, cache envoycache.SnapshotCache) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
+func getServerHandlers(
+	_ context.Context,
+	dbg *krt.DebugHandler,
+	cache envoycache.SnapshotCache,
+	routeDuplicates func() []proxy_syncer.RouteDuplicate,
+	gatewaySnapshot func(key string) *envoycache.Snapshot,
+	configExporter *configexport.Exporter,
+) func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 	return func(m *http.ServeMux, profiles map[string]dynamicProfileDescription) {
 		addXdsSnapshotHandler("/snapshots/xds", m, profiles, cache)
 
+		addXdsSnapshotDiffHandler("/snapshots/xds/diff", m, profiles, cache, gatewaySnapshot)
+
+		addXdsSnapshotPinsHandler("/snapshots/xds/pins", m, profiles, cache)
+
+		addXdsResyncHandler("/snapshots/xds/resync", m, profiles, cache)
+
+		addCertSelectionHandler("/snapshots/cert-selection", m, profiles, cache)
+
+		addConfigExportHandler("/snapshots/xds/export", m, profiles, configExporter)
+
 		addKrtSnapshotHandler("/snapshots/krt", m, profiles, dbg)
 
+		addRouteDuplicatesHandler("/snapshots/route-duplicates", m, profiles, routeDuplicates)
+
+		addPolicySupportHandler("/policies/support", m, profiles)
+
 		addLoggingHandler("/logging", m, profiles)
 
 		addPprofHandler("/debug/pprof/", m, profiles)
@@ -74,7 +104,14 @@ func writeJSON(w http.ResponseWriter, obj any, req *http.Request) {
 	}
 }
 
-func startHandlers(ctx context.Context, addHandlers ...func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription)) {
+// writeJSONError writes a json error payload with the given status code
+func writeJSONError(w http.ResponseWriter, status int, err error, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	writeJSON(w, SnapshotResponseData{Error: err}, req)
+}
+
+func startHandlers(ctx context.Context, authorizer *adminAuthorizer, addHandlers ...func(mux *http.ServeMux, profiles map[string]dynamicProfileDescription)) {
 	mux := new(http.ServeMux)
 	profileDescriptions := map[string]dynamicProfileDescription{}
 	for _, addHandler := range addHandlers {
@@ -83,9 +120,13 @@ func startHandlers(ctx context.Context, addHandlers ...func(mux *http.ServeMux,
 	idx := index(profileDescriptions)
 	mux.HandleFunc("/", idx)
 	mux.HandleFunc("/snapshots/", idx)
+	var handler http.Handler = mux
+	if authorizer != nil {
+		handler = authorizer.wrap(mux)
+	}
 	server := &http.Server{
 		Addr:              fmt.Sprintf("localhost:%d", wellknown.KgatewayAdminPort),
-		Handler:           mux,
+		Handler:           handler,
 		ReadHeaderTimeout: 10 * time.Second,
 	}
 	slog.Info("admin server starting", "address", server.Addr)
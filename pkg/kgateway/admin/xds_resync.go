@@ -0,0 +1,106 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
+)
+
+// The xDS resync endpoint forces a full push of the current snapshot to the data-plane nodes of a Gateway
+// or to a single node, identified by its node ID, e.g. when a data plane is suspected to have drifted.
+// As the admin server only listens on localhost, access requires exec or port-forward permissions on
+// the controller pod and, when KGW_ADMIN_AUTHZ is enabled, the create verb on the admin resource named
+// snapshots/xds/resync.
+//
+//	POST ?gateway=<namespace>/<name>   resyncs every cache key of the Gateway
+//	POST ?node=<id>                    resyncs the cache key last requested by the node
+func addXdsResyncHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, xdsCache cache.SnapshotCache) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if xdsCache == nil {
+			writeJSON(w, map[string]string{"error": "Envoy xDS cache not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		gateway, node := r.URL.Query().Get("gateway"), r.URL.Query().Get("node")
+		var keys []string
+		switch {
+		case gateway != "" && node == "":
+			ns, name, ok := strings.Cut(gateway, "/")
+			if !ok || ns == "" || name == "" {
+				writeJSONError(w, http.StatusBadRequest, fmt.Errorf("invalid gateway %q; expected <namespace>/<name>", gateway), r)
+				return
+			}
+			keys = gatewayCacheKeys(xdsCache, types.NamespacedName{Namespace: ns, Name: name})
+		case node != "" && gateway == "":
+			keys = nodeCacheKeys(xdsCache, node)
+		default:
+			writeJSONError(w, http.StatusBadRequest, errors.New(`exactly one of the "gateway" and "node" query parameters is required`), r)
+			return
+		}
+		if len(keys) == 0 {
+			writeJSONError(w, http.StatusNotFound, errors.New("no xDS cache keys found"), r)
+			return
+		}
+
+		resynced, err := resyncCacheKeys(r.Context(), xdsCache, keys)
+		if err != nil {
+			writeJSONError(w, http.StatusInternalServerError, err, r)
+			return
+		}
+		writeJSON(w, completeSnapshotResponse(resynced), r)
+	})
+	profiles[path] = func() string {
+		return "XDS resync: POST ?gateway=<namespace>/<name> or ?node=<id> to force a full push of the current snapshot (Envoy only)"
+	}
+}
+
+// gatewayCacheKeys returns the cache keys serving the nodes of the given Gateway, including per-client keys.
+func gatewayCacheKeys(xdsCache cache.SnapshotCache, gw types.NamespacedName) []string {
+	role := xds.OwnerNamespaceNameID(wellknown.GatewayApiProxyValue, gw.Namespace, gw.Name)
+	var keys []string
+	for _, k := range xdsCache.GetStatusKeys() {
+		if k == role || strings.HasPrefix(k, role+xds.KeyDelimiter) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// nodeCacheKeys returns the cache keys last requested by the node with the given ID.
+func nodeCacheKeys(xdsCache cache.SnapshotCache, nodeID string) []string {
+	var keys []string
+	for _, k := range xdsCache.GetStatusKeys() {
+		if info := xdsCache.GetStatusInfo(k); info != nil && info.GetNode().GetId() == nodeID {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func resyncCacheKeys(ctx context.Context, xdsCache cache.SnapshotCache, keys []string) ([]string, error) {
+	var resynced []string
+	var errs []error
+	for _, k := range keys {
+		if err := xds.Resync(ctx, xdsCache, k); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		resynced = append(resynced, k)
+	}
+	return resynced, errors.Join(errs...)
+}
@@ -0,0 +1,143 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// goldenSnapshotKey may be passed instead of a second node ID to compare a node's snapshot against the
+// full snapshot translated for its Gateway, before any per-client clusters and endpoints are added.
+const goldenSnapshotKey = "golden"
+
+// SnapshotDiff is the difference between two xDS snapshots, keyed by TypeUrl.
+// Only TypeUrls with differing resources are included.
+type SnapshotDiff struct {
+	A         string                   `json:"a"`
+	B         string                   `json:"b"`
+	Resources map[string]ResourcesDiff `json:"resources"`
+}
+
+// ResourcesDiff is the difference between the resources of a single TypeUrl in two snapshots.
+type ResourcesDiff struct {
+	VersionA string   `json:"versionA"`
+	VersionB string   `json:"versionB"`
+	OnlyInA  []string `json:"onlyInA,omitempty"`
+	OnlyInB  []string `json:"onlyInB,omitempty"`
+	// Changed maps the name of each resource present in both snapshots with different content to
+	// a human readable diff of that resource.
+	Changed map[string]string `json:"changed,omitempty"`
+}
+
+// The xDS Snapshot diff compares the snapshots served to two nodes, identified by their xDS cache key,
+// or the snapshot of a node against the snapshot translated for its Gateway when b is "golden".
+func addXdsSnapshotDiffHandler(
+	path string,
+	mux *http.ServeMux,
+	profiles map[string]dynamicProfileDescription,
+	xdsCache cache.SnapshotCache,
+	gatewaySnapshot func(key string) *cache.Snapshot,
+) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		if xdsCache == nil {
+			writeJSON(w, map[string]string{"error": "Envoy xDS cache not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+		a, b := r.URL.Query().Get("a"), r.URL.Query().Get("b")
+		if a == "" || b == "" {
+			writeJSONError(w, http.StatusBadRequest, errors.New(`both "a" and "b" query parameters are required`), r)
+			return
+		}
+		writeJSON(w, getXdsSnapshotDiff(xdsCache, gatewaySnapshot, a, b), r)
+	})
+	profiles[path] = func() string {
+		return fmt.Sprintf("XDS Snapshot diff between two nodes, e.g. ?a=<node>&b=<node>, or ?a=<node>&b=%s (Envoy only)", goldenSnapshotKey)
+	}
+}
+
+func getXdsSnapshotDiff(xdsCache cache.SnapshotCache, gatewaySnapshot func(key string) *cache.Snapshot, a, b string) SnapshotResponseData {
+	snapA, err := getXdsSnapshot(xdsCache, a)
+	if err != nil {
+		return SnapshotResponseData{Error: fmt.Errorf("snapshot %s: %w", a, err)}
+	}
+
+	var snapB cache.ResourceSnapshot
+	if b == goldenSnapshotKey {
+		var golden *cache.Snapshot
+		if gatewaySnapshot != nil {
+			golden = gatewaySnapshot(a)
+		}
+		if golden == nil {
+			return SnapshotResponseData{Error: fmt.Errorf("no Gateway snapshot found for %s", a)}
+		}
+		snapB = redactSecrets(golden)
+	} else {
+		snapB, err = getXdsSnapshot(xdsCache, b)
+		if err != nil {
+			return SnapshotResponseData{Error: fmt.Errorf("snapshot %s: %w", b, err)}
+		}
+	}
+
+	return completeSnapshotResponse(diffSnapshots(a, b, snapA.(*cache.Snapshot), snapB.(*cache.Snapshot)))
+}
+
+func diffSnapshots(a, b string, snapA, snapB *cache.Snapshot) SnapshotDiff {
+	out := SnapshotDiff{
+		A:         a,
+		B:         b,
+		Resources: map[string]ResourcesDiff{},
+	}
+	for i := range envoycachetypes.UnknownType {
+		typeURL, err := cache.GetResponseTypeURL(i)
+		if err != nil {
+			continue
+		}
+		var resA, resB cache.Resources
+		if snapA != nil {
+			resA = snapA.Resources[i]
+		}
+		if snapB != nil {
+			resB = snapB.Resources[i]
+		}
+		if d, ok := diffResources(resA, resB); ok {
+			out.Resources[typeURL] = d
+		}
+	}
+	return out
+}
+
+// diffResources returns the difference between two sets of resources of the same type, and whether
+// there is any difference at all.
+func diffResources(a, b cache.Resources) (ResourcesDiff, bool) {
+	d := ResourcesDiff{
+		VersionA: a.Version,
+		VersionB: b.Version,
+	}
+	for name, resA := range a.Items {
+		resB, ok := b.Items[name]
+		if !ok {
+			d.OnlyInA = append(d.OnlyInA, name)
+			continue
+		}
+		if diff := cmp.Diff(resA.Resource, resB.Resource, protocmp.Transform()); diff != "" {
+			if d.Changed == nil {
+				d.Changed = map[string]string{}
+			}
+			d.Changed[name] = diff
+		}
+	}
+	for name := range b.Items {
+		if _, ok := a.Items[name]; !ok {
+			d.OnlyInB = append(d.OnlyInB, name)
+		}
+	}
+	sort.Strings(d.OnlyInA)
+	sort.Strings(d.OnlyInB)
+	return d, len(d.OnlyInA) > 0 || len(d.OnlyInB) > 0 || len(d.Changed) > 0
+}
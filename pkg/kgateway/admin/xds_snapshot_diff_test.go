@@ -0,0 +1,94 @@
+package admin
+
+import (
+	"context"
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	r := require.New(t)
+
+	snapA, err := cache.NewSnapshot("a", map[resource.Type][]types.Resource{
+		resource.ClusterType: {
+			&envoyclusterv3.Cluster{Name: "shared"},
+			&envoyclusterv3.Cluster{Name: "only-a"},
+		},
+		resource.ListenerType: {
+			&envoylistenerv3.Listener{Name: "listener"},
+		},
+	})
+	r.NoError(err)
+	snapB, err := cache.NewSnapshot("b", map[resource.Type][]types.Resource{
+		resource.ClusterType: {
+			&envoyclusterv3.Cluster{Name: "shared", ConnectTimeout: durationpb.New(5)},
+			&envoyclusterv3.Cluster{Name: "only-b"},
+		},
+		resource.ListenerType: {
+			&envoylistenerv3.Listener{Name: "listener"},
+		},
+	})
+	r.NoError(err)
+
+	diff := diffSnapshots("node-a", "node-b", snapA, snapB)
+	r.Equal("node-a", diff.A)
+	r.Equal("node-b", diff.B)
+	r.Len(diff.Resources, 1, "identical listeners should not be reported")
+
+	clusters, ok := diff.Resources[resource.ClusterType]
+	r.True(ok)
+	r.Equal("a", clusters.VersionA)
+	r.Equal("b", clusters.VersionB)
+	r.Equal([]string{"only-a"}, clusters.OnlyInA)
+	r.Equal([]string{"only-b"}, clusters.OnlyInB)
+	r.Len(clusters.Changed, 1)
+	r.Contains(clusters.Changed["shared"], "connect_timeout")
+}
+
+func TestGetXdsSnapshotDiffGolden(t *testing.T) {
+	r := require.New(t)
+
+	const key = "kgateway-kube-gateway-api~default~gw~123~default"
+	clientSnap, err := cache.NewSnapshot("client", map[resource.Type][]types.Resource{
+		resource.ClusterType: {
+			&envoyclusterv3.Cluster{Name: "gateway"},
+			&envoyclusterv3.Cluster{Name: "per-client"},
+		},
+	})
+	r.NoError(err)
+	xdsCache := cache.NewSnapshotCache(false, cache.IDHash{}, nil)
+	r.NoError(xdsCache.SetSnapshot(context.Background(), key, clientSnap))
+
+	goldenSnap, err := cache.NewSnapshot("golden", map[resource.Type][]types.Resource{
+		resource.ClusterType: {
+			&envoyclusterv3.Cluster{Name: "gateway"},
+		},
+	})
+	r.NoError(err)
+	gatewaySnapshot := func(k string) *cache.Snapshot {
+		if k == key {
+			return goldenSnap
+		}
+		return nil
+	}
+
+	resp := getXdsSnapshotDiff(xdsCache, gatewaySnapshot, key, goldenSnapshotKey)
+	r.NoError(resp.Error)
+	diff, ok := resp.Data.(SnapshotDiff)
+	r.True(ok)
+	r.Equal([]string{"per-client"}, diff.Resources[resource.ClusterType].OnlyInA)
+	r.Empty(diff.Resources[resource.ClusterType].OnlyInB)
+
+	resp = getXdsSnapshotDiff(xdsCache, nil, key, goldenSnapshotKey)
+	r.Error(resp.Error)
+
+	resp = getXdsSnapshotDiff(xdsCache, gatewaySnapshot, key, "missing")
+	r.Error(resp.Error)
+}
@@ -0,0 +1,65 @@
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
+)
+
+// The xDS Snapshot pins endpoint allows pinning a data-plane node, identified by its node ID, to the
+// current snapshot of an xDS cache key, e.g. to hold back a canary replica on a known-good configuration.
+//
+//	GET                            lists the pinned nodes
+//	POST   ?node=<id>&key=<key>    pins the node to the current snapshot of the cache key
+//	DELETE ?node=<id>              unpins the node
+func addXdsSnapshotPinsHandler(path string, mux *http.ServeMux, profiles map[string]dynamicProfileDescription, xdsCache cache.SnapshotCache) {
+	mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+		pinnedCache, ok := xdsCache.(*xds.PinnedSnapshotCache)
+		if !ok {
+			writeJSON(w, map[string]string{"error": "Envoy xDS snapshot pinning not available (Envoy controller may be disabled)"}, r)
+			return
+		}
+
+		node := r.URL.Query().Get("node")
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, completeSnapshotResponse(pinnedCache.Pins()), r)
+		case http.MethodPost:
+			key := r.URL.Query().Get("key")
+			if node == "" || key == "" {
+				writeJSONError(w, http.StatusBadRequest, errors.New(`both "node" and "key" query parameters are required`), r)
+				return
+			}
+			pin, err := pinnedCache.Pin(r.Context(), node, key)
+			if err != nil {
+				writeJSONError(w, http.StatusBadRequest, err, r)
+				return
+			}
+			writeJSON(w, completeSnapshotResponse(pin), r)
+		case http.MethodDelete:
+			if node == "" {
+				writeJSONError(w, http.StatusBadRequest, errors.New(`"node" query parameter is required`), r)
+				return
+			}
+			unpinned, err := pinnedCache.Unpin(r.Context(), node)
+			if err != nil {
+				writeJSONError(w, http.StatusInternalServerError, err, r)
+				return
+			}
+			if !unpinned {
+				writeJSONError(w, http.StatusNotFound, fmt.Errorf("node %s is not pinned", node), r)
+				return
+			}
+			writeJSON(w, completeSnapshotResponse(pinnedCache.Pins()), r)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	})
+	profiles[path] = func() string {
+		return "XDS Snapshot pins: GET to list, POST ?node=<id>&key=<cache key> to pin a node to the current snapshot of the key, DELETE ?node=<id> to unpin (Envoy only)"
+	}
+}
@@ -0,0 +1,178 @@
+package krtxds
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+)
+
+// maxAdmissionResponseSize bounds the size of the admission webhook responses that are read
+const maxAdmissionResponseSize = 64 * 1024
+
+const admissionResultLabel = "result"
+
+var xdsAdmissionTotal = metrics.NewCounter(
+	metrics.CounterOpts{
+		Subsystem: agentGwXdsSubsystem,
+		Name:      "admission_total",
+		Help:      "Total number of agentgateway xDS connections reviewed by the admission webhook, by result (allowed, denied or error)",
+	}, []string{admissionResultLabel})
+
+// ConnectionAdmitter admits the proxies connecting to the discovery server, beyond the built-in identity checks.
+type ConnectionAdmitter interface {
+	// Admit returns an error when the proxy at the peer address, with the given node and authenticated identity,
+	// must not be served. The identity is nil when xDS authentication is disabled.
+	Admit(ctx context.Context, peer string, node *envoycorev3.Node, identity *types.NamespacedName) error
+}
+
+// AdmissionReview is the request sent to the admission webhook.
+type AdmissionReview struct {
+	// Node is the node of the proxy, as sent in its first xDS request, in the protobuf JSON format.
+	Node json.RawMessage `json:"node"`
+	// Identity is the authenticated identity of the proxy, unset when xDS authentication is disabled.
+	Identity *AdmissionIdentity `json:"identity,omitempty"`
+	// Peer is the address of the proxy.
+	Peer string `json:"peer"`
+}
+
+type AdmissionIdentity struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+}
+
+// AdmissionResponse is the response expected from the admission webhook.
+type AdmissionResponse struct {
+	Allowed bool `json:"allowed"`
+	// Reason is reported to the proxy when it is not allowed.
+	Reason string `json:"reason,omitempty"`
+}
+
+// WebhookAdmitterOptions configures a WebhookAdmitter.
+type WebhookAdmitterOptions struct {
+	// URL is the URL of the webhook.
+	URL string
+	// Timeout is the maximum time to wait for the webhook to answer.
+	Timeout time.Duration
+	// CAFile is the path to a PEM bundle of the CA certificates verifying the certificate of an https webhook.
+	// The system roots are used if empty.
+	CAFile string
+	// FailOpen admits the proxies when the webhook cannot be reached or does not answer with a valid response,
+	// instead of rejecting them. The proxies the webhook denies are always rejected.
+	FailOpen bool
+}
+
+// WebhookAdmitter admits proxies by POSTing an AdmissionReview to a webhook, e.g. checking the proxy against an
+// asset inventory. The webhook is only called for new connections, so its decisions are not cached.
+type WebhookAdmitter struct {
+	url      string
+	client   *http.Client
+	failOpen bool
+}
+
+var _ ConnectionAdmitter = &WebhookAdmitter{}
+
+// admissionDeniedError is returned when the webhook answered and did not allow the proxy.
+type admissionDeniedError struct {
+	reason string
+}
+
+func (e *admissionDeniedError) Error() string {
+	if e.reason == "" {
+		return "denied by admission webhook"
+	}
+	return "denied by admission webhook: " + e.reason
+}
+
+func NewWebhookAdmitter(opts WebhookAdmitterOptions) (*WebhookAdmitter, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if opts.CAFile != "" {
+		caCerts, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read admission webhook CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCerts) {
+			return nil, fmt.Errorf("no CA certificates found in %s", opts.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+	return &WebhookAdmitter{
+		url:      opts.URL,
+		client:   &http.Client{Timeout: opts.Timeout, Transport: transport},
+		failOpen: opts.FailOpen,
+	}, nil
+}
+
+func (w *WebhookAdmitter) Admit(ctx context.Context, peer string, node *envoycorev3.Node, identity *types.NamespacedName) error {
+	err := w.admit(ctx, peer, node, identity)
+	result := "allowed"
+	var denied *admissionDeniedError
+	switch {
+	case err == nil:
+	case errors.As(err, &denied):
+		result = "denied"
+	case w.failOpen:
+		log.Warn("ADS: admitting connection as the admission webhook failed", "node", node.GetId(), "peer", peer, "error", err)
+		result = "error"
+		err = nil
+	default:
+		result = "error"
+	}
+	xdsAdmissionTotal.Inc(metrics.Label{Name: admissionResultLabel, Value: result})
+	return err
+}
+
+func (w *WebhookAdmitter) admit(ctx context.Context, peer string, node *envoycorev3.Node, identity *types.NamespacedName) error {
+	nodeJSON, err := protojson.Marshal(node)
+	if err != nil {
+		return fmt.Errorf("failed to marshal node: %w", err)
+	}
+	review := AdmissionReview{Node: nodeJSON, Peer: peer}
+	if identity != nil {
+		review.Identity = &AdmissionIdentity{Namespace: identity.Namespace, Name: identity.Name}
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return fmt.Errorf("failed to marshal admission review: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build admission request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("admission webhook failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("admission webhook returned status %d", resp.StatusCode)
+	}
+	var out AdmissionResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxAdmissionResponseSize)).Decode(&out); err != nil {
+		return fmt.Errorf("invalid admission webhook response: %w", err)
+	}
+	if !out.Allowed {
+		return &admissionDeniedError{reason: out.Reason}
+	}
+	return nil
+}
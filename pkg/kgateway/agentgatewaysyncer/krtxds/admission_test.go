@@ -0,0 +1,153 @@
+package krtxds
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestWebhookAdmitter(t *testing.T) {
+	node := &envoycorev3.Node{Id: "agentgateway~10.0.0.1~gw.default~default.svc.cluster.local"}
+	identity := &types.NamespacedName{Namespace: "default", Name: "gw"}
+
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{
+			name:   "allowed",
+			status: http.StatusOK,
+			body:   `{"allowed":true}`,
+		},
+		{
+			name:    "denied with reason",
+			status:  http.StatusOK,
+			body:    `{"allowed":false,"reason":"unknown asset"}`,
+			wantErr: "denied by admission webhook: unknown asset",
+		},
+		{
+			name:    "denied without reason",
+			status:  http.StatusOK,
+			body:    `{}`,
+			wantErr: "denied by admission webhook",
+		},
+		{
+			name:    "webhook error",
+			status:  http.StatusInternalServerError,
+			body:    `{"allowed":true}`,
+			wantErr: "admission webhook returned status 500",
+		},
+		{
+			name:    "invalid response",
+			status:  http.StatusOK,
+			body:    `not json`,
+			wantErr: "invalid admission webhook response",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got AdmissionReview
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			admitter, err := NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, Timeout: time.Second})
+			require.NoError(t, err)
+			err = admitter.Admit(context.Background(), "10.0.0.1:1234", node, identity)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, "10.0.0.1:1234", got.Peer)
+			assert.Equal(t, &AdmissionIdentity{Namespace: "default", Name: "gw"}, got.Identity)
+			assert.Contains(t, string(got.Node), node.Id)
+		})
+	}
+}
+
+func TestWebhookAdmitterUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	admitter, err := NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, Timeout: time.Second})
+	require.NoError(t, err)
+	err = admitter.Admit(context.Background(), "peer", &envoycorev3.Node{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "admission webhook failed")
+
+	// the failures are ignored when failing open
+	admitter, err = NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, Timeout: time.Second, FailOpen: true})
+	require.NoError(t, err)
+	assert.NoError(t, admitter.Admit(context.Background(), "peer", &envoycorev3.Node{}, nil))
+}
+
+func TestWebhookAdmitterFailOpenDenied(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"allowed":false}`))
+	}))
+	defer srv.Close()
+
+	// denials are enforced even when failing open
+	admitter, err := NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, Timeout: time.Second, FailOpen: true})
+	require.NoError(t, err)
+	err = admitter.Admit(context.Background(), "peer", &envoycorev3.Node{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "denied by admission webhook")
+}
+
+func TestWebhookAdmitterTimeout(t *testing.T) {
+	done := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-done
+	}))
+	defer srv.Close()
+	defer close(done)
+
+	admitter, err := NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, Timeout: 10 * time.Millisecond})
+	require.NoError(t, err)
+	err = admitter.Admit(context.Background(), "peer", &envoycorev3.Node{}, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "admission webhook failed")
+}
+
+func TestWebhookAdmitterCAFile(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"allowed":true}`))
+	}))
+	defer srv.Close()
+
+	// the certificate of the test server is not trusted by the system roots
+	admitter, err := NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, Timeout: time.Second})
+	require.NoError(t, err)
+	require.Error(t, admitter.Admit(context.Background(), "peer", &envoycorev3.Node{}, nil))
+
+	caFile := filepath.Join(t.TempDir(), "ca.crt")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	require.NoError(t, os.WriteFile(caFile, caPEM, 0o600))
+	admitter, err = NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, Timeout: time.Second, CAFile: caFile})
+	require.NoError(t, err)
+	assert.NoError(t, admitter.Admit(context.Background(), "peer", &envoycorev3.Node{}, nil))
+
+	require.NoError(t, os.WriteFile(caFile, []byte("not a certificate"), 0o600))
+	_, err = NewWebhookAdmitter(WebhookAdmitterOptions{URL: srv.URL, CAFile: caFile})
+	assert.Error(t, err)
+}
@@ -0,0 +1,141 @@
+package krtxds
+
+import (
+	"time"
+
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"istio.io/istio/pkg/env"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/metrics"
+)
+
+var (
+	XdsSendTimeout = env.Register(
+		"KGW_XDS_SEND_TIMEOUT",
+		0*time.Second,
+		"The maximum time to send a single xDS response to a proxy. A proxy not reading its responses within this "+
+			"time is disconnected, instead of pinning the push. If set to 0, sends never time out.",
+	).Get()
+
+	XdsMaxUnackedResponses = env.Register(
+		"KGW_XDS_MAX_UNACKED_RESPONSES",
+		0,
+		"The maximum number of xDS responses pushed to a proxy that it has not acknowledged yet. Pushes to a proxy "+
+			"at the limit are merged and deferred until it catches up. If set to 0, the number is not limited.",
+	).Get()
+
+	XdsMaxStalledPushes = env.Register(
+		"KGW_XDS_MAX_STALLED_PUSHES",
+		0,
+		"The maximum number of consecutive pushes deferred for a proxy at KGW_XDS_MAX_UNACKED_RESPONSES, after "+
+			"which the proxy is considered stalled and is disconnected. If set to 0, stalled proxies are not disconnected.",
+	).Get()
+)
+
+const (
+	stalledReasonLabel = "reason"
+
+	stalledReasonSendTimeout = "send_timeout"
+	stalledReasonUnacked     = "unacked_responses"
+)
+
+var xdsStalledDisconnectsTotal = metrics.NewCounter(
+	metrics.CounterOpts{
+		Subsystem: agentGwXdsSubsystem,
+		Name:      "stalled_disconnects_total",
+		Help:      "Total number of agentgateway proxies disconnected for not keeping up with xDS pushes",
+	}, []string{stalledReasonLabel})
+
+// FlowControlOptions bounds the resources a slow or stuck proxy can hold on the discovery server.
+type FlowControlOptions struct {
+	// SendTimeout is the maximum time to send a single response. 0 disables the timeout.
+	SendTimeout time.Duration
+
+	// MaxUnackedResponses is the maximum number of pushed responses a proxy has not acknowledged yet, after
+	// which pushes are deferred. 0 disables the limit.
+	MaxUnackedResponses int
+
+	// MaxStalledPushes is the number of consecutive deferred pushes after which the proxy is disconnected.
+	// 0 never disconnects.
+	MaxStalledPushes int
+}
+
+// connectionFlowControl is the flow control state of a connection. It is only accessed from the connection's
+// main goroutine, which handles both requests and pushes.
+type connectionFlowControl struct {
+	// unacked is the number of responses sent on the connection that were not ACKed or NACKed yet
+	unacked int
+	// deferred is the merged push request deferred while the connection was at the limit of unacked responses
+	deferred *PushRequest
+	// stalledPushes is the number of consecutive pushes deferred
+	stalledPushes int
+}
+
+// send sends the response, failing with DeadlineExceeded when it takes longer than the send timeout. On timeout,
+// the send keeps blocking in the background until the stream is closed, which returning the error does.
+func (conn *Connection) send(res *discovery.DeltaDiscoveryResponse) error {
+	timeout := conn.sendTimeout
+	if timeout <= 0 {
+		return conn.deltaStream.Send(res)
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- conn.deltaStream.Send(res)
+	}()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case err := <-errCh:
+		return err
+	case <-timer.C:
+		xdsStalledDisconnectsTotal.Inc(metrics.Label{Name: stalledReasonLabel, Value: stalledReasonSendTimeout})
+		return status.Errorf(codes.DeadlineExceeded, "timed out sending response after %v", timeout)
+	}
+}
+
+// deferPush returns true when the push must be deferred as the connection has too many unacknowledged
+// responses, in which case it is merged with the other deferred pushes. Returns an error when the connection
+// is stalled and must be closed.
+func (s *DiscoveryServer) deferPush(con *Connection, req *PushRequest) (bool, error) {
+	limit := s.FlowControlOptions.MaxUnackedResponses
+	if limit <= 0 || con.flowControl.unacked < limit {
+		return false, nil
+	}
+
+	con.flowControl.deferred = con.flowControl.deferred.Merge(req)
+	con.flowControl.stalledPushes++
+	log.Debug("deferring push, too many unacked responses", "connection", con.ID(), "unacked", con.flowControl.unacked, "stalled", con.flowControl.stalledPushes)
+
+	if maxStalled := s.FlowControlOptions.MaxStalledPushes; maxStalled > 0 && con.flowControl.stalledPushes > maxStalled {
+		log.Warn("closing stalled connection", "connection", con.ID(), "unacked", con.flowControl.unacked, "stalled", con.flowControl.stalledPushes)
+		xdsStalledDisconnectsTotal.Inc(metrics.Label{Name: stalledReasonLabel, Value: stalledReasonUnacked})
+		return true, status.Errorf(codes.ResourceExhausted, "%d responses not acknowledged", con.flowControl.unacked)
+	}
+	return true, nil
+}
+
+// onResponseSent records a response sent on the connection, that the proxy must acknowledge.
+func (conn *Connection) onResponseSent() {
+	conn.flowControl.unacked++
+}
+
+// onResponseAcked records the acknowledgement of a response by the proxy, either an ACK or a NACK, and returns
+// the deferred push to send once the connection is back under the limit of unacked responses.
+func (s *DiscoveryServer) onResponseAcked(con *Connection) *PushRequest {
+	if con.flowControl.unacked > 0 {
+		con.flowControl.unacked--
+	}
+	if con.flowControl.deferred == nil {
+		return nil
+	}
+	if limit := s.FlowControlOptions.MaxUnackedResponses; limit > 0 && con.flowControl.unacked >= limit {
+		return nil
+	}
+	deferred := con.flowControl.deferred
+	con.flowControl.deferred = nil
+	con.flowControl.stalledPushes = 0
+	return deferred
+}
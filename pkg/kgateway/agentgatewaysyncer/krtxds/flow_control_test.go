@@ -0,0 +1,73 @@
+package krtxds
+
+import (
+	"testing"
+	"time"
+
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	pilotxds "istio.io/istio/pilot/pkg/xds"
+	"istio.io/istio/pkg/util/sets"
+)
+
+// blockingStream is a stream whose sends block until unblocked
+type blockingStream struct {
+	pilotxds.DeltaDiscoveryStream
+	unblock chan struct{}
+}
+
+func (b *blockingStream) Send(*discovery.DeltaDiscoveryResponse) error {
+	<-b.unblock
+	return nil
+}
+
+func TestSendTimeout(t *testing.T) {
+	stream := &blockingStream{unblock: make(chan struct{})}
+	defer close(stream.unblock)
+
+	con := newDeltaConnection("peer", stream, 10*time.Millisecond)
+	err := con.send(&discovery.DeltaDiscoveryResponse{})
+	require.Error(t, err)
+	assert.Equal(t, codes.DeadlineExceeded, status.Code(err))
+}
+
+func TestDeferPush(t *testing.T) {
+	s := &DiscoveryServer{FlowControlOptions: FlowControlOptions{MaxUnackedResponses: 2, MaxStalledPushes: 2}}
+	con := newDeltaConnection("peer", nil, 0)
+	push := func(typeURL string) *PushRequest {
+		return &PushRequest{ConfigsUpdated: map[TypeUrl]sets.String{TypeUrl(typeURL): sets.New("a")}}
+	}
+
+	con.onResponseSent()
+	deferred, err := s.deferPush(con, push("first"))
+	require.NoError(t, err)
+	assert.False(t, deferred, "under the limit")
+
+	con.onResponseSent()
+	deferred, err = s.deferPush(con, push("second"))
+	require.NoError(t, err)
+	assert.True(t, deferred, "at the limit")
+	deferred, err = s.deferPush(con, push("third"))
+	require.NoError(t, err)
+	assert.True(t, deferred)
+
+	// the deferred pushes are merged and sent once the proxy catches up
+	req := s.onResponseAcked(con)
+	require.NotNil(t, req)
+	assert.Len(t, req.ConfigsUpdated, 2)
+	assert.Nil(t, s.onResponseAcked(con), "nothing deferred")
+
+	// a proxy never catching up is disconnected
+	con.onResponseSent()
+	con.onResponseSent()
+	for range 2 {
+		_, err = s.deferPush(con, push("stalled"))
+		require.NoError(t, err)
+	}
+	_, err = s.deferPush(con, push("stalled"))
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
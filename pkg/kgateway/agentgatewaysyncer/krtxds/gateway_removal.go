@@ -0,0 +1,77 @@
+package krtxds
+
+import (
+	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// GatewayRemovals garbage collects the per-gateway resources of connected proxies when their Gateway is deleted.
+// The proxies are sent the removal of every per-gateway resource they were sent, and their connections are then
+// closed. Resources that are not scoped to a Gateway, such as addresses, are shared with other Gateways and are
+// not removed.
+func GatewayRemovals[T any](gateways krt.Collection[T], extract func(o T) types.NamespacedName) Registration {
+	return func(s *DiscoveryServer) CollectionRegistration {
+		start := func(stop <-chan struct{}) {
+			gateways.RegisterBatch(func(o []krt.Event[T]) {
+				removed := sets.New[types.NamespacedName]()
+				for _, e := range o {
+					if e.Event == controllers.EventDelete {
+						removed.Insert(extract(e.Latest()))
+					}
+				}
+				if removed.IsEmpty() {
+					return
+				}
+				s.InboundUpdates.Inc()
+				s.pushChannel <- &PushRequest{RemovedGateways: removed}
+			}, false)
+		}
+		return CollectionRegistration{
+			Start:     start,
+			HasSynced: gateways.HasSynced,
+		}
+	}
+}
+
+// trackSentResources records the per-gateway resources sent on the connection, so they can be removed
+// once the Gateway is deleted.
+func (conn *Connection) trackSentResources(typeURL string, res []*discovery.Resource, removed []string) {
+	sent, f := conn.sentResources[typeURL]
+	if !f {
+		sent = sets.New[string]()
+		conn.sentResources[typeURL] = sent
+	}
+	for _, r := range res {
+		sent.Insert(r.Name)
+	}
+	sent.DeleteAll(removed...)
+}
+
+// teardownConnection sends the removal of all per-gateway resources of every watched type to a connection whose
+// Gateway was deleted, and then closes the connection.
+func (s *DiscoveryServer) teardownConnection(con *Connection, req *PushRequest) error {
+	for _, w := range con.watchedResourcesByOrder(s.pushOrder) {
+		sent := con.sentResources[w.TypeUrl]
+		if len(sent) == 0 {
+			continue
+		}
+		resp := &discovery.DeltaDiscoveryResponse{
+			TypeUrl:           w.TypeUrl,
+			SystemVersionInfo: req.PushVersion,
+			Nonce:             nonce(req.PushVersion),
+			RemovedResources:  sets.SortedList(sent),
+		}
+		if err := con.sendDelta(resp); err != nil {
+			return err
+		}
+		log.Info("removed resources of deleted gateway", "type", w.TypeUrl, "connection", con.ID(), "removed", len(resp.RemovedResources))
+		delete(con.sentResources, w.TypeUrl)
+	}
+	log.Info("closing connection of deleted gateway", "connection", con.ID())
+	return status.Error(codes.Unavailable, "gateway was deleted")
+}
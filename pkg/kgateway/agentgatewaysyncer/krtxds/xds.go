@@ -198,6 +198,11 @@ func NewDiscoveryServer(debugger *krt.DebugHandler, nackPublisher *nack.Publishe
 			DebounceAfter: DebounceAfter,
 			DebounceMax:   DebounceMax,
 		},
+		FlowControlOptions: FlowControlOptions{
+			SendTimeout:         XdsSendTimeout,
+			MaxUnackedResponses: XdsMaxUnackedResponses,
+			MaxStalledPushes:    XdsMaxStalledPushes,
+		},
 		Collections: make(map[string]CollectionGenerator),
 	}
 
@@ -247,6 +252,8 @@ type DiscoveryServer struct {
 
 	DebounceOptions DebounceOptions
 
+	FlowControlOptions FlowControlOptions
+
 	// pushVersion stores the numeric push version. This should be accessed via NextVersion()
 	pushVersion atomic.Uint64
 
@@ -255,6 +262,9 @@ type DiscoveryServer struct {
 	registrations []CollectionRegistration
 
 	nackPublisher *nack.Publisher
+
+	// Admitter admits the proxies connecting, after their identity was checked. All proxies are admitted if nil.
+	Admitter ConnectionAdmitter
 }
 
 // Proxy contains information about an specific instance of a proxy.
@@ -287,6 +297,15 @@ type Connection struct {
 	deltaStream pilotxds.DeltaDiscoveryStream
 
 	deltaReqChan chan *discovery.DeltaDiscoveryRequest
+
+	// sentResources tracks the names of the per-gateway resources sent on this connection, keyed by TypeUrl.
+	// This is only accessed from the connection's main goroutine, which handles both requests and pushes.
+	sentResources map[string]sets.String
+
+	// sendTimeout is the maximum time to send a single response, 0 disables the timeout.
+	sendTimeout time.Duration
+
+	flowControl connectionFlowControl
 }
 
 // StreamAggregatedResources implements the ADS interface.
@@ -329,7 +348,7 @@ func (s *DiscoveryServer) StreamDeltas(stream pilotxds.DeltaDiscoveryStream) err
 		log.Debug("unauthenticated XDS", "peer", peerAddr)
 	}
 
-	con := newDeltaConnection(peerAddr, stream)
+	con := newDeltaConnection(peerAddr, stream, s.FlowControlOptions.SendTimeout)
 
 	// Do not call: defer close(con.pushChannel). The push channel will be garbage collected
 	// when the connection is no longer used. Closing the channel can cause subtle race conditions
@@ -396,12 +415,21 @@ func (s *DiscoveryServer) StreamDeltas(stream pilotxds.DeltaDiscoveryStream) err
 func (s *DiscoveryServer) pushConnectionDelta(con *Connection, pushEv *Event) error {
 	pushRequest := pushEv.PushRequest
 
+	if pushRequest.RemovedGateways.Contains(kgwxds.AgentgatewayID(con.node)) {
+		return s.teardownConnection(con, pushRequest)
+	}
+
 	needsPush := s.ProxyNeedsPush(con.proxy, pushRequest)
 	if !needsPush {
 		log.Debug("skipping push, no updates required", "connection", con.ID())
 		return nil
 	}
 
+	// Do not pile up responses on a proxy that does not keep up; the push is sent once it acknowledges them
+	if deferred, err := s.deferPush(con, pushRequest); deferred || err != nil {
+		return err
+	}
+
 	// Send pushes to all generators
 	// Each Generator is responsible for determining if the push event requires a push
 	wrl := con.watchedResourcesByOrder(s.pushOrder)
@@ -467,10 +495,11 @@ func (conn *Connection) sendDelta(res *discovery.DeltaDiscoveryResponse) error {
 	sendResonse := func() error {
 		start := time.Now()
 		defer func() { xds.RecordSendTime(time.Since(start)) }()
-		return conn.deltaStream.Send(res)
+		return conn.send(res)
 	}
 	err := sendResonse()
 	if err == nil {
+		conn.onResponseSent()
 		if !strings.HasPrefix(res.TypeUrl, v3.DebugType) {
 			conn.proxy.UpdateWatchedResource(res.TypeUrl, func(wr *model.WatchedResource) *model.WatchedResource {
 				if wr == nil {
@@ -495,6 +524,23 @@ func (s *DiscoveryServer) processDeltaRequest(req *discovery.DeltaDiscoveryReque
 	stype := v3.GetShortType(req.TypeUrl)
 	log.Debug("ADS: REQ resources", "type", stype, "connection", con.ID(), "subscribe", len(req.ResourceNamesSubscribe), "unsubscribe", len(req.ResourceNamesUnsubscribe), "nonce", req.ResponseNonce)
 
+	// An ACK or NACK may bring the connection back under the limit of unacked responses, in which case the
+	// deferred push is sent once the request is handled, so that the request does not look like a stale nonce.
+	var deferred *PushRequest
+	if req.ResponseNonce != "" {
+		deferred = s.onResponseAcked(con)
+	}
+	if err := s.respondDelta(req, con); err != nil {
+		return err
+	}
+	if deferred != nil {
+		return s.pushConnectionDelta(con, deferred)
+	}
+	return nil
+}
+
+// respondDelta responds to the request, unless it is an ACK or a NACK.
+func (s *DiscoveryServer) respondDelta(req *discovery.DeltaDiscoveryRequest, con *Connection) error {
 	shouldRespond := shouldRespondDelta(con, req, s.nackPublisher)
 	if !shouldRespond {
 		log.Debug("no response needed")
@@ -678,6 +724,9 @@ func (s *DiscoveryServer) pushDeltaXds(con *Connection, w *model.WatchedResource
 		log.Debug("send failure", "type", v3.GetShortType(w.TypeUrl), "node", con.proxy.ID, "resources", len(res), "size", util.ByteCount(configSize), "error", err)
 		return err
 	}
+	if gen.PerGateway {
+		con.trackSentResources(w.TypeUrl, res, deletedRes)
+	}
 
 	log.Info("push response",
 		"type", v3.GetShortType(w.TypeUrl),
@@ -699,11 +748,13 @@ func (s *DiscoveryServer) IsServerReady() bool {
 	return true
 }
 
-func newDeltaConnection(peerAddr string, stream pilotxds.DeltaDiscoveryStream) *Connection {
+func newDeltaConnection(peerAddr string, stream pilotxds.DeltaDiscoveryStream, sendTimeout time.Duration) *Connection {
 	return &Connection{
-		Connection:   xds.NewConnection(peerAddr, nil),
-		deltaStream:  stream,
-		deltaReqChan: make(chan *discovery.DeltaDiscoveryRequest, 1),
+		Connection:    xds.NewConnection(peerAddr, nil),
+		deltaStream:   stream,
+		deltaReqChan:  make(chan *discovery.DeltaDiscoveryRequest, 1),
+		sentResources: map[string]sets.String{},
+		sendTimeout:   sendTimeout,
 	}
 }
 
@@ -848,6 +899,13 @@ func (s *DiscoveryServer) initConnection(node *envoycorev3.Node, con *Connection
 		}
 	}
 
+	if s.Admitter != nil {
+		if err := s.Admitter.Admit(con.deltaStream.Context(), con.Peer(), node, id); err != nil {
+			log.Warn("ADS: connection not admitted", "node", node.Id, "peer", con.Peer(), "error", err)
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+	}
+
 	// Register the connection. this allows pushes to be triggered for the proxy. Note: the timing of
 	// this and initializeProxy important. While registering for pushes *after* initialization is complete seems like
 	// a better choice, it introduces a race condition; If we complete initialization of a new push
@@ -1209,6 +1267,10 @@ type PushRequest struct {
 	// Delta defines the resources that were added or removed as part of this push request.
 	// This is set only on requests from the client which change the set of resources they (un)subscribe from.
 	Delta xds.ResourceDelta
+
+	// RemovedGateways are the Gateways that were deleted. Connections for these Gateways are sent the
+	// removal of all their per-gateway resources and closed instead of being pushed.
+	RemovedGateways sets.Set[types.NamespacedName]
 }
 
 func (r PushRequest) IsRequest() bool {
@@ -1246,6 +1308,12 @@ func (pr *PushRequest) Merge(other *PushRequest) *PushRequest {
 		}
 	}
 
+	if pr.RemovedGateways == nil {
+		pr.RemovedGateways = other.RemovedGateways
+	} else {
+		pr.RemovedGateways.Merge(other.RemovedGateways)
+	}
+
 	return pr
 }
 
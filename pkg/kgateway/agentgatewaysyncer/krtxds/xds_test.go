@@ -6,19 +6,24 @@ import (
 	"testing"
 
 	"github.com/agentgateway/agentgateway/go/api"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/structpb"
 	"istio.io/istio/pilot/pkg/model"
 	istioxds "istio.io/istio/pilot/pkg/xds"
+	"istio.io/istio/pkg/config"
 	"istio.io/istio/pkg/kube"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/ptr"
 	"istio.io/istio/pkg/test"
 	"istio.io/istio/pkg/test/util/assert"
 	"istio.io/istio/pkg/workloadapi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	agwir "github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/translator"
@@ -31,6 +36,7 @@ type Fake struct {
 	Server      *krtxds.DiscoveryServer
 	Addresses   krt.StaticCollection[agentgatewaysyncer.Address]
 	Resources   krt.StaticCollection[agwir.AgwResource]
+	Gateways    krt.StaticCollection[*gwv1.Gateway]
 	BufListener *bufconn.Listener
 	t           *testing.T
 }
@@ -38,17 +44,19 @@ type Fake struct {
 func NewFakeDiscoveryServer(t *testing.T, initialAddress ...agentgatewaysyncer.Address) Fake {
 	return NewFakeDiscoveryServerWith(t, initialAddress, nil)
 }
-func NewFakeDiscoveryServerWith(t *testing.T, initialAddress []agentgatewaysyncer.Address, initialResource []agwir.AgwResource) Fake {
+func NewFakeDiscoveryServerWith(t *testing.T, initialAddress []agentgatewaysyncer.Address, initialResource []agwir.AgwResource, initialGateways ...*gwv1.Gateway) Fake {
 	stop := test.NewStop(t)
 	opts := krtutil.NewKrtOptions(stop, new(krt.DebugHandler))
 	xdsAddress := krt.NewStaticCollection[agentgatewaysyncer.Address](nil, initialAddress, opts.ToOptions("address")...)
 	xdsResource := krt.NewStaticCollection[agwir.AgwResource](nil, initialResource, opts.ToOptions("resource")...)
+	gateways := krt.NewStaticCollection[*gwv1.Gateway](nil, initialGateways, opts.ToOptions("gateways")...)
 	agwResourcesByGateway := func(resource agwir.AgwResource) types.NamespacedName {
 		return resource.Gateway
 	}
 	reg := []krtxds.Registration{
 		krtxds.Collection[agentgatewaysyncer.Address, *workloadapi.Address](xdsAddress, opts),
 		krtxds.PerGatewayCollection[agwir.AgwResource, *api.Resource](xdsResource, agwResourcesByGateway, opts),
+		krtxds.GatewayRemovals(gateways, config.NamespacedName[*gwv1.Gateway]),
 	}
 	// we won't need a mock nack event publisher for this testing, so we pass nil
 	s := krtxds.NewDiscoveryServer(opts.Debugger, nil, reg...)
@@ -82,6 +90,7 @@ func NewFakeDiscoveryServerWith(t *testing.T, initialAddress []agentgatewaysynce
 		BufListener: listener,
 		Addresses:   xdsAddress,
 		Resources:   xdsResource,
+		Gateways:    gateways,
 	}
 }
 
@@ -214,3 +223,49 @@ func TestXDSDisconnect(t *testing.T) {
 		assert.Equal(t, len(resp.RemovedResources), 1)
 	})
 }
+
+func TestXDSGatewayRemoval(t *testing.T) {
+	gw := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "gw", Namespace: "default"}}
+	other := &gwv1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default"}}
+	bind := func(key string, gw types.NamespacedName) agwir.AgwResource {
+		return agwir.AgwResource{
+			Resource: &api.Resource{
+				Kind: &api.Resource_Bind{Bind: &api.Bind{Key: key}},
+			},
+			Gateway: gw,
+		}
+	}
+	node := func(gw types.NamespacedName) *envoycorev3.Node {
+		return &envoycorev3.Node{
+			Id: gw.Name,
+			Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+				"role": structpb.NewStringValue(gw.Namespace + "~" + gw.Name),
+			}},
+		}
+	}
+	s := NewFakeDiscoveryServerWith(t, []agentgatewaysyncer.Address{testWorkload1}, []agwir.AgwResource{
+		bind("shared", types.NamespacedName{}),
+		bind("bind1", config.NamespacedName(gw)),
+		bind("bind2", config.NamespacedName(gw)),
+		bind("other", config.NamespacedName(other)),
+	}, gw, other)
+
+	ads := s.ConnectDeltaADS().WithType(translator.TargetTypeResourceUrl)
+	resp := ads.RequestResponseAck(&discovery.DeltaDiscoveryRequest{Node: node(config.NamespacedName(gw))})
+	assert.Equal(t, len(resp.Resources), 3)
+	addresses := s.ConnectDeltaADS().WithType(translator.TargetTypeAddressUrl)
+	addresses.RequestResponseAck(&discovery.DeltaDiscoveryRequest{Node: node(config.NamespacedName(gw))})
+	otherAds := s.ConnectDeltaADS().WithType(translator.TargetTypeResourceUrl)
+	otherAds.RequestResponseAck(&discovery.DeltaDiscoveryRequest{Node: node(config.NamespacedName(other))})
+
+	s.Gateways.DeleteObject("default/gw")
+
+	// every per-gateway resource that was sent is removed before the connection is closed
+	resp = ads.ExpectResponse()
+	assert.Equal(t, len(resp.Resources), 0)
+	assert.Equal(t, len(resp.RemovedResources), 3)
+	ads.ExpectError()
+	// addresses are not scoped to the gateway, so the connection is closed without removing them
+	addresses.ExpectError()
+	otherAds.ExpectNoResponse()
+}
@@ -4,7 +4,9 @@ import (
 	"istio.io/istio/pkg/kube/controllers"
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/ptr"
+	"istio.io/istio/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/ir"
@@ -16,7 +18,15 @@ import (
 
 type PolicyStatusCollections = map[schema.GroupKind]krt.StatusCollection[controllers.Object, gwv1.PolicyStatus]
 
-func AgwPolicyCollection(agwPlugins plugins.AgwPlugin, ancestors krt.Collection[*utils.AncestorBackend], krtopts krtutil.KrtOptions) (krt.Collection[ir.AgwResource], PolicyStatusCollections) {
+// AgwPolicyCollection builds the policies of all the plugins. The policies targeting a route are only sent to the
+// gateways the route is attached to, according to routeAttachments. If routeAttachments is nil, all the policies are
+// sent to all the gateways.
+func AgwPolicyCollection(
+	agwPlugins plugins.AgwPlugin,
+	ancestors krt.Collection[*utils.AncestorBackend],
+	routeAttachments krt.Collection[*translator.RouteAttachment],
+	krtopts krtutil.KrtOptions,
+) (krt.Collection[ir.AgwResource], PolicyStatusCollections) {
 	var allPolicies []krt.Collection[plugins.AgwPolicy]
 	policyStatusMap := PolicyStatusCollections{}
 	ancestorsIndex := krt.NewIndex(ancestors, "ancestors", func(o *utils.AncestorBackend) []utils.TypedNamespacedName {
@@ -36,8 +46,37 @@ func AgwPolicyCollection(agwPlugins plugins.AgwPlugin, ancestors krt.Collection[
 	}
 	joinPolicies := krt.JoinCollection(allPolicies, krtopts.ToOptions("JoinPolicies")...)
 
-	allPoliciesCol := krt.NewCollection(joinPolicies, func(ctx krt.HandlerContext, i plugins.AgwPolicy) *ir.AgwResource {
-		return ptr.Of(translator.ToResourceGlobal(i))
+	if routeAttachments == nil {
+		allPoliciesCol := krt.NewCollection(joinPolicies, func(ctx krt.HandlerContext, i plugins.AgwPolicy) *ir.AgwResource {
+			return ptr.Of(translator.ToResourceGlobal(i))
+		}, krtopts.ToOptions("AllPolicies")...)
+		return allPoliciesCol, policyStatusMap
+	}
+
+	// index the gateways a route is attached to by the route, including its kind
+	routeAttachmentsIndex := krt.NewIndex(routeAttachments, "from", func(o *translator.RouteAttachment) []utils.TypedNamespacedName {
+		return []utils.TypedNamespacedName{{NamespacedName: o.From.Name, Kind: o.From.Kind.Kind}}
+	})
+	allPoliciesCol := krt.NewManyCollection(joinPolicies, func(ctx krt.HandlerContext, i plugins.AgwPolicy) []ir.AgwResource {
+		route := i.Policy.GetTarget().GetRoute()
+		if route == nil {
+			return []ir.AgwResource{translator.ToResourceGlobal(i)}
+		}
+		key := utils.TypedNamespacedName{
+			NamespacedName: types.NamespacedName{Namespace: route.GetNamespace(), Name: route.GetName()},
+			Kind:           route.GetKind(),
+		}
+		attachments := krt.Fetch(ctx, routeAttachments, krt.FilterIndex(routeAttachmentsIndex, key))
+		// a route attached to several listeners of a gateway gets a single copy of the policy for the gateway
+		gateways := sets.New[types.NamespacedName]()
+		res := make([]ir.AgwResource, 0, len(attachments))
+		for _, a := range attachments {
+			if gateways.InsertContains(a.To) {
+				continue
+			}
+			res = append(res, translator.ToResourceForGateway(a.To, i))
+		}
+		return res
 	}, krtopts.ToOptions("AllPolicies")...)
 
 	return allPoliciesCol, policyStatusMap
@@ -0,0 +1,76 @@
+package agentgatewaysyncer
+
+import (
+	"testing"
+
+	"github.com/agentgateway/agentgateway/go/api"
+	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/kube/controllers"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/test"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/plugins"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/translator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/agentgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
+)
+
+func TestAgwPolicyCollectionRouteTargets(t *testing.T) {
+	stop := test.NewStop(t)
+	krtopts := krtutil.NewKrtOptions(stop, new(krt.DebugHandler))
+
+	gw1 := types.NamespacedName{Namespace: "default", Name: "gw1"}
+	gw2 := types.NamespacedName{Namespace: "default", Name: "gw2"}
+	route := types.NamespacedName{Namespace: "default", Name: "route"}
+
+	policies := krt.NewStaticCollection(nil, []plugins.AgwPolicy{
+		{Policy: &api.Policy{
+			Key:    "route",
+			Target: &api.PolicyTarget{Kind: utils.RouteTarget[string](route.Namespace, route.Name, wellknown.HTTPRouteGVK.Kind, nil)},
+		}},
+		{Policy: &api.Policy{
+			// a GRPCRoute with the same name is not attached to any gateway
+			Key:    "grpc-route",
+			Target: &api.PolicyTarget{Kind: utils.RouteTarget[string](route.Namespace, route.Name, wellknown.GRPCRouteGVK.Kind, nil)},
+		}},
+		{Policy: &api.Policy{
+			Key:    "gateway",
+			Target: &api.PolicyTarget{Kind: utils.GatewayTarget[string](gw2.Namespace, gw2.Name, nil)},
+		}},
+	}, krtopts.ToOptions("Policies")...)
+	plugin := plugins.AgwPlugin{
+		ContributesPolicies: map[schema.GroupKind]plugins.PolicyPlugin{
+			wellknown.AgentgatewayPolicyGVK.GroupKind(): {
+				Build: func(plugins.PolicyPluginInput) (krt.StatusCollection[controllers.Object, gwv1.PolicyStatus], krt.Collection[plugins.AgwPolicy]) {
+					return nil, policies
+				},
+			},
+		},
+	}
+
+	from := translator.TypedResource{Kind: wellknown.HTTPRouteGVK, Name: route}
+	routeAttachments := krt.NewStaticCollection(nil, []*translator.RouteAttachment{
+		{From: from, To: gw1, ListenerName: "http"},
+		{From: from, To: gw1, ListenerName: "https"},
+	}, krtopts.ToOptions("RouteAttachments")...)
+	ancestors := krt.NewStaticCollection[*utils.AncestorBackend](nil, nil, krtopts.ToOptions("AncestorBackend")...)
+
+	col, _ := AgwPolicyCollection(plugin, ancestors, routeAttachments, krtopts)
+	col.WaitUntilSynced(stop)
+
+	got := map[string][]types.NamespacedName{}
+	for _, res := range col.List() {
+		key := res.Resource.GetPolicy().GetKey()
+		got[key] = append(got[key], res.Gateway)
+	}
+	assert.Equal(t, map[string][]types.NamespacedName{
+		// the route policy is only sent once to the gateway the route is attached to
+		"route": {gw1},
+		// the gateway policies are global
+		"gateway": {{}},
+	}, got)
+}
@@ -337,7 +337,7 @@ func (s *Syncer) buildAgwResources(
 		agwRoutes = krt.JoinCollection([]krt.Collection[agwir.AgwResource]{agwRoutes, s.agwPlugins.AddResourceExtension.Routes})
 	}
 
-	agwPolicies, policyStatuses := AgwPolicyCollection(s.agwPlugins, ancestorBackends, krtopts)
+	agwPolicies, policyStatuses := AgwPolicyCollection(s.agwPlugins, ancestorBackends, routeAttachments, krtopts)
 
 	// Create an agentgateway backend collection from the kgateway backend resources
 	agwBackendStatus, agwBackends := s.newAgwBackendCollection(s.agwCollections.Backends, krtopts)
@@ -543,6 +543,7 @@ func (s *Syncer) buildXDSCollection(
 	}
 	s.Registrations = append(s.Registrations, krtxds.Collection[Address, *workloadapi.Address](xdsAddresses, krtopts))
 	s.Registrations = append(s.Registrations, krtxds.PerGatewayCollection[agwir.AgwResource, *api.Resource](agwResources, agwResourcesByGateway, krtopts))
+	s.Registrations = append(s.Registrations, krtxds.GatewayRemovals(s.agwCollections.Gateways, config.NamespacedName[*gwv1.Gateway]))
 }
 
 func (s *Syncer) setupSyncDependencies(
@@ -0,0 +1,282 @@
+package configexport
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/yaml"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
+)
+
+var logger = logging.New("configexport")
+
+var _ manager.LeaderElectionRunnable = &Exporter{}
+
+var (
+	// ErrNotLeader is returned when exporting from a controller replica that is not the leader, as only the leader
+	// writes to the export directory.
+	ErrNotLeader = errors.New("config is exported by the leader controller replica")
+	// ErrNotSynced is returned when exporting before the snapshots are synced, as the configuration of the Gateways
+	// not yet translated would be removed.
+	ErrNotSynced = errors.New("snapshots are not synced yet")
+)
+
+const (
+	// markerFile is written to the directory of each exported Gateway. Only the directories carrying it are removed
+	// when their Gateway no longer exists, so that other content of the export directory is never deleted.
+	markerFile    = ".kgateway-export"
+	markerContent = "# Written by the kgateway config exporter. This directory is removed when its Gateway is deleted.\n"
+)
+
+// exportedTypes are the xDS resource types written for each Gateway, by file name.
+var exportedTypes = map[string]envoycachetypes.ResponseType{
+	"clusters.yaml":  envoycachetypes.Cluster,
+	"listeners.yaml": envoycachetypes.Listener,
+	"routes.yaml":    envoycachetypes.Route,
+	"secrets.yaml":   envoycachetypes.Secret,
+}
+
+// Exporter writes the xDS configuration translated for each Gateway to a directory, as one file per resource type
+// under <namespace>/<name>/. Resources are sorted by name and written as YAML, and files are only rewritten when their
+// content changed, so the directory can be committed to git to keep an auditable history of the effective dataplane
+// configuration. Secrets are redacted to their names.
+type Exporter struct {
+	dir       string
+	interval  time.Duration
+	hasSynced func() bool
+	snapshots func() map[types.NamespacedName]*envoycache.Snapshot
+
+	// elected is set once the replica is elected leader and started the exporter
+	elected atomic.Bool
+	// lock serializes the periodic and on-demand exports
+	lock sync.Mutex
+}
+
+// NewExporter returns an Exporter writing the given snapshots to dir every interval, once hasSynced returns true.
+// If interval is 0, the configuration is only exported on demand.
+func NewExporter(
+	dir string,
+	interval time.Duration,
+	hasSynced func() bool,
+	snapshots func() map[types.NamespacedName]*envoycache.Snapshot,
+) *Exporter {
+	return &Exporter{
+		dir:       dir,
+		interval:  interval,
+		hasSynced: hasSynced,
+		snapshots: snapshots,
+	}
+}
+
+// NeedLeaderElection returns true so that a single controller replica writes to a shared directory.
+func (e *Exporter) NeedLeaderElection() bool {
+	return true
+}
+
+// Start allows exports from this replica, and exports the configuration periodically once the snapshots are synced
+// until the context is canceled. It is only called on the leader.
+func (e *Exporter) Start(ctx context.Context) error {
+	e.elected.Store(true)
+	if e.interval <= 0 {
+		return nil
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), e.hasSynced) {
+		return nil
+	}
+	logger.Info("starting config exporter", "dir", e.dir, "interval", e.interval)
+	ticker := time.NewTicker(e.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if _, err := e.Export(); err != nil {
+				logger.Error("error exporting config", "error", err, "dir", e.dir)
+			}
+		}
+	}
+}
+
+// Export writes the configuration of all Gateways and removes the configuration of Gateways that no longer exist.
+// It returns the Gateways whose configuration changed, or ErrNotLeader or ErrNotSynced when the configuration cannot
+// be exported yet.
+func (e *Exporter) Export() ([]types.NamespacedName, error) {
+	if !e.elected.Load() {
+		return nil, ErrNotLeader
+	}
+	if !e.hasSynced() {
+		return nil, ErrNotSynced
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err := os.MkdirAll(e.dir, 0o755); err != nil {
+		return nil, err
+	}
+	snapshots := e.snapshots()
+	var changed []types.NamespacedName
+	var errs []error
+	for gw, snap := range snapshots {
+		updated, err := e.exportGateway(gw, snap)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("gateway %s: %w", gw, err))
+			continue
+		}
+		if updated {
+			changed = append(changed, gw)
+		}
+	}
+	removed, err := e.pruneGateways(snapshots)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	changed = append(changed, removed...)
+	slices.SortFunc(changed, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return changed, errors.Join(errs...)
+}
+
+func (e *Exporter) exportGateway(gw types.NamespacedName, snap *envoycache.Snapshot) (bool, error) {
+	dir := filepath.Join(e.dir, gw.Namespace, gw.Name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return false, err
+	}
+	if _, err := writeIfChanged(filepath.Join(dir, markerFile), []byte(markerContent)); err != nil {
+		return false, err
+	}
+	updated := false
+	for file, typ := range exportedTypes {
+		data, err := marshalResources(snap.Resources[typ].Items)
+		if err != nil {
+			return false, err
+		}
+		written, err := writeIfChanged(filepath.Join(dir, file), data)
+		if err != nil {
+			return false, err
+		}
+		updated = updated || written
+	}
+	return updated, nil
+}
+
+// pruneGateways removes the directories of exported Gateways that are not in the snapshots.
+// Only the directories carrying the marker file are removed, and entries starting with a dot, such as .git, are
+// left untouched.
+func (e *Exporter) pruneGateways(snapshots map[types.NamespacedName]*envoycache.Snapshot) ([]types.NamespacedName, error) {
+	namespaces, err := os.ReadDir(e.dir)
+	if err != nil {
+		return nil, err
+	}
+	var removed []types.NamespacedName
+	var errs []error
+	for _, ns := range namespaces {
+		if !ns.IsDir() || strings.HasPrefix(ns.Name(), ".") {
+			continue
+		}
+		gws, err := os.ReadDir(filepath.Join(e.dir, ns.Name()))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		remaining := len(gws)
+		for _, gw := range gws {
+			nn := types.NamespacedName{Namespace: ns.Name(), Name: gw.Name()}
+			if !gw.IsDir() || strings.HasPrefix(gw.Name(), ".") || snapshots[nn] != nil {
+				continue
+			}
+			if _, err := os.Stat(filepath.Join(e.dir, nn.Namespace, nn.Name, markerFile)); err != nil {
+				if !errors.Is(err, os.ErrNotExist) {
+					errs = append(errs, err)
+				}
+				continue
+			}
+			if err := os.RemoveAll(filepath.Join(e.dir, nn.Namespace, nn.Name)); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			removed = append(removed, nn)
+			remaining--
+		}
+		if remaining == 0 {
+			if err := os.Remove(filepath.Join(e.dir, ns.Name())); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return removed, errors.Join(errs...)
+}
+
+// marshalResources returns the resources as a YAML list sorted by resource name.
+func marshalResources(items map[string]envoycachetypes.ResourceWithTTL) ([]byte, error) {
+	names := make([]string, 0, len(items))
+	for name := range items {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	resources := make([]byte, 0, len(names)*256)
+	resources = append(resources, '[')
+	for i, name := range names {
+		var res proto.Message = items[name].Resource
+		if secret, ok := res.(*envoytlsv3.Secret); ok {
+			res = &envoytlsv3.Secret{Name: secret.GetName()}
+		}
+		// protojson output is not stable, but it is normalized by the conversion to YAML
+		b, err := protojson.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("resource %s: %w", name, err)
+		}
+		if i > 0 {
+			resources = append(resources, ',')
+		}
+		resources = append(resources, b...)
+	}
+	resources = append(resources, ']')
+	return yaml.JSONToYAML(resources)
+}
+
+// writeIfChanged atomically writes the file if its content differs, and returns whether it was written.
+func writeIfChanged(path string, data []byte) (bool, error) {
+	existing, err := os.ReadFile(path)
+	if err == nil && bytes.Equal(existing, data) {
+		return false, nil
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return false, err
+	}
+	defer os.Remove(tmp.Name())
+	if err := tmp.Chmod(0o644); err != nil {
+		tmp.Close()
+		return false, err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return false, err
+	}
+	if err := tmp.Close(); err != nil {
+		return false, err
+	}
+	return true, os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,78 @@
+package configexport
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestExport(t *testing.T) {
+	r := require.New(t)
+	dir := t.TempDir()
+	r.NoError(os.Mkdir(filepath.Join(dir, ".git"), 0o755))
+
+	gw := types.NamespacedName{Namespace: "default", Name: "gw"}
+	snap := &envoycache.Snapshot{}
+	snap.Resources[envoycachetypes.Cluster] = envoycache.NewResources("1", []envoycachetypes.Resource{
+		&envoyclusterv3.Cluster{Name: "b"},
+		&envoyclusterv3.Cluster{Name: "a"},
+	})
+	snap.Resources[envoycachetypes.Secret] = envoycache.NewResources("1", []envoycachetypes.Resource{
+		&envoytlsv3.Secret{
+			Name: "cert",
+			Type: &envoytlsv3.Secret_TlsCertificate{TlsCertificate: &envoytlsv3.TlsCertificate{
+				PrivateKey: &envoycorev3.DataSource{Specifier: &envoycorev3.DataSource_InlineString{InlineString: "private-key"}},
+			}},
+		},
+	})
+	snapshots := map[types.NamespacedName]*envoycache.Snapshot{gw: snap}
+	synced := false
+	e := NewExporter(dir, 0, func() bool { return synced }, func() map[types.NamespacedName]*envoycache.Snapshot { return snapshots })
+
+	// only the leader exports, once the snapshots are synced
+	_, err := e.Export()
+	r.ErrorIs(err, ErrNotLeader)
+	r.NoError(e.Start(context.Background()))
+	_, err = e.Export()
+	r.ErrorIs(err, ErrNotSynced)
+	synced = true
+
+	changed, err := e.Export()
+	r.NoError(err)
+	r.Equal([]types.NamespacedName{gw}, changed)
+
+	clusters, err := os.ReadFile(filepath.Join(dir, "default", "gw", "clusters.yaml"))
+	r.NoError(err)
+	r.Equal("- name: a\n- name: b\n", string(clusters))
+	secrets, err := os.ReadFile(filepath.Join(dir, "default", "gw", "secrets.yaml"))
+	r.NoError(err)
+	r.Equal("- name: cert\n", string(secrets))
+	listeners, err := os.ReadFile(filepath.Join(dir, "default", "gw", "listeners.yaml"))
+	r.NoError(err)
+	r.Equal("[]\n", string(listeners))
+	r.FileExists(filepath.Join(dir, "default", "gw", markerFile))
+
+	// unchanged configuration is not rewritten
+	changed, err = e.Export()
+	r.NoError(err)
+	r.Empty(changed)
+
+	// the configuration of deleted Gateways is removed, but not the directories the exporter did not write
+	r.NoError(os.MkdirAll(filepath.Join(dir, "docs", "notes"), 0o755))
+	snapshots = map[types.NamespacedName]*envoycache.Snapshot{}
+	changed, err = e.Export()
+	r.NoError(err)
+	r.Equal([]types.NamespacedName{gw}, changed)
+	r.NoDirExists(filepath.Join(dir, "default"))
+	r.DirExists(filepath.Join(dir, "docs", "notes"))
+	r.DirExists(filepath.Join(dir, ".git"))
+}
@@ -61,6 +61,12 @@ type GatewayConfig struct {
 	AdditionalGatewayClasses map[string]*deployer.GatewayClassInfo
 	// CertWatcher is the shared certificate watcher for xDS TLS
 	CertWatcher *certwatcher.CertWatcher
+	// PropagatedLabelPrefixes is the allowlist of Gateway label key prefixes the deployer
+	// propagates onto the resources it generates.
+	PropagatedLabelPrefixes []string
+	// PropagatedAnnotationPrefixes is the allowlist of Gateway annotation key prefixes the deployer
+	// propagates onto the resources it generates.
+	PropagatedAnnotationPrefixes []string
 }
 
 type HelmValuesGeneratorOverrideFunc func(inputs *deployer.Inputs) deployer.HelmValuesGenerator
@@ -99,15 +105,17 @@ func watchGw(
 	)
 
 	inputs := &deployer.Inputs{
-		Dev:                        cfg.Dev,
-		IstioAutoMtlsEnabled:       cfg.IstioAutoMtlsEnabled,
-		ControlPlane:               cfg.ControlPlane,
-		ImageInfo:                  cfg.ImageInfo,
-		CommonCollections:          cfg.CommonCollections,
-		GatewayClassName:           cfg.GatewayClassName,
-		WaypointGatewayClassName:   cfg.WaypointGatewayClassName,
-		AgentgatewayClassName:      cfg.AgentgatewayClassName,
-		AgentgatewayControllerName: cfg.AgwControllerName,
+		Dev:                          cfg.Dev,
+		IstioAutoMtlsEnabled:         cfg.IstioAutoMtlsEnabled,
+		ControlPlane:                 cfg.ControlPlane,
+		ImageInfo:                    cfg.ImageInfo,
+		CommonCollections:            cfg.CommonCollections,
+		GatewayClassName:             cfg.GatewayClassName,
+		WaypointGatewayClassName:     cfg.WaypointGatewayClassName,
+		AgentgatewayClassName:        cfg.AgentgatewayClassName,
+		AgentgatewayControllerName:   cfg.AgwControllerName,
+		PropagatedLabelPrefixes:      cfg.PropagatedLabelPrefixes,
+		PropagatedAnnotationPrefixes: cfg.PropagatedAnnotationPrefixes,
 	}
 
 	gwParams := internaldeployer.NewGatewayParameters(cfg.Client, inputs)
@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
@@ -402,6 +403,10 @@ func (r *gatewayReconciler) Reconcile(req types.NamespacedName) (rErr error) {
 	if err != nil {
 		return err
 	}
+	// remove resources generated under a previous name, e.g. after the resourceNaming changed
+	if err := r.deployer.PruneStaleObjs(ctx, gw, objs, r.cachedGeneratedObjs(gw.Namespace)); err != nil {
+		return err
+	}
 
 	// find the name/ns of the service we own so we can grab addresses
 	// from it for status
@@ -421,6 +426,26 @@ func (r *gatewayReconciler) Reconcile(req types.NamespacedName) (rErr error) {
 	return nil
 }
 
+// cachedGeneratedObjs returns the objects of the namespace of the kinds generated for the Gateways,
+// read from the caches of the reconciler.
+func (r *gatewayReconciler) cachedGeneratedObjs(namespace string) map[schema.GroupVersionKind][]client.Object {
+	return map[schema.GroupVersionKind][]client.Object{
+		wellknown.DeploymentGVK:     cachedObjs(r.deploymentClient, namespace),
+		wellknown.ServiceGVK:        cachedObjs(r.svcClient, namespace),
+		wellknown.ServiceAccountGVK: cachedObjs(r.svcAccountClient, namespace),
+		wellknown.ConfigMapGVK:      cachedObjs(r.configMapClient, namespace),
+	}
+}
+
+func cachedObjs[T controllers.Object](c kclient.Client[T], namespace string) []client.Object {
+	items := c.List(namespace, labels.Everything())
+	objs := make([]client.Object, 0, len(items))
+	for _, item := range items {
+		objs = append(objs, item)
+	}
+	return objs
+}
+
 func (r *gatewayReconciler) updateStatus(ctx context.Context, gw *gwv1.Gateway, svcMeta *metav1.ObjectMeta) error {
 	var svc *corev1.Service
 	if svcMeta != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/agentgatewaysyncer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/agentgatewaysyncer/backend/inferencepool"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/bootstrap"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/configexport"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/waypoint"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/registry"
@@ -49,6 +50,18 @@ type SetupOpts struct {
 
 	KrtDebugger *krt.DebugHandler
 
+	// RouteDuplicates returns the routes that claim the same hostname and match on different
+	// Gateways of the same GatewayClass. It is set when the Envoy proxy syncer is initialized.
+	RouteDuplicates func() []proxy_syncer.RouteDuplicate
+
+	// GatewaySnapshot returns the xDS snapshot translated for the Gateway serving the given xDS cache key.
+	// It is set when the Envoy proxy syncer is initialized.
+	GatewaySnapshot func(key string) *envoycache.Snapshot
+
+	// ConfigExporter exports the xDS configuration of each Gateway to a directory. It is set when the Envoy
+	// proxy syncer is initialized and KGW_CONFIG_EXPORT_DIR is set.
+	ConfigExporter *configexport.Exporter
+
 	// static set of global Settings
 	GlobalSettings *apisettings.Settings
 
@@ -183,11 +196,25 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 			cfg.Validator,
 		)
 		proxySyncer.Init(ctx, cfg.KrtOptions)
+		cfg.SetupOpts.RouteDuplicates = proxySyncer.RouteDuplicates
+		cfg.SetupOpts.GatewaySnapshot = proxySyncer.GatewaySnapshot
 		if err := cfg.Manager.Add(proxySyncer); err != nil {
 			setupLog.Error(err, "unable to add proxySyncer runnable")
 			return nil, err
 		}
 
+		if dir := cfg.SetupOpts.GlobalSettings.ConfigExportDir; dir != "" {
+			exporter := configexport.NewExporter(dir, cfg.SetupOpts.GlobalSettings.ConfigExportInterval, proxySyncer.HasSynced, proxySyncer.GatewaySnapshots)
+			if err := cfg.Manager.Add(exporter); err != nil {
+				setupLog.Error(err, "unable to add config exporter runnable")
+				return nil, err
+			}
+			cfg.SetupOpts.ConfigExporter = exporter
+		}
+
+		statusSyncerOpts := append([]proxy_syncer.StatusSyncerOption{
+			proxy_syncer.WithReadyProxyReplicas(cfg.SetupOpts.GlobalSettings.GatewayProgrammedRequiresXdsAck),
+		}, cfg.StatusSyncerOptions...)
 		statusSyncer := proxy_syncer.NewStatusSyncer(
 			cfg.Manager,
 			mergedPlugins,
@@ -198,7 +225,7 @@ func NewControllerBuilder(ctx context.Context, cfg StartConfig) (*ControllerBuil
 			proxySyncer.ReportQueue(),
 			proxySyncer.BackendPolicyReportQueue(),
 			proxySyncer.CacheSyncs(),
-			cfg.StatusSyncerOptions...,
+			statusSyncerOpts...,
 		)
 		if err := cfg.Manager.Add(statusSyncer); err != nil {
 			setupLog.Error(err, "unable to add statusSyncer runnable")
@@ -348,6 +375,9 @@ func (c *ControllerBuilder) Build(ctx context.Context) (*agentgatewaysyncer.Sync
 		WaypointGatewayClassName: c.cfg.WaypointGatewayClassName,
 		AgentgatewayClassName:    c.cfg.AgentgatewayClassName,
 		CertWatcher:              c.cfg.SetupOpts.CertWatcher,
+
+		PropagatedLabelPrefixes:      globalSettings.PropagatedGatewayLabelPrefixes,
+		PropagatedAnnotationPrefixes: globalSettings.PropagatedGatewayAnnotationPrefixes,
 	}
 
 	setupLog.Info("creating base gateway controller")
@@ -78,6 +78,17 @@ func (a *AgentgatewayParametersApplier) ApplyToHelmValues(vals *deployer.HelmCon
 		setIfNonZero(&res.Istio.TrustDomain, configs.Istio.TrustDomain)
 	}
 	setIfNonNil(&res.RawConfig, configs.RawConfig)
+	setIfNonNil(&res.ReadinessProbe, configs.ReadinessProbe)
+	setIfNonNil(&res.StartupProbe, configs.StartupProbe)
+	setIfNonNil(&res.LivenessProbe, configs.LivenessProbe)
+	setIfNonNil(&res.Lifecycle, configs.Lifecycle)
+	// Unlike the GatewayParameters pod settings, which append the slices with deployer.DeepMergeSlices, the
+	// items are merged by name as with env: appending would duplicate the names set at both levels, which
+	// Kubernetes rejects, whereas replacing lets the Gateway parameters override a GatewayClass item.
+	res.ExtraVolumes = mergeByName(res.ExtraVolumes, configs.ExtraVolumes, func(v corev1.Volume) string { return v.Name })
+	res.ExtraVolumeMounts = mergeByName(res.ExtraVolumeMounts, configs.ExtraVolumeMounts, func(m corev1.VolumeMount) string { return m.MountPath })
+	res.ExtraContainers = mergeByName(res.ExtraContainers, configs.ExtraContainers, func(c corev1.Container) string { return c.Name })
+	res.ExtraInitContainers = mergeByName(res.ExtraInitContainers, configs.ExtraInitContainers, func(c corev1.Container) string { return c.Name })
 
 	// Apply logging.level as RUST_LOG first, then merge explicit env vars on top.
 	// This ensures explicit env vars override logging.level if both specify RUST_LOG.
@@ -99,6 +110,13 @@ func (a *AgentgatewayParametersApplier) ApplyToHelmValues(vals *deployer.HelmCon
 // Variables in 'override' take precedence over variables in 'base' with the same name.
 // The order is preserved: base vars first (minus overridden ones), then override vars.
 func mergeEnvVars(base, override []corev1.EnvVar) []corev1.EnvVar {
+	return mergeByName(base, override, func(env corev1.EnvVar) string { return env.Name })
+}
+
+// mergeByName merges two slices of named items, such as volumes or containers.
+// Items in 'override' take precedence over items in 'base' with the same name.
+// The order is preserved: base items first (minus overridden ones), then override items.
+func mergeByName[T any](base, override []T, name func(T) string) []T {
 	if len(override) == 0 {
 		return base
 	}
@@ -108,19 +126,19 @@ func mergeEnvVars(base, override []corev1.EnvVar) []corev1.EnvVar {
 
 	// Build a set of names in override
 	overrideNames := make(map[string]struct{}, len(override))
-	for _, env := range override {
-		overrideNames[env.Name] = struct{}{}
+	for _, item := range override {
+		overrideNames[name(item)] = struct{}{}
 	}
 
-	// Keep base vars that are not overridden
-	result := make([]corev1.EnvVar, 0, len(base)+len(override))
-	for _, env := range base {
-		if _, exists := overrideNames[env.Name]; !exists {
-			result = append(result, env)
+	// Keep base items that are not overridden
+	result := make([]T, 0, len(base)+len(override))
+	for _, item := range base {
+		if _, exists := overrideNames[name(item)]; !exists {
+			result = append(result, item)
 		}
 	}
 
-	// Append all override vars
+	// Append all override items
 	result = append(result, override...)
 	return result
 }
@@ -284,10 +302,7 @@ func (g *agentgatewayParametersHelmValuesGenerator) getDefaultAgentgatewayHelmVa
 		},
 	}
 
-	if i := gw.Spec.Infrastructure; i != nil {
-		gtw.GatewayAnnotations = translateInfraMeta(i.Annotations)
-		gtw.GatewayLabels = translateInfraMeta(i.Labels)
-	}
+	gtw.GatewayAnnotations, gtw.GatewayLabels = gatewayMeta(gw, g.inputs)
 
 	gtw.Image = &agentgateway.Image{
 		Registry:   ptr.To(deployer.AgentgatewayRegistry),
@@ -98,6 +98,75 @@ func TestAgentgatewayParametersApplier_ApplyToHelmValues_Env(t *testing.T) {
 	assert.Equal(t, "ANOTHER_VAR", vals.Agentgateway.Env[1].Name)
 }
 
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_ExtraContainers(t *testing.T) {
+	params := &agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				ExtraContainers: []corev1.Container{
+					{Name: "log-shipper", Image: "log-shipper:v2"},
+					{Name: "sidecar", Image: "sidecar:v1"},
+				},
+				ExtraVolumes: []corev1.Volume{
+					{Name: "logs"},
+				},
+			},
+		},
+	}
+
+	applier := NewAgentgatewayParametersApplier(params)
+	vals := &deployer.HelmConfig{
+		Agentgateway: &deployer.AgentgatewayHelmGateway{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				ExtraContainers: []corev1.Container{
+					{Name: "log-shipper", Image: "log-shipper:v1"},
+					{Name: "metrics", Image: "metrics:v1"},
+				},
+				ExtraInitContainers: []corev1.Container{
+					{Name: "fetch-secrets", Image: "fetch-secrets:v1"},
+				},
+			},
+		},
+	}
+
+	applier.ApplyToHelmValues(vals)
+
+	// containers with the same name are replaced
+	require.Len(t, vals.Agentgateway.ExtraContainers, 3)
+	assert.Equal(t, "metrics", vals.Agentgateway.ExtraContainers[0].Name)
+	assert.Equal(t, "log-shipper:v2", vals.Agentgateway.ExtraContainers[1].Image)
+	assert.Equal(t, "sidecar", vals.Agentgateway.ExtraContainers[2].Name)
+	require.Len(t, vals.Agentgateway.ExtraInitContainers, 1)
+	require.Len(t, vals.Agentgateway.ExtraVolumes, 1)
+}
+
+func TestAgentgatewayParametersApplier_ApplyToHelmValues_Probes(t *testing.T) {
+	params := &agentgateway.AgentgatewayParameters{
+		Spec: agentgateway.AgentgatewayParametersSpec{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				StartupProbe: &corev1.Probe{FailureThreshold: 300},
+			},
+		},
+	}
+
+	applier := NewAgentgatewayParametersApplier(params)
+	vals := &deployer.HelmConfig{
+		Agentgateway: &deployer.AgentgatewayHelmGateway{
+			AgentgatewayParametersConfigs: agentgateway.AgentgatewayParametersConfigs{
+				StartupProbe:  &corev1.Probe{FailureThreshold: 120},
+				LivenessProbe: &corev1.Probe{PeriodSeconds: 5},
+			},
+		},
+	}
+
+	applier.ApplyToHelmValues(vals)
+
+	// probes are replaced as a whole
+	assert.Equal(t, &corev1.Probe{FailureThreshold: 300}, vals.Agentgateway.StartupProbe)
+	assert.Equal(t, &corev1.Probe{PeriodSeconds: 5}, vals.Agentgateway.LivenessProbe)
+	assert.Nil(t, vals.Agentgateway.ReadinessProbe)
+	assert.Nil(t, vals.Agentgateway.Lifecycle)
+}
+
 func TestAgentgatewayParametersApplier_ApplyOverlaysToObjects(t *testing.T) {
 	specPatch := []byte(`{
 		"replicas": 3
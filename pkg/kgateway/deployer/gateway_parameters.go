@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"helm.sh/helm/v3/pkg/chart"
 	"istio.io/istio/pkg/kube/kclient"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/utils/ptr"
@@ -30,6 +32,9 @@ var (
 
 	// ErrNotFound is returned when a requested resource is not found
 	ErrNotFound = errors.New("resource not found")
+
+	// tapVolumeSizeLimit is the size of the volume of the proxies the tap file sinks write captures to
+	tapVolumeSizeLimit = resource.MustParse("128Mi")
 )
 
 func NewGatewayParameters(cli apiclient.Client, inputs *deployer.Inputs) *GatewayParameters {
@@ -476,10 +481,7 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 			},
 		},
 	}
-	if i := gw.Spec.Infrastructure; i != nil {
-		gtw.GatewayAnnotations = translateInfraMeta(i.Annotations)
-		gtw.GatewayLabels = translateInfraMeta(i.Labels)
-	}
+	gtw.GatewayAnnotations, gtw.GatewayLabels = gatewayMeta(gw, k.inputs)
 	// construct the default values
 	vals := &deployer.HelmConfig{
 		Gateway: gtw,
@@ -521,6 +523,9 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 
 	gateway := vals.Gateway
 
+	// generated resource names
+	gateway.FullnameOverride = ptr.To(deployer.GetResourceName(gw.Name, kubeProxyConfig.GetResourceNaming()))
+
 	// deployment values
 	if deployConfig.GetReplicas() != nil {
 		gateway.ReplicaCount = ptr.To(uint32(*deployConfig.GetReplicas())) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
@@ -588,6 +593,13 @@ func (k *kgatewayParameters) getValues(gw *gwv1.Gateway, gwParam *kgateway.Gatew
 
 	gateway.Stats = deployer.GetStatsValues(statsConfig)
 
+	// the tap file sinks write to a memory-backed volume, whose size bounds the bytes captured by each replica
+	if k.inputs.CommonCollections.Settings.EnableTap {
+		gateway.Tap = &deployer.HelmTap{
+			SizeLimit: ptr.To(tapVolumeSizeLimit),
+		}
+	}
+
 	return vals, nil
 }
 
@@ -666,3 +678,35 @@ func translateInfraMeta[K ~string, V ~string](meta map[K]V) map[string]string {
 	}
 	return infra
 }
+
+// gatewayMeta returns the annotations and labels to set on the resources generated for the Gateway:
+// the ones from spec.infrastructure, plus the Gateway's own annotations and labels whose key matches
+// one of the allowlisted prefixes. Values from spec.infrastructure take precedence.
+func gatewayMeta(gw *gwv1.Gateway, inputs *deployer.Inputs) (annotations, labels map[string]string) {
+	if i := gw.Spec.Infrastructure; i != nil {
+		annotations = translateInfraMeta(i.Annotations)
+		labels = translateInfraMeta(i.Labels)
+	}
+	annotations = propagateGatewayMeta(annotations, gw.GetAnnotations(), inputs.PropagatedAnnotationPrefixes)
+	labels = propagateGatewayMeta(labels, gw.GetLabels(), inputs.PropagatedLabelPrefixes)
+	return annotations, labels
+}
+
+func propagateGatewayMeta(infra, meta map[string]string, prefixes []string) map[string]string {
+	for k, v := range meta {
+		if _, ok := infra[k]; ok {
+			continue
+		}
+		if !slices.ContainsFunc(prefixes, func(p string) bool { return p != "" && strings.HasPrefix(k, p) }) {
+			continue
+		}
+		if strings.HasPrefix(k, "gateway.networking.k8s.io/") {
+			continue // ignore this prefix to avoid conflicts
+		}
+		if infra == nil {
+			infra = map[string]string{}
+		}
+		infra[k] = v
+	}
+	return infra
+}
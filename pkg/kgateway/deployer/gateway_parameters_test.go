@@ -202,3 +202,46 @@ func newCommonCols(t test.Failer, initObjs ...client.Object) *collections.Common
 	gateways.Gateways.WaitUntilSynced(ctx.Done())
 	return commonCols
 }
+
+func TestGatewayMetaPropagation(t *testing.T) {
+	gw := &gwv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: defaultNamespace,
+			Labels: map[string]string{
+				"team":                           "payments",
+				"cost-center.example.com/id":     "1234",
+				"unrelated":                      "x",
+				"gateway.networking.k8s.io/name": "ignored",
+			},
+			Annotations: map[string]string{
+				"backup.velero.io/backup-volumes": "data",
+				"kubectl.kubernetes.io/last":      "ignored",
+			},
+		},
+		Spec: gwv1.GatewaySpec{
+			Infrastructure: &gwv1.GatewayInfrastructure{
+				Labels: map[gwv1.LabelKey]gwv1.LabelValue{
+					"team": "infra",
+				},
+			},
+		},
+	}
+	inputs := &deployer.Inputs{
+		PropagatedLabelPrefixes:      []string{"team", "cost-center.example.com/", "gateway.networking.k8s.io/"},
+		PropagatedAnnotationPrefixes: []string{"backup.velero.io/"},
+	}
+
+	annotations, labels := gatewayMeta(gw, inputs)
+	assert.Equal(t, map[string]string{
+		"backup.velero.io/backup-volumes": "data",
+	}, annotations)
+	assert.Equal(t, map[string]string{
+		"team":                       "infra",
+		"cost-center.example.com/id": "1234",
+	}, labels)
+
+	annotations, labels = gatewayMeta(gw, &deployer.Inputs{})
+	assert.Nil(t, annotations)
+	assert.Equal(t, map[string]string{"team": "infra"}, labels)
+}
@@ -22,6 +22,9 @@ import (
 type EndpointsInputs struct {
 	EndpointsForBackend ir.EndpointsForBackend
 	PriorityInfo        *PriorityInfo
+	// OverprovisioningFactor, in percents, controls when traffic fails over to the lower priorities.
+	// Envoy's default of 140 is used when unset.
+	OverprovisioningFactor *uint32
 }
 
 // PrioritizeEndpoints converts EndpointsInputs into a ClusterLoadAssignment.
@@ -41,7 +44,13 @@ func PrioritizeEndpoints(
 		lbInfo.PriorityInfo = inputs.PriorityInfo
 	}
 
-	return prioritizeWithLbInfo(logger, inputs.EndpointsForBackend, lbInfo)
+	cla := prioritizeWithLbInfo(logger, inputs.EndpointsForBackend, lbInfo)
+	if inputs.OverprovisioningFactor != nil {
+		cla.Policy = &envoyendpointv3.ClusterLoadAssignment_Policy{
+			OverprovisioningFactor: wrapperspb.UInt32(*inputs.OverprovisioningFactor),
+		}
+	}
+	return cla
 }
 
 type LoadBalancingInfo struct {
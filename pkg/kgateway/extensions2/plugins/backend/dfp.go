@@ -9,6 +9,8 @@ import (
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/ptr"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -29,24 +31,46 @@ type DfpIr struct {
 	clusterTypeConfig *anypb.Any
 	// +noKrtEquals
 	transportSocket *envoycorev3.TransportSocket
+	// dnsLookupFamily, dnsRefreshRate and respectDnsTtl are set on the cluster, from which the sub clusters
+	// resolving each hostname are created
+	dnsLookupFamily envoyclusterv3.Cluster_DnsLookupFamily
+	dnsRefreshRate  *durationpb.Duration
+	respectDnsTtl   bool
 }
 
 // Equals checks if two DfpIr objects are equal.
 func (u *DfpIr) Equals(other *DfpIr) bool {
 	return cmputils.CompareWithNils(u, other, func(a, b *DfpIr) bool {
 		return proto.Equal(a.clusterTypeConfig, b.clusterTypeConfig) &&
-			proto.Equal(a.transportSocket, b.transportSocket)
+			proto.Equal(a.transportSocket, b.transportSocket) &&
+			a.dnsLookupFamily == b.dnsLookupFamily &&
+			proto.Equal(a.dnsRefreshRate, b.dnsRefreshRate) &&
+			a.respectDnsTtl == b.respectDnsTtl
 	})
 }
 
 func buildDfpIr(in *kgateway.DynamicForwardProxyBackend) (*DfpIr, error) {
 	ir := &DfpIr{}
 
+	subClusters := &envoy_dfp_cluster.SubClustersConfig{
+		LbPolicy: envoyclusterv3.Cluster_LEAST_REQUEST,
+	}
+	if dnsCache := in.DnsCache; dnsCache != nil {
+		if dnsCache.MaxHosts != nil {
+			subClusters.MaxSubClusters = wrapperspb.UInt32(uint32(*dnsCache.MaxHosts)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		}
+		if dnsCache.HostTtl != nil {
+			subClusters.SubClusterTtl = durationpb.New(dnsCache.HostTtl.Duration)
+		}
+		if dnsCache.RefreshRate != nil {
+			ir.dnsRefreshRate = durationpb.New(dnsCache.RefreshRate.Duration)
+		}
+		ir.respectDnsTtl = ptr.Deref(dnsCache.RespectDnsTtl, false)
+		ir.dnsLookupFamily = toEnvoyDnsLookupFamily(ptr.Deref(dnsCache.LookupFamily, kgateway.DnsLookupFamilyAuto))
+	}
 	c := &envoy_dfp_cluster.ClusterConfig{
 		ClusterImplementationSpecifier: &envoy_dfp_cluster.ClusterConfig_SubClustersConfig{
-			SubClustersConfig: &envoy_dfp_cluster.SubClustersConfig{
-				LbPolicy: envoyclusterv3.Cluster_LEAST_REQUEST,
-			},
+			SubClustersConfig: subClusters,
 		},
 	}
 	anyCluster, err := utils.MessageToAny(c)
@@ -100,4 +124,23 @@ func processDynamicForwardProxy(ir *DfpIr, out *envoyclusterv3.Cluster) {
 	if ir.transportSocket != nil {
 		out.TransportSocket = ir.transportSocket
 	}
+
+	out.DnsLookupFamily = ir.dnsLookupFamily
+	out.DnsRefreshRate = ir.dnsRefreshRate
+	out.RespectDnsTtl = ir.respectDnsTtl
+}
+
+func toEnvoyDnsLookupFamily(family kgateway.DnsLookupFamily) envoyclusterv3.Cluster_DnsLookupFamily {
+	switch family {
+	case kgateway.DnsLookupFamilyV4Preferred:
+		return envoyclusterv3.Cluster_V4_PREFERRED
+	case kgateway.DnsLookupFamilyV4Only:
+		return envoyclusterv3.Cluster_V4_ONLY
+	case kgateway.DnsLookupFamilyV6Only:
+		return envoyclusterv3.Cluster_V6_ONLY
+	case kgateway.DnsLookupFamilyAll:
+		return envoyclusterv3.Cluster_ALL
+	default:
+		return envoyclusterv3.Cluster_AUTO
+	}
 }
@@ -0,0 +1,86 @@
+package backend
+
+import (
+	"testing"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_dfp_cluster "github.com/envoyproxy/go-control-plane/envoy/extensions/clusters/dynamic_forward_proxy/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+func TestProcessDynamicForwardProxy(t *testing.T) {
+	tests := []struct {
+		name           string
+		in             *kgateway.DynamicForwardProxyBackend
+		lookupFamily   envoyclusterv3.Cluster_DnsLookupFamily
+		refreshRate    time.Duration
+		respectDnsTtl  bool
+		maxSubClusters uint32
+		subClusterTtl  time.Duration
+	}{
+		{
+			name:         "defaults",
+			in:           &kgateway.DynamicForwardProxyBackend{},
+			lookupFamily: envoyclusterv3.Cluster_AUTO,
+		},
+		{
+			name: "dns cache",
+			in: &kgateway.DynamicForwardProxyBackend{
+				DnsCache: &kgateway.DynamicForwardProxyDnsCache{
+					LookupFamily:  ptr.To(kgateway.DnsLookupFamilyV4Only),
+					RefreshRate:   &metav1.Duration{Duration: 30 * time.Second},
+					RespectDnsTtl: ptr.To(true),
+					HostTtl:       &metav1.Duration{Duration: time.Hour},
+					MaxHosts:      ptr.To(int32(100)),
+				},
+			},
+			lookupFamily:   envoyclusterv3.Cluster_V4_ONLY,
+			refreshRate:    30 * time.Second,
+			respectDnsTtl:  true,
+			maxSubClusters: 100,
+			subClusterTtl:  time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ir, err := buildDfpIr(tt.in)
+			require.NoError(t, err)
+
+			out := &envoyclusterv3.Cluster{}
+			processDynamicForwardProxy(ir, out)
+
+			assert.Equal(t, envoyclusterv3.Cluster_CLUSTER_PROVIDED, out.GetLbPolicy())
+			assert.Equal(t, tt.lookupFamily, out.GetDnsLookupFamily())
+			assert.Equal(t, tt.refreshRate, out.GetDnsRefreshRate().AsDuration())
+			assert.Equal(t, tt.respectDnsTtl, out.GetRespectDnsTtl())
+
+			clusterConfig := &envoy_dfp_cluster.ClusterConfig{}
+			require.NoError(t, out.GetClusterType().GetTypedConfig().UnmarshalTo(clusterConfig))
+			subClusters := clusterConfig.GetSubClustersConfig()
+			require.NotNil(t, subClusters)
+			assert.Equal(t, envoyclusterv3.Cluster_LEAST_REQUEST, subClusters.GetLbPolicy())
+			assert.Equal(t, tt.maxSubClusters, subClusters.GetMaxSubClusters().GetValue())
+			assert.Equal(t, tt.subClusterTtl, subClusters.GetSubClusterTtl().AsDuration())
+		})
+	}
+}
+
+func TestDfpIrEquals(t *testing.T) {
+	withDnsCache := func(family kgateway.DnsLookupFamily) *DfpIr {
+		ir, err := buildDfpIr(&kgateway.DynamicForwardProxyBackend{
+			DnsCache: &kgateway.DynamicForwardProxyDnsCache{LookupFamily: ptr.To(family)},
+		})
+		require.NoError(t, err)
+		return ir
+	}
+
+	assert.True(t, withDnsCache(kgateway.DnsLookupFamilyV4Only).Equals(withDnsCache(kgateway.DnsLookupFamilyV4Only)))
+	assert.False(t, withDnsCache(kgateway.DnsLookupFamilyV4Only).Equals(withDnsCache(kgateway.DnsLookupFamilyV6Only)))
+}
@@ -2,10 +2,12 @@ package backendconfigpolicy
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	statefulsessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/proto"
@@ -38,13 +40,17 @@ type BackendConfigPolicyIR struct {
 	perConnectionBufferLimitBytes *uint32
 	tcpKeepalive                  *envoycorev3.TcpKeepalive
 	commonHttpProtocolOptions     *envoycorev3.HttpProtocolOptions
+	httpProtocol                  kgateway.BackendHTTPProtocol
 	http1ProtocolOptions          *envoycorev3.Http1ProtocolOptions
 	http2ProtocolOptions          *envoycorev3.Http2ProtocolOptions
 	tlsConfig                     *envoytlsv3.UpstreamTlsContext
+	proxyProtocol                 *envoycorev3.ProxyProtocolConfig
 	loadBalancerConfig            *LoadBalancerConfigIR
 	healthCheck                   *envoycorev3.HealthCheck
 	outlierDetection              *envoyclusterv3.OutlierDetection
 	circuitBreakers               *envoyclusterv3.CircuitBreakers
+	sessionAffinity               *statefulsessionv3.StatefulSessionPerRoute
+	trafficDistribution           *trafficDistributionIR
 }
 
 var logger = logging.New("plugin/backendconfigpolicy")
@@ -81,6 +87,10 @@ func (d *BackendConfigPolicyIR) Equals(other any) bool {
 		return false
 	}
 
+	if d.httpProtocol != d2.httpProtocol {
+		return false
+	}
+
 	if !proto.Equal(d.http1ProtocolOptions, d2.http1ProtocolOptions) {
 		return false
 	}
@@ -93,6 +103,10 @@ func (d *BackendConfigPolicyIR) Equals(other any) bool {
 		return false
 	}
 
+	if !proto.Equal(d.proxyProtocol, d2.proxyProtocol) {
+		return false
+	}
+
 	if !cmputils.CompareWithNils(d.loadBalancerConfig, d2.loadBalancerConfig, func(a, b *LoadBalancerConfigIR) bool {
 		return a.Equals(b)
 	}) {
@@ -111,6 +125,14 @@ func (d *BackendConfigPolicyIR) Equals(other any) bool {
 		return false
 	}
 
+	if !proto.Equal(d.sessionAffinity, d2.sessionAffinity) {
+		return false
+	}
+
+	if !d.trafficDistribution.Equals(d2.trafficDistribution) {
+		return false
+	}
+
 	return true
 }
 
@@ -154,6 +176,8 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, v
 		return statusMarker, pol
 	})
 
+	trafficDistributions := newTrafficDistributionIndex(backendConfigPolicyCol)
+
 	// processMarkers for policies that have existing status but no current report
 	processMarkers := func(kctx krt.HandlerContext, reportMap *reports.ReportMap) {
 		objStatus := krt.Fetch(kctx, policyStatusMarker)
@@ -180,6 +204,9 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, v
 				Policies:                        backendConfigPolicyCol,
 				ProcessPolicyStaleStatusMarkers: processMarkers,
 				ProcessBackend:                  processBackend,
+				FinalizeBackend:                 finalizeBackend,
+				PerClientProcessEndpoints:       trafficDistributions.processEndpoints,
+				NewGatewayTranslationPass:       newGatewayTranslationPass,
 				GetPolicyStatus:                 getPolicyStatusFn(cli),
 				PatchPolicyStatus:               patchPolicyStatusFn(cli),
 			},
@@ -204,8 +231,7 @@ func processBackend(_ context.Context, polir ir.PolicyIR, backend ir.BackendObje
 	}
 
 	applyCommonHttpProtocolOptions(pol.commonHttpProtocolOptions, backend, out)
-	applyHttp1ProtocolOptions(pol.http1ProtocolOptions, backend, out)
-	applyHttp2ProtocolOptions(pol.http2ProtocolOptions, backend, out)
+	applyHttpProtocol(pol, backend, out)
 
 	if pol.tlsConfig != nil {
 		typedConfig, err := utils.MessageToAny(pol.tlsConfig)
@@ -236,6 +262,15 @@ func processBackend(_ context.Context, polir ir.PolicyIR, backend ir.BackendObje
 	}
 }
 
+// finalizeBackend wraps the transport sockets of the cluster once every plugin configured them, e.g. with TLS.
+func finalizeBackend(_ context.Context, polir ir.PolicyIR, _ ir.BackendObjectIR, out *envoyclusterv3.Cluster) error {
+	pol := polir.(*BackendConfigPolicyIR)
+	if err := applyProxyProtocol(pol.proxyProtocol, out); err != nil {
+		return fmt.Errorf("failed to apply proxy protocol: %w", err)
+	}
+	return nil
+}
+
 func translate(
 	commoncol *collections.CommonCollections,
 	krtctx krt.HandlerContext,
@@ -261,6 +296,10 @@ func translate(
 		ir.commonHttpProtocolOptions = translateCommonHttpProtocolOptions(pol.Spec.CommonHttpProtocolOptions)
 	}
 
+	if pol.Spec.HTTPProtocol != nil {
+		ir.httpProtocol = *pol.Spec.HTTPProtocol
+	}
+
 	if pol.Spec.Http1ProtocolOptions != nil {
 		http1ProtocolOptions, err := translateHttp1ProtocolOptions(pol.Spec.Http1ProtocolOptions)
 		if err != nil {
@@ -281,6 +320,14 @@ func translate(
 		ir.tlsConfig = tlsConfig
 	}
 
+	if pol.Spec.ProxyProtocol != nil {
+		ir.proxyProtocol = translateProxyProtocol(pol.Spec.ProxyProtocol)
+		// the transport sockets are wrapped once the cluster is complete, report invalid config in the policy status
+		if _, err := wrapWithProxyProtocol(ir.proxyProtocol, nil); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if pol.Spec.LoadBalancer != nil {
 		loadBalancerConfig, err := translateLoadBalancerConfig(pol.Spec.LoadBalancer, pol.Name, pol.Namespace)
 		if err != nil {
@@ -304,6 +351,18 @@ func translate(
 		}
 	}
 
+	if pol.Spec.SessionAffinity != nil {
+		sessionAffinity, err := translateSessionAffinity(pol.Spec.SessionAffinity)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		ir.sessionAffinity = sessionAffinity
+	}
+
+	if pol.Spec.TrafficDistribution != nil {
+		ir.trafficDistribution = translateTrafficDistribution(pol.Spec.TrafficDistribution)
+	}
+
 	return &ir, errs
 }
 
@@ -235,6 +235,132 @@ func TestBackendConfigPolicyTranslation(t *testing.T) {
 			want:    &envoyclusterv3.Cluster{},
 			wantErr: false,
 		},
+		{
+			name: "http2 protocol selected for non-http2 backend",
+			policy: &kgateway.BackendConfigPolicy{
+				Spec: kgateway.BackendConfigPolicySpec{
+					HTTPProtocol: ptr.To(kgateway.BackendHTTPProtocolHTTP2),
+					Http2ProtocolOptions: &kgateway.Http2ProtocolOptions{
+						MaxConcurrentStreams: ptr.To(int32(100)),
+					},
+				},
+			},
+			backend: &ir.BackendObjectIR{},
+			cluster: &envoyclusterv3.Cluster{},
+			want: &envoyclusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+									Http2ProtocolOptions: &envoycorev3.Http2ProtocolOptions{
+										MaxConcurrentStreams: &wrapperspb.UInt32Value{Value: 100},
+									},
+								},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "http1 protocol selected for http2 backend",
+			policy: &kgateway.BackendConfigPolicy{
+				Spec: kgateway.BackendConfigPolicySpec{
+					HTTPProtocol: ptr.To(kgateway.BackendHTTPProtocolHTTP1),
+					Http1ProtocolOptions: &kgateway.Http1ProtocolOptions{
+						EnableTrailers: ptr.To(true),
+					},
+				},
+			},
+			backend: &ir.BackendObjectIR{
+				AppProtocol: ir.HTTP2AppProtocol,
+			},
+			cluster: &envoyclusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+									Http2ProtocolOptions: &envoycorev3.Http2ProtocolOptions{},
+								},
+							},
+						},
+					}),
+				},
+			},
+			want: &envoyclusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+							ExplicitHttpConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig{
+								ProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+									HttpProtocolOptions: &envoycorev3.Http1ProtocolOptions{
+										EnableTrailers: true,
+									},
+								},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "auto protocol selection",
+			policy: &kgateway.BackendConfigPolicy{
+				Spec: kgateway.BackendConfigPolicySpec{
+					HTTPProtocol: ptr.To(kgateway.BackendHTTPProtocolAuto),
+					Http2ProtocolOptions: &kgateway.Http2ProtocolOptions{
+						MaxConcurrentStreams: ptr.To(int32(100)),
+					},
+				},
+			},
+			backend: &ir.BackendObjectIR{},
+			cluster: &envoyclusterv3.Cluster{},
+			want: &envoyclusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_AutoConfig{
+							AutoConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_AutoHttpConfig{
+								Http2ProtocolOptions: &envoycorev3.Http2ProtocolOptions{
+									MaxConcurrentStreams: &wrapperspb.UInt32Value{Value: 100},
+								},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "downstream protocol selection",
+			policy: &kgateway.BackendConfigPolicy{
+				Spec: kgateway.BackendConfigPolicySpec{
+					HTTPProtocol: ptr.To(kgateway.BackendHTTPProtocolDownstream),
+					Http1ProtocolOptions: &kgateway.Http1ProtocolOptions{
+						EnableTrailers: ptr.To(true),
+					},
+				},
+			},
+			backend: &ir.BackendObjectIR{},
+			cluster: &envoyclusterv3.Cluster{},
+			want: &envoyclusterv3.Cluster{
+				TypedExtensionProtocolOptions: map[string]*anypb.Any{
+					"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": mustMessageToAny(t, &envoy_upstreams_http_v3.HttpProtocolOptions{
+						UpstreamProtocolOptions: &envoy_upstreams_http_v3.HttpProtocolOptions_UseDownstreamProtocolConfig{
+							UseDownstreamProtocolConfig: &envoy_upstreams_http_v3.HttpProtocolOptions_UseDownstreamHttpConfig{
+								HttpProtocolOptions: &envoycorev3.Http1ProtocolOptions{
+									EnableTrailers: true,
+								},
+							},
+						},
+					}),
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "circuit breakers minimal configuration",
 			policy: &kgateway.BackendConfigPolicy{
@@ -144,3 +144,56 @@ func applyHttp2ProtocolOptions(http2ProtocolOptions *envoycorev3.Http2ProtocolOp
 		logger.Error("failed to apply http2 protocol options", "backend", backend.GetName(), "error", err)
 	}
 }
+
+// applyHttpProtocol configures the HTTP protocol used to connect to the backend. If no protocol is selected by the policy,
+// the protocol inferred from the appProtocol of the backend is kept and only the protocol options matching it are applied.
+func applyHttpProtocol(pol *BackendConfigPolicyIR, backend ir.BackendObjectIR, out *envoyclusterv3.Cluster) {
+	selected := &envoy_upstreams_v3.HttpProtocolOptions{}
+	switch pol.httpProtocol {
+	case kgateway.BackendHTTPProtocolHTTP1:
+		selected.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_HttpProtocolOptions{
+					HttpProtocolOptions: pol.http1ProtocolOptions,
+				},
+			},
+		}
+	case kgateway.BackendHTTPProtocolHTTP2:
+		http2ProtocolOptions := pol.http2ProtocolOptions
+		if http2ProtocolOptions == nil {
+			http2ProtocolOptions = &envoycorev3.Http2ProtocolOptions{}
+		}
+		selected.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_{
+			ExplicitHttpConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig{
+				ProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{
+					Http2ProtocolOptions: http2ProtocolOptions,
+				},
+			},
+		}
+	case kgateway.BackendHTTPProtocolAuto:
+		// ALPN is negotiated using the TLS config of the policy, which is required by the API.
+		selected.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_AutoConfig{
+			AutoConfig: &envoy_upstreams_v3.HttpProtocolOptions_AutoHttpConfig{
+				HttpProtocolOptions:  pol.http1ProtocolOptions,
+				Http2ProtocolOptions: pol.http2ProtocolOptions,
+			},
+		}
+	case kgateway.BackendHTTPProtocolDownstream:
+		selected.UpstreamProtocolOptions = &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamProtocolConfig{
+			UseDownstreamProtocolConfig: &envoy_upstreams_v3.HttpProtocolOptions_UseDownstreamHttpConfig{
+				HttpProtocolOptions:  pol.http1ProtocolOptions,
+				Http2ProtocolOptions: pol.http2ProtocolOptions,
+			},
+		}
+	default:
+		applyHttp1ProtocolOptions(pol.http1ProtocolOptions, backend, out)
+		applyHttp2ProtocolOptions(pol.http2ProtocolOptions, backend, out)
+		return
+	}
+
+	if err := translatorutils.MutateHttpOptions(out, func(opts *envoy_upstreams_v3.HttpProtocolOptions) {
+		opts.UpstreamProtocolOptions = selected.GetUpstreamProtocolOptions()
+	}); err != nil {
+		logger.Error("failed to apply http protocol", "backend", backend.GetName(), "protocol", pol.httpProtocol, "error", err)
+	}
+}
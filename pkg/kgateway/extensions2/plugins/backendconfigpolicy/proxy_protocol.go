@@ -0,0 +1,73 @@
+package backendconfigpolicy
+
+import (
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	proxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	rawbufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/raw_buffer/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+const upstreamProxyProtocolTransportSocket = "envoy.transport_sockets.upstream_proxy_protocol"
+
+func translateProxyProtocol(config *kgateway.UpstreamProxyProtocol) *envoycorev3.ProxyProtocolConfig {
+	out := &envoycorev3.ProxyProtocolConfig{
+		Version: envoycorev3.ProxyProtocolConfig_V1,
+	}
+	if config.Version != nil && *config.Version == kgateway.ProxyProtocolVersionV2 {
+		out.Version = envoycorev3.ProxyProtocolConfig_V2
+	}
+	return out
+}
+
+// applyProxyProtocol wraps the transport sockets of the cluster so that the PROXY protocol header is sent
+// before anything else, including the TLS handshake. It runs when the backend is finalized, so that the transport
+// sockets set by other plugins, such as BackendTLSPolicy or Istio mTLS, are wrapped as well.
+func applyProxyProtocol(config *envoycorev3.ProxyProtocolConfig, out *envoyclusterv3.Cluster) error {
+	if config == nil {
+		return nil
+	}
+
+	transportSocket, err := wrapWithProxyProtocol(config, out.GetTransportSocket())
+	if err != nil {
+		return err
+	}
+	out.TransportSocket = transportSocket
+
+	for _, match := range out.GetTransportSocketMatches() {
+		transportSocket, err := wrapWithProxyProtocol(config, match.GetTransportSocket())
+		if err != nil {
+			return err
+		}
+		match.TransportSocket = transportSocket
+	}
+	return nil
+}
+
+func wrapWithProxyProtocol(config *envoycorev3.ProxyProtocolConfig, inner *envoycorev3.TransportSocket) (*envoycorev3.TransportSocket, error) {
+	if inner == nil {
+		rawBuffer, err := utils.MessageToAny(&rawbufferv3.RawBuffer{})
+		if err != nil {
+			return nil, err
+		}
+		inner = &envoycorev3.TransportSocket{
+			Name:       envoywellknown.TransportSocketRawBuffer,
+			ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: rawBuffer},
+		}
+	}
+
+	typedConfig, err := utils.MessageToAny(&proxyprotocolv3.ProxyProtocolUpstreamTransport{
+		Config:          config,
+		TransportSocket: inner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoycorev3.TransportSocket{
+		Name:       upstreamProxyProtocolTransportSocket,
+		ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: typedConfig},
+	}, nil
+}
@@ -0,0 +1,67 @@
+package backendconfigpolicy
+
+import (
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	proxyprotocolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/proxy_protocol/v3"
+	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoywellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+func TestTranslateProxyProtocol(t *testing.T) {
+	assert.Equal(t, envoycorev3.ProxyProtocolConfig_V1, translateProxyProtocol(&kgateway.UpstreamProxyProtocol{}).GetVersion())
+	assert.Equal(t, envoycorev3.ProxyProtocolConfig_V2, translateProxyProtocol(&kgateway.UpstreamProxyProtocol{
+		Version: ptr.To(kgateway.ProxyProtocolVersionV2),
+	}).GetVersion())
+}
+
+func TestApplyProxyProtocol(t *testing.T) {
+	config := &envoycorev3.ProxyProtocolConfig{Version: envoycorev3.ProxyProtocolConfig_V2}
+	unwrap := func(t *testing.T, ts *envoycorev3.TransportSocket) *proxyprotocolv3.ProxyProtocolUpstreamTransport {
+		t.Helper()
+		require.Equal(t, upstreamProxyProtocolTransportSocket, ts.GetName())
+		out := &proxyprotocolv3.ProxyProtocolUpstreamTransport{}
+		require.NoError(t, ts.GetTypedConfig().UnmarshalTo(out))
+		assert.Equal(t, envoycorev3.ProxyProtocolConfig_V2, out.GetConfig().GetVersion())
+		return out
+	}
+
+	t.Run("plaintext backend", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		require.NoError(t, applyProxyProtocol(config, cluster))
+		assert.Equal(t, envoywellknown.TransportSocketRawBuffer, unwrap(t, cluster.GetTransportSocket()).GetTransportSocket().GetName())
+	})
+
+	t.Run("TLS backend", func(t *testing.T) {
+		tlsConfig, err := utils.MessageToAny(&envoytlsv3.UpstreamTlsContext{Sni: "example.com"})
+		require.NoError(t, err)
+		tlsSocket := &envoycorev3.TransportSocket{
+			Name:       envoywellknown.TransportSocketTls,
+			ConfigType: &envoycorev3.TransportSocket_TypedConfig{TypedConfig: tlsConfig},
+		}
+		cluster := &envoyclusterv3.Cluster{
+			TransportSocketMatches: []*envoyclusterv3.Cluster_TransportSocketMatch{{
+				Name:            "tls",
+				TransportSocket: tlsSocket,
+			}},
+		}
+		require.NoError(t, applyProxyProtocol(config, cluster))
+		assert.Equal(t, envoywellknown.TransportSocketRawBuffer, unwrap(t, cluster.GetTransportSocket()).GetTransportSocket().GetName())
+		assert.True(t, proto.Equal(tlsSocket, unwrap(t, cluster.GetTransportSocketMatches()[0].GetTransportSocket()).GetTransportSocket()))
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		cluster := &envoyclusterv3.Cluster{}
+		require.NoError(t, applyProxyProtocol(nil, cluster))
+		assert.Nil(t, cluster.GetTransportSocket())
+	})
+}
@@ -0,0 +1,126 @@
+package backendconfigpolicy
+
+import (
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	statefulsessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
+	cookiesessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/cookie/v3"
+	headersessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/header/v3"
+	envoyhttpv3 "github.com/envoyproxy/go-control-plane/envoy/type/http/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+)
+
+const (
+	statefulSessionFilterName = "envoy.filters.http.stateful_session"
+	cookieSessionStateName    = "envoy.http.stateful_session.cookie"
+	headerSessionStateName    = "envoy.http.stateful_session.header"
+)
+
+// translateSessionAffinity translates the session affinity to the per-route config of the stateful session filter.
+func translateSessionAffinity(config *kgateway.SessionAffinity) (*statefulsessionv3.StatefulSessionPerRoute, error) {
+	var (
+		name         string
+		sessionState proto.Message
+	)
+	switch {
+	case config.Cookie != nil:
+		cookie := &envoyhttpv3.Cookie{
+			Name:       config.Cookie.Name,
+			Attributes: sessionCookieAttributes(config.Cookie),
+		}
+		if config.Cookie.TTL != nil {
+			cookie.Ttl = durationpb.New(config.Cookie.TTL.Duration)
+		}
+		if config.Cookie.Path != nil {
+			cookie.Path = *config.Cookie.Path
+		}
+		name = cookieSessionStateName
+		sessionState = &cookiesessionv3.CookieBasedSessionState{Cookie: cookie}
+	case config.Header != nil:
+		name = headerSessionStateName
+		sessionState = &headersessionv3.HeaderBasedSessionState{Name: config.Header.Name}
+	default:
+		return nil, fmt.Errorf("session affinity requires either a cookie or a header")
+	}
+
+	typedConfig, err := utils.MessageToAny(sessionState)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert session state config: %w", err)
+	}
+	out := &statefulsessionv3.StatefulSessionPerRoute{
+		Override: &statefulsessionv3.StatefulSessionPerRoute_StatefulSession{
+			StatefulSession: &statefulsessionv3.StatefulSession{
+				SessionState: &envoycorev3.TypedExtensionConfig{
+					Name:        name,
+					TypedConfig: typedConfig,
+				},
+				Strict: config.Strict != nil && *config.Strict,
+			},
+		},
+	}
+	return out, out.Validate()
+}
+
+func sessionCookieAttributes(cookie *kgateway.Cookie) []*envoyhttpv3.CookieAttribute {
+	var attributes []*envoyhttpv3.CookieAttribute
+	if cookie.Secure != nil && *cookie.Secure {
+		attributes = append(attributes, &envoyhttpv3.CookieAttribute{Name: cookieAttributeSecure, Value: cookieValueTrue})
+	}
+	if cookie.HttpOnly != nil && *cookie.HttpOnly {
+		attributes = append(attributes, &envoyhttpv3.CookieAttribute{Name: cookieAttributeHttpOnly, Value: cookieValueTrue})
+	}
+	if cookie.SameSite != nil {
+		attributes = append(attributes, &envoyhttpv3.CookieAttribute{Name: cookieAttributeSameSite, Value: *cookie.SameSite})
+	}
+	return attributes
+}
+
+// backendConfigPolicyGwPass applies the parts of the BackendConfigPolicies that are not cluster settings, but
+// configure the routes to the backends they are attached to.
+type backendConfigPolicyGwPass struct {
+	ir.UnimplementedProxyTranslationPass
+
+	// statefulSessionInChain is the set of filter chains with routes to backends with session affinity
+	statefulSessionInChain map[string]bool
+}
+
+var _ ir.ProxyTranslationPass = &backendConfigPolicyGwPass{}
+
+func newGatewayTranslationPass(_ ir.GwTranslationCtx, _ reporter.Reporter) ir.ProxyTranslationPass {
+	return &backendConfigPolicyGwPass{}
+}
+
+// ApplyForRouteBackend enables the stateful session filter on the routes to backends with session affinity.
+func (p *backendConfigPolicyGwPass) ApplyForRouteBackend(policy ir.PolicyIR, pCtx *ir.RouteBackendContext) error {
+	pol, ok := policy.(*BackendConfigPolicyIR)
+	if !ok || pol.sessionAffinity == nil {
+		return nil
+	}
+
+	pCtx.TypedFilterConfig.AddTypedConfig(statefulSessionFilterName, pol.sessionAffinity)
+	if p.statefulSessionInChain == nil {
+		p.statefulSessionInChain = make(map[string]bool)
+	}
+	p.statefulSessionInChain[pCtx.FilterChainName] = true
+	return nil
+}
+
+func (p *backendConfigPolicyGwPass) HttpFilters(_ ir.HttpFiltersContext, fc ir.FilterChainCommon) ([]filters.StagedHttpFilter, error) {
+	if !p.statefulSessionInChain[fc.FilterChainName] {
+		return nil, nil
+	}
+
+	// The filter is disabled by default, and enabled by the per-route config of the routes to backends with
+	// session affinity.
+	filter := filters.MustNewStagedFilter(statefulSessionFilterName, &statefulsessionv3.StatefulSession{}, filters.DuringStage(filters.RouteStage))
+	filter.Filter.Disabled = true
+	return []filters.StagedHttpFilter{filter}, nil
+}
@@ -0,0 +1,113 @@
+package backendconfigpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	statefulsessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/stateful_session/v3"
+	cookiesessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/cookie/v3"
+	headersessionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/stateful_session/header/v3"
+	envoyhttpv3 "github.com/envoyproxy/go-control-plane/envoy/type/http/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestTranslateSessionAffinity(t *testing.T) {
+	sessionPerRoute := func(name string, state proto.Message, strict bool) *statefulsessionv3.StatefulSessionPerRoute {
+		typedConfig, err := utils.MessageToAny(state)
+		require.NoError(t, err)
+		return &statefulsessionv3.StatefulSessionPerRoute{
+			Override: &statefulsessionv3.StatefulSessionPerRoute_StatefulSession{
+				StatefulSession: &statefulsessionv3.StatefulSession{
+					SessionState: &envoycorev3.TypedExtensionConfig{
+						Name:        name,
+						TypedConfig: typedConfig,
+					},
+					Strict: strict,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		config   *kgateway.SessionAffinity
+		expected *statefulsessionv3.StatefulSessionPerRoute
+	}{
+		{
+			name: "cookie",
+			config: &kgateway.SessionAffinity{
+				Cookie: &kgateway.Cookie{
+					Name:     "session",
+					Path:     ptr.To("/app"),
+					TTL:      &metav1.Duration{Duration: time.Hour},
+					HttpOnly: ptr.To(true),
+					SameSite: ptr.To("Lax"),
+				},
+			},
+			expected: sessionPerRoute(cookieSessionStateName, &cookiesessionv3.CookieBasedSessionState{
+				Cookie: &envoyhttpv3.Cookie{
+					Name: "session",
+					Path: "/app",
+					Ttl:  durationpb.New(time.Hour),
+					Attributes: []*envoyhttpv3.CookieAttribute{
+						{Name: cookieAttributeHttpOnly, Value: cookieValueTrue},
+						{Name: cookieAttributeSameSite, Value: "Lax"},
+					},
+				},
+			}, false),
+		},
+		{
+			name: "strict header",
+			config: &kgateway.SessionAffinity{
+				Header: &kgateway.SessionAffinityHeader{Name: "x-session"},
+				Strict: ptr.To(true),
+			},
+			expected: sessionPerRoute(headerSessionStateName, &headersessionv3.HeaderBasedSessionState{
+				Name: "x-session",
+			}, true),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := translateSessionAffinity(tt.config)
+			require.NoError(t, err)
+			assert.True(t, proto.Equal(tt.expected, actual), "expected %v, got %v", tt.expected, actual)
+		})
+	}
+}
+
+func TestSessionAffinityFilter(t *testing.T) {
+	sessionAffinity, err := translateSessionAffinity(&kgateway.SessionAffinity{
+		Header: &kgateway.SessionAffinityHeader{Name: "x-session"},
+	})
+	require.NoError(t, err)
+
+	pass := newGatewayTranslationPass(ir.GwTranslationCtx{}, nil)
+
+	pCtx := &ir.RouteBackendContext{FilterChainName: "fc", TypedFilterConfig: ir.TypedFilterConfigMap{}}
+	require.NoError(t, pass.ApplyForRouteBackend(&BackendConfigPolicyIR{}, pCtx))
+	assert.Nil(t, pCtx.TypedFilterConfig.GetTypedConfig(statefulSessionFilterName))
+
+	require.NoError(t, pass.ApplyForRouteBackend(&BackendConfigPolicyIR{sessionAffinity: sessionAffinity}, pCtx))
+	assert.Equal(t, sessionAffinity, pCtx.TypedFilterConfig.GetTypedConfig(statefulSessionFilterName))
+
+	stagedFilters, err := pass.HttpFilters(ir.HttpFiltersContext{}, ir.FilterChainCommon{FilterChainName: "fc"})
+	require.NoError(t, err)
+	require.Len(t, stagedFilters, 1)
+	assert.True(t, stagedFilters[0].Filter.GetDisabled())
+
+	stagedFilters, err = pass.HttpFilters(ir.HttpFiltersContext{}, ir.FilterChainCommon{FilterChainName: "other"})
+	require.NoError(t, err)
+	assert.Empty(t, stagedFilters)
+}
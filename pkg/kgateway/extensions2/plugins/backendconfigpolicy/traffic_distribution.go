@@ -0,0 +1,144 @@
+package backendconfigpolicy
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/endpoints"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/krtutil"
+)
+
+type trafficDistributionIR struct {
+	mode wellknown.TrafficDistribution
+	// overprovisioningFactor is the Envoy overprovisioning factor, in percents, derived from the failover threshold
+	overprovisioningFactor *uint32
+}
+
+func (t *trafficDistributionIR) Equals(other *trafficDistributionIR) bool {
+	if t == nil || other == nil {
+		return t == nil && other == nil
+	}
+	return t.mode == other.mode &&
+		cmputils.PointerValsEqual(t.overprovisioningFactor, other.overprovisioningFactor)
+}
+
+func translateTrafficDistribution(td *kgateway.TrafficDistribution) *trafficDistributionIR {
+	out := &trafficDistributionIR{}
+	switch td.Mode {
+	case kgateway.TrafficDistributionModePreferSameZone:
+		out.mode = wellknown.TrafficDistributionPreferSameZone
+	case kgateway.TrafficDistributionModePreferSameNode:
+		out.mode = wellknown.TrafficDistributionPreferSameNode
+	case kgateway.TrafficDistributionModePreferNetwork:
+		out.mode = wellknown.TrafficDistributionPreferNetwork
+	default:
+		out.mode = wellknown.TrafficDistributionAny
+	}
+
+	if td.FailoverThreshold != nil && *td.FailoverThreshold > 0 {
+		// Envoy keeps all the traffic on a priority as long as its ratio of healthy endpoints multiplied
+		// by the overprovisioning factor is at least 100%.
+		threshold := uint32(*td.FailoverThreshold) //nolint:gosec // G115: kubebuilder validation ensures 1 <= value <= 100
+		factor := (100*100 + threshold - 1) / threshold
+		out.overprovisioningFactor = &factor
+	}
+	return out
+}
+
+// trafficDistributionTarget is the key of the policies indexed by target. The name is empty for target selectors.
+type trafficDistributionTarget struct {
+	group, kind, namespace, name string
+}
+
+// trafficDistributionIndex finds the policies configuring the traffic distribution of the backends of endpoints.
+// Endpoints are computed from the backends before policies are attached, so the policies are looked up here.
+type trafficDistributionIndex struct {
+	policies krt.Collection[ir.PolicyWrapper]
+	byTarget krt.Index[trafficDistributionTarget, ir.PolicyWrapper]
+}
+
+func newTrafficDistributionIndex(policies krt.Collection[ir.PolicyWrapper]) *trafficDistributionIndex {
+	byTarget := krtutil.UnnamedIndex(policies, func(p ir.PolicyWrapper) []trafficDistributionTarget {
+		pol, ok := p.PolicyIR.(*BackendConfigPolicyIR)
+		if !ok || pol.trafficDistribution == nil || len(p.Errors) > 0 {
+			return nil
+		}
+		keys := make([]trafficDistributionTarget, 0, len(p.TargetRefs))
+		for _, ref := range p.TargetRefs {
+			key := trafficDistributionTarget{group: ref.Group, kind: ref.Kind, namespace: p.Namespace}
+			if len(ref.MatchLabels) == 0 {
+				key.name = ref.Name
+			}
+			keys = append(keys, key)
+		}
+		return keys
+	})
+	return &trafficDistributionIndex{
+		policies: policies,
+		byTarget: byTarget,
+	}
+}
+
+// processEndpoints applies the traffic distribution of the oldest policy targeting the backend of the endpoints.
+func (t *trafficDistributionIndex) processEndpoints(
+	kctx krt.HandlerContext,
+	_ context.Context,
+	_ ir.UniqlyConnectedClient,
+	out *endpoints.EndpointsInputs,
+) uint64 {
+	pol := t.policyFor(kctx, out.EndpointsForBackend)
+	if pol == nil {
+		return 0
+	}
+
+	td := pol.PolicyIR.(*BackendConfigPolicyIR).trafficDistribution
+	out.EndpointsForBackend.TrafficDistribution = td.mode
+	out.OverprovisioningFactor = td.overprovisioningFactor
+
+	hasher := fnv.New64()
+	hasher.Write([]byte(pol.ResourceName()))
+	hasher.Write(fmt.Appendf(nil, "%v", pol.Policy.GetGeneration()))
+	return hasher.Sum64()
+}
+
+func (t *trafficDistributionIndex) policyFor(kctx krt.HandlerContext, eps ir.EndpointsForBackend) *ir.PolicyWrapper {
+	src := eps.BackendSource
+	key := trafficDistributionTarget{group: src.Group, kind: src.Kind, namespace: src.Namespace, name: src.Name}
+	candidates := krt.Fetch(kctx, t.policies, krt.FilterIndex(t.byTarget, key))
+
+	key.name = ""
+	for _, p := range krt.Fetch(kctx, t.policies, krt.FilterIndex(t.byTarget, key)) {
+		for _, ref := range p.TargetRefs {
+			if ref.Group == src.Group && ref.Kind == src.Kind && len(ref.MatchLabels) > 0 &&
+				labels.SelectorFromSet(ref.MatchLabels).Matches(labels.Set(eps.BackendLabels)) {
+				candidates = append(candidates, p)
+				break
+			}
+		}
+	}
+
+	var oldest *ir.PolicyWrapper
+	for i := range candidates {
+		p := &candidates[i]
+		if oldest == nil || isOlder(p, oldest) {
+			oldest = p
+		}
+	}
+	return oldest
+}
+
+func isOlder(a, b *ir.PolicyWrapper) bool {
+	at, bt := a.PolicyIR.CreationTime(), b.PolicyIR.CreationTime()
+	if !at.Equal(bt) {
+		return at.Before(bt)
+	}
+	return a.ResourceName() < b.ResourceName()
+}
@@ -0,0 +1,156 @@
+package backendconfigpolicy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/endpoints"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestTranslateTrafficDistribution(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *kgateway.TrafficDistribution
+		expected *trafficDistributionIR
+	}{
+		{
+			name:     "any",
+			config:   &kgateway.TrafficDistribution{Mode: kgateway.TrafficDistributionModeAny},
+			expected: &trafficDistributionIR{mode: wellknown.TrafficDistributionAny},
+		},
+		{
+			name:     "prefer same zone",
+			config:   &kgateway.TrafficDistribution{Mode: kgateway.TrafficDistributionModePreferSameZone},
+			expected: &trafficDistributionIR{mode: wellknown.TrafficDistributionPreferSameZone},
+		},
+		{
+			name: "prefer same node with failover threshold",
+			config: &kgateway.TrafficDistribution{
+				Mode:              kgateway.TrafficDistributionModePreferSameNode,
+				FailoverThreshold: ptr.To(int32(50)),
+			},
+			expected: &trafficDistributionIR{
+				mode:                   wellknown.TrafficDistributionPreferSameNode,
+				overprovisioningFactor: ptr.To(uint32(200)),
+			},
+		},
+		{
+			name: "failover threshold rounds the factor up",
+			config: &kgateway.TrafficDistribution{
+				Mode:              kgateway.TrafficDistributionModePreferNetwork,
+				FailoverThreshold: ptr.To(int32(72)),
+			},
+			expected: &trafficDistributionIR{
+				mode:                   wellknown.TrafficDistributionPreferNetwork,
+				overprovisioningFactor: ptr.To(uint32(139)),
+			},
+		},
+		{
+			name: "no failover before all endpoints are unhealthy",
+			config: &kgateway.TrafficDistribution{
+				Mode:              kgateway.TrafficDistributionModePreferSameZone,
+				FailoverThreshold: ptr.To(int32(100)),
+			},
+			expected: &trafficDistributionIR{
+				mode:                   wellknown.TrafficDistributionPreferSameZone,
+				overprovisioningFactor: ptr.To(uint32(100)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.expected.Equals(translateTrafficDistribution(tt.config)))
+		})
+	}
+}
+
+func TestTrafficDistributionProcessEndpoints(t *testing.T) {
+	now := time.Now()
+	policy := func(name string, ct time.Time, td *trafficDistributionIR, refs ...ir.PolicyRef) ir.PolicyWrapper {
+		return ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{
+				Group:     wellknown.BackendConfigPolicyGVK.Group,
+				Kind:      wellknown.BackendConfigPolicyGVK.Kind,
+				Namespace: "default",
+				Name:      name,
+			},
+			Policy:     &kgateway.BackendConfigPolicy{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"}},
+			PolicyIR:   &BackendConfigPolicyIR{ct: ct, trafficDistribution: td},
+			TargetRefs: refs,
+		}
+	}
+	svcRef := func(name string) ir.PolicyRef {
+		return ir.PolicyRef{Kind: "Service", Name: name}
+	}
+	sameZone := &trafficDistributionIR{mode: wellknown.TrafficDistributionPreferSameZone, overprovisioningFactor: ptr.To(uint32(200))}
+	anyDistribution := &trafficDistributionIR{mode: wellknown.TrafficDistributionAny}
+
+	mock := krttest.NewMock(t, []any{
+		policy("zone", now, sameZone, svcRef("svc")),
+		policy("newer", now.Add(time.Minute), anyDistribution, svcRef("svc")),
+		policy("no-distribution", now.Add(-time.Minute), nil, svcRef("svc")),
+		policy("selector", now, anyDistribution, ir.PolicyRef{Kind: "Service", MatchLabels: map[string]string{"app": "selected"}}),
+	})
+	idx := newTrafficDistributionIndex(krttest.GetMockCollection[ir.PolicyWrapper](mock))
+
+	tests := []struct {
+		name                  string
+		backend               ir.ObjectSource
+		labels                map[string]string
+		serviceDistribution   wellknown.TrafficDistribution
+		expectedDistribution  wellknown.TrafficDistribution
+		expectedOverprovision *uint32
+		expectedAppliedPolicy bool
+	}{
+		{
+			name:                  "oldest policy with a traffic distribution applies",
+			backend:               ir.ObjectSource{Kind: "Service", Namespace: "default", Name: "svc"},
+			expectedDistribution:  wellknown.TrafficDistributionPreferSameZone,
+			expectedOverprovision: ptr.To(uint32(200)),
+			expectedAppliedPolicy: true,
+		},
+		{
+			name:                  "selector overrides the service traffic distribution",
+			backend:               ir.ObjectSource{Kind: "Service", Namespace: "default", Name: "other"},
+			labels:                map[string]string{"app": "selected"},
+			serviceDistribution:   wellknown.TrafficDistributionPreferSameNode,
+			expectedDistribution:  wellknown.TrafficDistributionAny,
+			expectedAppliedPolicy: true,
+		},
+		{
+			name:                 "untargeted backend keeps the service traffic distribution",
+			backend:              ir.ObjectSource{Kind: "Service", Namespace: "other", Name: "svc"},
+			labels:               map[string]string{"app": "selected"},
+			serviceDistribution:  wellknown.TrafficDistributionPreferSameNode,
+			expectedDistribution: wellknown.TrafficDistributionPreferSameNode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			in := &endpoints.EndpointsInputs{
+				EndpointsForBackend: ir.EndpointsForBackend{
+					BackendSource:       tt.backend,
+					BackendLabels:       tt.labels,
+					TrafficDistribution: tt.serviceDistribution,
+				},
+			}
+			hash := idx.processEndpoints(krt.TestingDummyContext{}, context.Background(), ir.UniqlyConnectedClient{}, in)
+
+			assert.Equal(t, tt.expectedAppliedPolicy, hash != 0)
+			assert.Equal(t, tt.expectedDistribution, in.EndpointsForBackend.TrafficDistribution)
+			assert.Equal(t, tt.expectedOverprovision, in.OverprovisioningFactor)
+		})
+	}
+}
@@ -82,7 +82,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 	)
 	col := krt.WrapClient(cli, commoncol.KrtOpts.ToOptions("BackendTLSPolicy")...)
 
-	translate := buildTranslateFunc(commoncol.ConfigMaps.Collection(), commoncol.Secrets)
+	translate := buildTranslateFunc(commoncol.ConfigMaps.Collection(), commoncol.Secrets, commoncol.TrustBundles)
 
 	policyStatusMarker, tlsPolicyCol := krt.NewStatusCollection(col, func(krtctx krt.HandlerContext, i *gwv1.BackendTLSPolicy) (*krtcollections.StatusMarker, *ir.PolicyWrapper) {
 		tlsPolicyIR, err := translate(krtctx, i)
@@ -161,6 +161,7 @@ func processBackend(ctx context.Context, polir ir.PolicyIR, in ir.BackendObjectI
 func buildTranslateFunc(
 	cfgmaps krt.Collection[*corev1.ConfigMap],
 	secrets *krtcollections.SecretIndex,
+	trustBundles *krtcollections.TrustBundleIndex,
 ) func(krtctx krt.HandlerContext, i *gwv1.BackendTLSPolicy) (*backendTlsPolicy, error) {
 	return func(krtctx krt.HandlerContext, policyCR *gwv1.BackendTLSPolicy) (*backendTlsPolicy, error) {
 		spec := policyCR.Spec
@@ -199,8 +200,19 @@ func buildTranslateFunc(
 			var caCert string
 			var err error
 
-			switch refKind {
-			case kgwellknown.ConfigMapKind:
+			switch {
+			case krtcollections.IsTrustBundleRef(string(certRef.Group), refKind):
+				caCert, err = trustBundles.GetCACert(krtctx, policyCR.Namespace, gwv1.ObjectReference{
+					Group: certRef.Group,
+					Kind:  certRef.Kind,
+					Name:  certRef.Name,
+				})
+				if err != nil {
+					perr := fmt.Errorf("%w: %v", ErrCreatingTLSConfig, err)
+					logger.Error("error extracting CA cert from trust bundle", "error", perr, "policy_name", policyCR.Name)
+					return &policyIr, perr
+				}
+			case refKind == kgwellknown.ConfigMapKind:
 				nn := types.NamespacedName{
 					Name:      string(certRef.Name),
 					Namespace: policyCR.Namespace,
@@ -217,7 +229,7 @@ func buildTranslateFunc(
 					logger.Error("error extracting CA cert from ConfigMap", "error", perr, "policy_name", policyCR.Name)
 					return &policyIr, perr
 				}
-			case kgwellknown.SecretKind:
+			case refKind == kgwellknown.SecretKind:
 				// secret is always in the same namespace as the policy (LocalObjectReference), no need to check reference grant
 				secret, err := secrets.GetSecretWithoutRefGrant(krtctx, string(certRef.Name), policyCR.Namespace)
 				if err != nil {
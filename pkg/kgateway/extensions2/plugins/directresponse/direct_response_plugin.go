@@ -11,9 +11,12 @@ import (
 	"istio.io/istio/pkg/kube/kclient"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
 	sdk "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
@@ -22,8 +25,11 @@ import (
 
 type directResponse struct {
 	// +noKrtEquals
-	ct   time.Time
-	spec kgateway.DirectResponseSpec
+	ct         time.Time
+	statusCode int32
+	// body is the inline body or the body resolved from the ConfigMap referenced by the DirectResponse.
+	body        *string
+	contentType *string
 }
 
 // in case multiple policies attached to the same resource, we sort by policy creation time.
@@ -36,7 +42,9 @@ func (d *directResponse) Equals(in any) bool {
 	if !ok {
 		return false
 	}
-	return d.spec == d2.spec
+	return d.statusCode == d2.statusCode &&
+		ptr.Equal(d.body, d2.body) &&
+		ptr.Equal(d.contentType, d2.contentType)
 }
 
 type directResponsePluginGwPass struct {
@@ -55,6 +63,7 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 
 	gk := wellknown.DirectResponseGVK.GroupKind()
 	policyCol := krt.NewCollection(col, func(krtctx krt.HandlerContext, i *kgateway.DirectResponse) *ir.PolicyWrapper {
+		dr, err := buildDirectResponse(krtctx, commoncol.ConfigMaps, i)
 		pol := &ir.PolicyWrapper{
 			ObjectSource: ir.ObjectSource{
 				Group:     gk.Group,
@@ -63,9 +72,12 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 				Name:      i.Name,
 			},
 			Policy:   i,
-			PolicyIR: &directResponse{ct: i.CreationTimestamp.Time, spec: i.Spec},
+			PolicyIR: dr,
 			// no target refs for direct response
 		}
+		if err != nil {
+			pol.Errors = []error{err}
+		}
 		return pol
 	})
 
@@ -80,6 +92,43 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 	}
 }
 
+// buildDirectResponse builds the IR of the DirectResponse. The body referenced by ConfigMap is inlined,
+// so the DirectResponse is translated again when the ConfigMap changes.
+func buildDirectResponse(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	dr *kgateway.DirectResponse,
+) (*directResponse, error) {
+	out := &directResponse{
+		ct:          dr.CreationTimestamp.Time,
+		statusCode:  dr.Spec.StatusCode,
+		body:        dr.Spec.Body,
+		contentType: dr.Spec.ContentType,
+	}
+	ref := dr.Spec.BodyRef
+	if ref == nil {
+		return out, nil
+	}
+
+	from := krtcollections.From{
+		GroupKind: wellknown.DirectResponseGVK.GroupKind(),
+		Namespace: dr.Namespace,
+	}
+	cm, err := configMaps.GetConfigMap(krtctx, from, gwv1.ObjectReference{
+		Kind: "ConfigMap",
+		Name: gwv1.ObjectName(ref.Name),
+	})
+	if err != nil {
+		return out, fmt.Errorf("direct response: failed to find configmap %s: %w", ref.Name, err)
+	}
+	body, ok := cm.Data[ref.Key]
+	if !ok || body == "" {
+		return out, fmt.Errorf("direct response: configmap %s key '%s' not found", ref.Name, ref.Key)
+	}
+	out.body = &body
+	return out, nil
+}
+
 func NewGatewayTranslationPass(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass {
 	return &directResponsePluginGwPass{
 		reporter: reporter,
@@ -106,18 +155,27 @@ func (p *directResponsePluginGwPass) ApplyForRoute(pCtx *ir.RouteContext, output
 	}
 
 	drAction := &envoyroutev3.DirectResponseAction{
-		Status: uint32(dr.spec.StatusCode), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		Status: uint32(dr.statusCode), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
 	}
-	if dr.spec.Body != nil {
+	if dr.body != nil {
 		drAction.Body = &envoycorev3.DataSource{
 			Specifier: &envoycorev3.DataSource_InlineString{
-				InlineString: *dr.spec.Body,
+				InlineString: *dr.body,
 			},
 		}
 	}
 	outputRoute.Action = &envoyroutev3.Route_DirectResponse{
 		DirectResponse: drAction,
 	}
+	if dr.contentType != nil {
+		outputRoute.ResponseHeadersToAdd = append(outputRoute.GetResponseHeadersToAdd(), &envoycorev3.HeaderValueOption{
+			Header: &envoycorev3.HeaderValue{
+				Key:   "content-type",
+				Value: *dr.contentType,
+			},
+			AppendAction: envoycorev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
 
 	return nil
 }
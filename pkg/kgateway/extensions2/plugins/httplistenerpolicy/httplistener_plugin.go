@@ -68,6 +68,9 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 
 	// processMarkers for policies that have existing status but no current report
 	processMarkers := func(kctx krt.HandlerContext, reportMap *reports.ReportMap) {
+		// flag targetRefs that resolve to nothing while a targetSelector matches another object, as the target was possibly renamed
+		krtcollections.ReportPossibleTargetRenames(kctx, gk, policyCol, commoncol.GatewayIndex, commoncol.Routes, reportMap)
+
 		objStatus := krt.Fetch(kctx, policyStatusMarker)
 		for _, status := range objStatus {
 			policyKey := reporter.PolicyKey{
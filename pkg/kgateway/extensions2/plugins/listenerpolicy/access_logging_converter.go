@@ -33,6 +33,10 @@ var ErrUnresolvedBackendRef = errors.New("unresolved backend reference")
 
 const serviceNameKey = "service.name"
 
+// DefaultTcpAccessLogFormat is the format of the TCP access logs of file sinks that do not set a format.
+const DefaultTcpAccessLogFormat = "[%START_TIME%] %ACCESS_LOG_TYPE% %DOWNSTREAM_REMOTE_ADDRESS% %REQUESTED_SERVER_NAME% " +
+	"%UPSTREAM_HOST% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESPONSE_FLAGS% %UPSTREAM_TRANSPORT_FAILURE_REASON%\n"
+
 // convertAccessLogConfig transforms a list of AccessLog configurations into Envoy AccessLog configurations
 // These access log configs can be either FileAccessLog, HttpGrpcAccessLogConfig or OpenTelemetryAccessLogConfig.
 // The default service name needs to be set to the cluster name in the OpenTelemetryAccessLogConfig.
@@ -40,18 +44,16 @@ const serviceNameKey = "service.name"
 // we return partially translated configs. As these configs are of different types, we return an list of interfaces
 // that is stored in the IR to be fully translated during translation.
 func convertAccessLogConfig(
-	policy *kgateway.HTTPSettings,
+	configs []kgateway.AccessLog,
 	commoncol *collections.CommonCollections,
 	krtctx krt.HandlerContext,
 	parentSrc ir.ObjectSource,
 ) ([]proto.Message, error) {
-	configs := policy.AccessLog
-
 	if configs != nil && len(configs) == 0 {
 		return nil, nil
 	}
 
-	grpcBackends := make(map[string]*ir.BackendObjectIR, len(policy.AccessLog))
+	grpcBackends := make(map[string]*ir.BackendObjectIR, len(configs))
 	for idx, log := range configs {
 		if log.GrpcService != nil {
 			backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, log.GrpcService.BackendRef.BackendObjectReference)
@@ -594,7 +596,54 @@ func generateAccessLogConfig(pCtx *ir.HcmContext, policies []kgateway.AccessLog,
 		case *envoygrpc.HttpGrpcAccessLogConfig:
 			cfg = newAccessLogWithConfig(wellknown.HTTPGRPCAccessLog, t)
 		case *envoy_open_telemetry.OpenTelemetryAccessLogConfig:
-			addDefaultResourceAttributes(pCtx, t)
+			addDefaultResourceAttributes(pCtx.Gateway, t)
+			cfg = newAccessLogWithConfig("envoy.access_loggers.open_telemetry", t)
+		}
+		// Add filter if specified
+		if policies[i].Filter != nil {
+			if err := addAccessLogFilter(cfg, policies[i].Filter); err != nil {
+				return nil, err
+			}
+		}
+		accessLogs[i] = cfg
+	}
+	return accessLogs, nil
+}
+
+// generateTcpAccessLogConfig generates the connection-level access logs of a TcpProxy.
+// File sinks without a format get a default format with the connection fields, and gRPC sinks use the TCP logger,
+// as the default format and the HTTP gRPC logger only report HTTP request fields.
+func generateTcpAccessLogConfig(pCtx *ir.TcpProxyContext, policies []kgateway.AccessLog, configs []proto.Message) ([]*envoyaccesslogv3.AccessLog, error) {
+	accessLogs := make([]*envoyaccesslogv3.AccessLog, len(configs))
+	if len(configs) == 0 {
+		return accessLogs, nil
+	}
+
+	for i, config := range configs {
+		var cfg *envoyaccesslogv3.AccessLog
+		switch t := config.(type) {
+		case *envoyalfile.FileAccessLog:
+			if t.GetAccessLogFormat() == nil {
+				t = proto.Clone(t).(*envoyalfile.FileAccessLog)
+				t.AccessLogFormat = &envoyalfile.FileAccessLog_LogFormat{
+					LogFormat: &envoycorev3.SubstitutionFormatString{
+						Format: &envoycorev3.SubstitutionFormatString_TextFormatSource{
+							TextFormatSource: &envoycorev3.DataSource{
+								Specifier: &envoycorev3.DataSource_InlineString{
+									InlineString: DefaultTcpAccessLogFormat,
+								},
+							},
+						},
+					},
+				}
+			}
+			cfg = newAccessLogWithConfig(wellknown.FileAccessLog, t)
+		case *envoygrpc.HttpGrpcAccessLogConfig:
+			cfg = newAccessLogWithConfig(wellknown.TCPGRPCAccessLog, &envoygrpc.TcpGrpcAccessLogConfig{
+				CommonConfig: t.GetCommonConfig(),
+			})
+		case *envoy_open_telemetry.OpenTelemetryAccessLogConfig:
+			addDefaultResourceAttributes(pCtx.Gateway, t)
 			cfg = newAccessLogWithConfig("envoy.access_loggers.open_telemetry", t)
 		}
 		// Add filter if specified
@@ -608,7 +657,7 @@ func generateAccessLogConfig(pCtx *ir.HcmContext, policies []kgateway.AccessLog,
 	return accessLogs, nil
 }
 
-func addDefaultResourceAttributes(pCtx *ir.HcmContext, config *envoy_open_telemetry.OpenTelemetryAccessLogConfig) {
+func addDefaultResourceAttributes(gw ir.GatewayIR, config *envoy_open_telemetry.OpenTelemetryAccessLogConfig) {
 	if config.GetResourceAttributes() == nil {
 		config.ResourceAttributes = &otelv1.KeyValueList{
 			Values: []*otelv1.KeyValue{
@@ -616,7 +665,7 @@ func addDefaultResourceAttributes(pCtx *ir.HcmContext, config *envoy_open_teleme
 					Key: serviceNameKey,
 					Value: &otelv1.AnyValue{
 						Value: &otelv1.AnyValue_StringValue{
-							StringValue: GenerateDefaultServiceName(pCtx.Gateway.SourceObject.GetName(), pCtx.Gateway.SourceObject.GetNamespace()),
+							StringValue: GenerateDefaultServiceName(gw.SourceObject.GetName(), gw.SourceObject.GetNamespace()),
 						},
 					},
 				},
@@ -634,7 +683,7 @@ func addDefaultResourceAttributes(pCtx *ir.HcmContext, config *envoy_open_teleme
 		Key: serviceNameKey,
 		Value: &otelv1.AnyValue{
 			Value: &otelv1.AnyValue_StringValue{
-				StringValue: GenerateDefaultServiceName(pCtx.Gateway.SourceObject.GetName(), pCtx.Gateway.SourceObject.GetNamespace()),
+				StringValue: GenerateDefaultServiceName(gw.SourceObject.GetName(), gw.SourceObject.GetNamespace()),
 			},
 		},
 	})
@@ -0,0 +1,85 @@
+package listenerpolicy
+
+import (
+	"testing"
+
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConvertClientCertDetails(t *testing.T) {
+	tests := []struct {
+		name               string
+		details            *kgateway.ClientCertDetails
+		expectedForward    *envoy_hcm.HttpConnectionManager_ForwardClientCertDetails
+		expectedSetCurrent *envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails
+	}{
+		{
+			name: "unset",
+		},
+		{
+			name:            "forward only",
+			details:         &kgateway.ClientCertDetails{Forward: kgateway.ForwardOnlyForwardClientCertDetails},
+			expectedForward: ptr.To(envoy_hcm.HttpConnectionManager_FORWARD_ONLY),
+		},
+		{
+			name: "sanitize set",
+			details: &kgateway.ClientCertDetails{
+				Forward: kgateway.SanitizeSetForwardClientCertDetails,
+				Subject: ptr.To(true),
+				Cert:    ptr.To(true),
+				URI:     ptr.To(true),
+			},
+			expectedForward: ptr.To(envoy_hcm.HttpConnectionManager_SANITIZE_SET),
+			expectedSetCurrent: &envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+				Subject: wrapperspb.Bool(true),
+				Cert:    true,
+				Uri:     true,
+			},
+		},
+		{
+			name: "append forward without details",
+			details: &kgateway.ClientCertDetails{
+				Forward: kgateway.AppendForwardForwardClientCertDetails,
+			},
+			expectedForward:    ptr.To(envoy_hcm.HttpConnectionManager_APPEND_FORWARD),
+			expectedSetCurrent: &envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forward, setCurrent := convertClientCertDetails(tt.details)
+			assert.Equal(t, tt.expectedForward, forward)
+			assert.True(t, proto.Equal(tt.expectedSetCurrent, setCurrent))
+		})
+	}
+}
+
+func TestApplyHCMClientCertDetails(t *testing.T) {
+	forward, setCurrent := convertClientCertDetails(&kgateway.ClientCertDetails{
+		Forward: kgateway.AppendForwardForwardClientCertDetails,
+		DNS:     ptr.To(true),
+	})
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{
+			http: &HttpListenerPolicyIr{
+				forwardClientCertDetails:    forward,
+				setCurrentClientCertDetails: setCurrent,
+			},
+		},
+	}
+
+	pass := &listenerPolicyPluginGwPass{}
+	out := &envoy_hcm.HttpConnectionManager{}
+	require.NoError(t, pass.ApplyHCM(&ir.HcmContext{ListenerPort: 8443, Policy: pol}, out))
+	assert.Equal(t, envoy_hcm.HttpConnectionManager_APPEND_FORWARD, out.GetForwardClientCertDetails())
+	assert.True(t, out.GetSetCurrentClientCertDetails().GetDns())
+}
@@ -0,0 +1,47 @@
+package listenerpolicy
+
+import (
+	"testing"
+
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	customheaderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/original_ip_detection/custom_header/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyHCMClientIPHeader(t *testing.T) {
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{
+			http: &HttpListenerPolicyIr{
+				useRemoteAddress:  ptr.To(true),
+				xffNumTrustedHops: ptr.To(uint32(2)),
+				skipXffAppend:     ptr.To(true),
+				clientIPDetection: convertClientIPHeader(&kgateway.ClientIPHeader{
+					Name:    "CF-Connecting-IP",
+					Trusted: ptr.To(true),
+				}),
+			},
+		},
+	}
+
+	pass := &listenerPolicyPluginGwPass{}
+	out := &envoy_hcm.HttpConnectionManager{UseRemoteAddress: wrapperspb.Bool(true)}
+	require.NoError(t, pass.ApplyHCM(&ir.HcmContext{ListenerPort: 8080, Policy: pol}, out))
+
+	assert.True(t, out.GetSkipXffAppend())
+	// the remote address and trusted hops cannot be used together with the original IP detection extensions
+	assert.False(t, out.GetUseRemoteAddress().GetValue())
+	assert.Zero(t, out.GetXffNumTrustedHops())
+	require.Len(t, out.GetOriginalIpDetectionExtensions(), 1)
+	assert.Equal(t, customHeaderIPDetectionExtensionName, out.GetOriginalIpDetectionExtensions()[0].GetName())
+
+	customHeader := &customheaderv3.CustomHeaderConfig{}
+	require.NoError(t, out.GetOriginalIpDetectionExtensions()[0].GetTypedConfig().UnmarshalTo(customHeader))
+	assert.Equal(t, "CF-Connecting-IP", customHeader.GetHeaderName())
+	assert.True(t, customHeader.GetAllowExtensionToSetAddressAsTrusted())
+}
@@ -0,0 +1,105 @@
+package listenerpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	connectionlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	networkratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConnectionLimitNetworkFilter(t *testing.T) {
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{maxConnections: ptr.To(uint32(100))},
+		perPortPolicy: map[uint32]listenerPolicy{
+			8443: {maxConnections: ptr.To(uint32(10))},
+			9000: {},
+		},
+	}
+
+	pass := NewGatewayTranslationPass(ir.GwTranslationCtx{}, nil).(*listenerPolicyPluginGwPass)
+	for _, port := range []uint32{8080, 8443, 9000} {
+		pass.ApplyListenerPlugin(&ir.ListenerContext{Policy: pol, Port: port}, &envoylistenerv3.Listener{})
+	}
+
+	tests := []struct {
+		port     uint32
+		expected uint64
+	}{
+		{port: 8080, expected: 100},
+		{port: 8443, expected: 10},
+	}
+	for _, tt := range tests {
+		networkFilters, err := pass.NetworkFilters(ir.NetworkFiltersContext{ListenerPort: tt.port})
+		require.NoError(t, err)
+		require.Len(t, networkFilters, 1)
+		assert.Equal(t, connectionLimitFilterName, networkFilters[0].Filter.GetName())
+
+		connectionLimit := &connectionlimitv3.ConnectionLimit{}
+		require.NoError(t, networkFilters[0].Filter.GetTypedConfig().UnmarshalTo(connectionLimit))
+		assert.Equal(t, tt.expected, connectionLimit.GetMaxConnections().GetValue())
+	}
+
+	// the per-port policy without a limit and the listeners without a policy are not limited
+	for _, port := range []uint32{9000, 9999} {
+		networkFilters, err := pass.NetworkFilters(ir.NetworkFiltersContext{ListenerPort: port})
+		require.NoError(t, err)
+		assert.Empty(t, networkFilters)
+	}
+}
+
+func TestConnectionRateLimitNetworkFilter(t *testing.T) {
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{
+			tcp: &TcpListenerPolicyIr{
+				connectionRateLimit: convertConnectionRateLimit(&kgateway.TokenBucket{
+					MaxTokens:     10,
+					TokensPerFill: ptr.To(int32(5)),
+					FillInterval:  metav1.Duration{Duration: time.Second},
+				}),
+			},
+		},
+	}
+
+	pass := NewGatewayTranslationPass(ir.GwTranslationCtx{}, nil).(*listenerPolicyPluginGwPass)
+	pass.ApplyListenerPlugin(&ir.ListenerContext{Policy: pol, Port: 9443}, &envoylistenerv3.Listener{})
+
+	networkFilters, err := pass.NetworkFilters(ir.NetworkFiltersContext{ListenerPort: 9443, TcpProxy: true})
+	require.NoError(t, err)
+	require.Len(t, networkFilters, 1)
+	assert.Equal(t, connectionRateLimitFilterName, networkFilters[0].Filter.GetName())
+
+	rateLimit := &networkratelimitv3.LocalRateLimit{}
+	require.NoError(t, networkFilters[0].Filter.GetTypedConfig().UnmarshalTo(rateLimit))
+	assert.Equal(t, uint32(10), rateLimit.GetTokenBucket().GetMaxTokens())
+	assert.Equal(t, uint32(5), rateLimit.GetTokenBucket().GetTokensPerFill().GetValue())
+	assert.Equal(t, time.Second, rateLimit.GetTokenBucket().GetFillInterval().AsDuration())
+	assert.Equal(t, "listener_9443", rateLimit.GetShareKey())
+
+	// the HTTP filter chains of the listener are not rate limited
+	networkFilters, err = pass.NetworkFilters(ir.NetworkFiltersContext{ListenerPort: 9443})
+	require.NoError(t, err)
+	assert.Empty(t, networkFilters)
+}
+
+func TestApplyHCMMaxRequestsPerConnection(t *testing.T) {
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{
+			http: &HttpListenerPolicyIr{maxRequestsPerConnection: ptr.To(uint32(1000))},
+		},
+	}
+
+	pass := &listenerPolicyPluginGwPass{}
+	out := &envoy_hcm.HttpConnectionManager{}
+	require.NoError(t, pass.ApplyHCM(&ir.HcmContext{ListenerPort: 8080, Policy: pol}, out))
+	assert.Equal(t, uint32(1000), out.GetCommonHttpProtocolOptions().GetMaxRequestsPerConnection().GetValue())
+}
@@ -7,10 +7,10 @@ import (
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
-	envoytracev3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	healthcheckv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/early_header_mutation/header_mutation/v3"
+	customheaderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/original_ip_detection/custom_header/v3"
 	envoyuuidv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/request_id/uuid/v3"
 	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/proto"
@@ -27,15 +27,20 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
+const customHeaderIPDetectionExtensionName = "envoy.extensions.http.original_ip_detection.custom_header"
+
 type HttpListenerPolicyIr struct {
 	upgradeConfigs             []*envoy_hcm.HttpConnectionManager_UpgradeConfig
 	useRemoteAddress           *bool
 	xffNumTrustedHops          *uint32
+	skipXffAppend              *bool
+	clientIPDetection          *envoycorev3.TypedExtensionConfig
 	serverHeaderTransformation *envoy_hcm.HttpConnectionManager_ServerHeaderTransformation
 	streamIdleTimeout          *time.Duration
 	idleTimeout                *time.Duration
 	healthCheckPolicy          *healthcheckv3.HealthCheck
 	preserveHttp1HeaderCase    *bool
+	properCaseHttp1Headers     *bool
 	preserveExternalRequestId  *bool
 	generateRequestId          *bool
 	// For a better UX, we set the default serviceName for access logs to the envoy cluster name (`<gateway-name>.<gateway-namespace>`).
@@ -48,13 +53,20 @@ type HttpListenerPolicyIr struct {
 	// Since the gateway name can only be determined during translation, the tracing config is split into the provider
 	// and the actual config. During translation, the default serviceName is set if not already provided
 	// and the final config is then marshalled.
-	tracingProvider               *envoytracev3.OpenTelemetryConfig
+	tracingProvider               proto.Message
 	tracingConfig                 *envoy_hcm.HttpConnectionManager_Tracing
 	acceptHttp10                  *bool
 	defaultHostForHttp10          *string
 	earlyHeaderMutationExtensions []*envoycorev3.TypedExtensionConfig
 	maxRequestHeadersKb           *uint32
+	maxRequestHeadersCount        *uint32
+	maxRequestsPerConnection      *uint32
+	streamErrorOnInvalidRequest   *bool
 	uuidRequestIdConfig           *envoyuuidv3.UuidRequestIdConfig
+	forwardClientCertDetails      *envoy_hcm.HttpConnectionManager_ForwardClientCertDetails
+	setCurrentClientCertDetails   *envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails
+	outageDrill                   *outageDrillIr
+	localReplyConfig              *envoy_hcm.LocalReplyConfig
 }
 
 func (d *HttpListenerPolicyIr) Equals(in any) bool {
@@ -103,6 +115,13 @@ func (d *HttpListenerPolicyIr) Equals(in any) bool {
 		return false
 	}
 
+	if !cmputils.PointerValsEqual(d.skipXffAppend, d2.skipXffAppend) {
+		return false
+	}
+	if !proto.Equal(d.clientIPDetection, d2.clientIPDetection) {
+		return false
+	}
+
 	// Check xffNumTrustedHops
 	if !cmputils.PointerValsEqual(d.xffNumTrustedHops, d2.xffNumTrustedHops) {
 		return false
@@ -143,6 +162,10 @@ func (d *HttpListenerPolicyIr) Equals(in any) bool {
 		return false
 	}
 
+	if !cmputils.PointerValsEqual(d.properCaseHttp1Headers, d2.properCaseHttp1Headers) {
+		return false
+	}
+
 	if !cmputils.PointerValsEqual(d.acceptHttp10, d2.acceptHttp10) {
 		return false
 	}
@@ -161,10 +184,37 @@ func (d *HttpListenerPolicyIr) Equals(in any) bool {
 		return false
 	}
 
+	if !cmputils.PointerValsEqual(d.maxRequestHeadersCount, d2.maxRequestHeadersCount) {
+		return false
+	}
+
+	if !cmputils.PointerValsEqual(d.maxRequestsPerConnection, d2.maxRequestsPerConnection) {
+		return false
+	}
+
+	if !cmputils.PointerValsEqual(d.streamErrorOnInvalidRequest, d2.streamErrorOnInvalidRequest) {
+		return false
+	}
+
 	if !proto.Equal(d.uuidRequestIdConfig, d2.uuidRequestIdConfig) {
 		return false
 	}
 
+	if !cmputils.PointerValsEqual(d.forwardClientCertDetails, d2.forwardClientCertDetails) {
+		return false
+	}
+	if !proto.Equal(d.setCurrentClientCertDetails, d2.setCurrentClientCertDetails) {
+		return false
+	}
+
+	if !d.outageDrill.Equals(d2.outageDrill) {
+		return false
+	}
+
+	if !proto.Equal(d.localReplyConfig, d2.localReplyConfig) {
+		return false
+	}
+
 	return true
 }
 
@@ -173,7 +223,7 @@ func NewHttpListenerPolicy(krtctx krt.HandlerContext, commoncol *collections.Com
 		return nil, nil
 	}
 	errs := []error{}
-	accessLog, err := convertAccessLogConfig(h, commoncol, krtctx, objSrc)
+	accessLog, err := convertAccessLogConfig(h.AccessLog, commoncol, krtctx, objSrc)
 	if err != nil {
 		logger.Error("error translating access log", "error", err)
 		errs = append(errs, err)
@@ -212,6 +262,21 @@ func NewHttpListenerPolicy(krtctx krt.HandlerContext, commoncol *collections.Com
 		maxRequestHeadersKb = ptr.To(uint32(*h.MaxRequestHeadersKb)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
 	}
 
+	var maxRequestHeadersCount *uint32
+	if h.MaxRequestHeadersCount != nil {
+		maxRequestHeadersCount = ptr.To(uint32(*h.MaxRequestHeadersCount)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+
+	var maxRequestsPerConnection *uint32
+	if h.MaxRequestsPerConnection != nil {
+		maxRequestsPerConnection = ptr.To(uint32(*h.MaxRequestsPerConnection)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+
+	var streamErrorOnInvalidRequest *bool
+	if h.InvalidRequestAction != nil {
+		streamErrorOnInvalidRequest = ptr.To(*h.InvalidRequestAction == kgateway.ResetStreamInvalidRequestAction)
+	}
+
 	var uuidRequestIdConfig *envoyuuidv3.UuidRequestIdConfig
 	if h.UuidRequestIdConfig != nil {
 		uuidRequestIdConfig = &envoyuuidv3.UuidRequestIdConfig{
@@ -220,6 +285,14 @@ func NewHttpListenerPolicy(krtctx krt.HandlerContext, commoncol *collections.Com
 		}
 	}
 
+	forwardClientCertDetails, setCurrentClientCertDetails := convertClientCertDetails(h.ClientCertDetails)
+
+	localReplyConfig, err := convertLocalReply(krtctx, commoncol.ConfigMaps, objSrc, h.LocalReply)
+	if err != nil {
+		logger.Error("error translating local reply", "error", err)
+		errs = append(errs, err)
+	}
+
 	return &HttpListenerPolicyIr{
 		accessLogConfig:               accessLog,
 		accessLogPolicies:             h.AccessLog,
@@ -230,16 +303,26 @@ func NewHttpListenerPolicy(krtctx krt.HandlerContext, commoncol *collections.Com
 		preserveExternalRequestId:     h.PreserveExternalRequestId,
 		generateRequestId:             h.GenerateRequestId,
 		xffNumTrustedHops:             xffNumTrustedHops,
+		skipXffAppend:                 h.SkipXffAppend,
+		clientIPDetection:             convertClientIPHeader(h.ClientIPHeader),
 		serverHeaderTransformation:    serverHeaderTransformation,
 		streamIdleTimeout:             streamIdleTimeout,
 		idleTimeout:                   idleTimeout,
 		healthCheckPolicy:             healthCheckPolicy,
 		preserveHttp1HeaderCase:       h.PreserveHttp1HeaderCase,
+		properCaseHttp1Headers:        h.ProperCaseHttp1Headers,
 		acceptHttp10:                  h.AcceptHttp10,
 		defaultHostForHttp10:          h.DefaultHostForHttp10,
 		earlyHeaderMutationExtensions: convertHeaderMutations(h.EarlyRequestHeaderModifier),
 		maxRequestHeadersKb:           maxRequestHeadersKb,
+		maxRequestHeadersCount:        maxRequestHeadersCount,
+		maxRequestsPerConnection:      maxRequestsPerConnection,
+		streamErrorOnInvalidRequest:   streamErrorOnInvalidRequest,
 		uuidRequestIdConfig:           uuidRequestIdConfig,
+		forwardClientCertDetails:      forwardClientCertDetails,
+		setCurrentClientCertDetails:   setCurrentClientCertDetails,
+		outageDrill:                   newOutageDrill(krtctx, h.OutageDrill, time.Now()),
+		localReplyConfig:              localReplyConfig,
 	}, errs
 }
 
@@ -277,6 +360,57 @@ func convertServerHeaderTransformation(transformation *kgateway.ServerHeaderTran
 	}
 }
 
+func convertClientIPHeader(header *kgateway.ClientIPHeader) *envoycorev3.TypedExtensionConfig {
+	if header == nil {
+		return nil
+	}
+	return &envoycorev3.TypedExtensionConfig{
+		Name: customHeaderIPDetectionExtensionName,
+		TypedConfig: utils.MustMessageToAny(&customheaderv3.CustomHeaderConfig{
+			HeaderName:                          string(header.Name),
+			AllowExtensionToSetAddressAsTrusted: ptr.Deref(header.Trusted, false),
+		}),
+	}
+}
+
+func convertClientCertDetails(details *kgateway.ClientCertDetails) (
+	*envoy_hcm.HttpConnectionManager_ForwardClientCertDetails,
+	*envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails,
+) {
+	if details == nil {
+		return nil, nil
+	}
+
+	var forward envoy_hcm.HttpConnectionManager_ForwardClientCertDetails
+	switch details.Forward {
+	case kgateway.ForwardOnlyForwardClientCertDetails:
+		forward = envoy_hcm.HttpConnectionManager_FORWARD_ONLY
+	case kgateway.AppendForwardForwardClientCertDetails:
+		forward = envoy_hcm.HttpConnectionManager_APPEND_FORWARD
+	case kgateway.SanitizeSetForwardClientCertDetails:
+		forward = envoy_hcm.HttpConnectionManager_SANITIZE_SET
+	case kgateway.AlwaysForwardOnlyForwardClientCertDetails:
+		forward = envoy_hcm.HttpConnectionManager_ALWAYS_FORWARD_ONLY
+	default:
+		forward = envoy_hcm.HttpConnectionManager_SANITIZE
+	}
+
+	// The details of the client certificate are only added with APPEND_FORWARD and SANITIZE_SET
+	if forward != envoy_hcm.HttpConnectionManager_APPEND_FORWARD && forward != envoy_hcm.HttpConnectionManager_SANITIZE_SET {
+		return &forward, nil
+	}
+	setCurrent := &envoy_hcm.HttpConnectionManager_SetCurrentClientCertDetails{
+		Cert:  ptr.Deref(details.Cert, false),
+		Chain: ptr.Deref(details.Chain, false),
+		Dns:   ptr.Deref(details.DNS, false),
+		Uri:   ptr.Deref(details.URI, false),
+	}
+	if details.Subject != nil {
+		setCurrent.Subject = wrapperspb.Bool(*details.Subject)
+	}
+	return &forward, setCurrent
+}
+
 func convertHealthCheckPolicy(policy *kgateway.HTTPSettings) *healthcheckv3.HealthCheck {
 	if policy.HealthCheck != nil {
 		return &healthcheckv3.HealthCheck{
@@ -6,12 +6,17 @@ import (
 	"maps"
 	"time"
 
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	healthcheckv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
 	proxy_protocol "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/listener/proxy_protocol/v3"
+	connectionlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/connection_limit/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	networkratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/local_ratelimit/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	preserve_case_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/header_formatters/preserve_case/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -43,6 +48,11 @@ import (
 
 var logger = logging.New("plugin/listenerpolicy")
 
+const (
+	connectionLimitFilterName     = "envoy.filters.network.connection_limit"
+	connectionRateLimitFilterName = "envoy.filters.network.local_ratelimit"
+)
+
 type ListenerPolicyIR struct {
 	ct            time.Time
 	defaultPolicy listenerPolicy
@@ -55,8 +65,11 @@ type ListenerPolicyIR struct {
 type listenerPolicy struct {
 	proxyProtocol                 *anypb.Any
 	perConnectionBufferLimitBytes *uint32
+	maxConnections                *uint32
 	// +noKrtEquals
 	http *HttpListenerPolicyIr
+	// +noKrtEquals
+	tcp *TcpListenerPolicyIr
 }
 
 func newListenerPolicy(
@@ -69,12 +82,20 @@ func newListenerPolicy(
 	if i.PerConnectionBufferLimitBytes != nil {
 		perConnectionBufferLimitBytes = ptr.To(uint32(*i.PerConnectionBufferLimitBytes)) //nolint:gosec // G115: kubebuilder validation ensures 0 <= value <= 2147483647, safe for uint32
 	}
+	var maxConnections *uint32
+	if i.MaxConnections != nil {
+		maxConnections = ptr.To(uint32(*i.MaxConnections)) //nolint:gosec // G115: kubebuilder validation ensures 1 <= value <= 2147483647, safe for uint32
+	}
 	http, errs := NewHttpListenerPolicy(krtctx, commoncol, i.HTTPSettings, objSrc)
+	tcp, tcpErrs := NewTcpListenerPolicy(krtctx, commoncol, i.TCPSettings, objSrc)
+	errs = append(errs, tcpErrs...)
 
 	return listenerPolicy{
 		proxyProtocol:                 convertProxyProtocolConfig(objSrc, i.ProxyProtocol),
 		perConnectionBufferLimitBytes: perConnectionBufferLimitBytes,
+		maxConnections:                maxConnections,
 		http:                          http,
+		tcp:                           tcp,
 	}, errs
 }
 
@@ -111,6 +132,10 @@ func (d listenerPolicy) Equals(d2 listenerPolicy) bool {
 		return false
 	}
 
+	if !cmputils.PointerValsEqual(d.maxConnections, d2.maxConnections) {
+		return false
+	}
+
 	if (d.http == nil) != (d2.http == nil) {
 		return false
 	}
@@ -118,6 +143,13 @@ func (d listenerPolicy) Equals(d2 listenerPolicy) bool {
 		return false
 	}
 
+	if (d.tcp == nil) != (d2.tcp == nil) {
+		return false
+	}
+	if d.tcp != nil && !d.tcp.Equals(d2.tcp) {
+		return false
+	}
+
 	return true
 }
 
@@ -162,6 +194,12 @@ type listenerPolicyPluginGwPass struct {
 	reporter reporter.Reporter
 
 	healthCheckPolicy map[uint32]*healthcheckv3.HealthCheck
+	// maxConnections is the connection limit of the listeners, by port
+	maxConnections map[uint32]uint32
+	// connectionRateLimit is the token bucket limiting the TCP connections of the listeners, by port
+	connectionRateLimit map[uint32]*typev3.TokenBucket
+	// outageDrillActive is true when a filter was pointed at the outage drill cluster
+	outageDrillActive bool
 }
 
 var _ ir.ProxyTranslationPass = &listenerPolicyPluginGwPass{}
@@ -232,6 +270,9 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 
 	// processMarkers for policies that have existing status but no current report
 	processMarkers := func(kctx krt.HandlerContext, reportMap *reports.ReportMap) {
+		// flag targetRefs that resolve to nothing while a targetSelector matches another object, as the target was possibly renamed
+		krtcollections.ReportPossibleTargetRenames(kctx, gk, policyCol, commoncol.GatewayIndex, commoncol.Routes, reportMap)
+
 		objStatus := krt.Fetch(kctx, policyStatusMarker)
 		for _, status := range objStatus {
 			policyKey := reporter.PolicyKey{
@@ -268,8 +309,10 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections) sd
 
 func NewGatewayTranslationPass(tctx ir.GwTranslationCtx, reporter reporter.Reporter) ir.ProxyTranslationPass {
 	return &listenerPolicyPluginGwPass{
-		reporter:          reporter,
-		healthCheckPolicy: map[uint32]*healthcheckv3.HealthCheck{},
+		reporter:            reporter,
+		healthCheckPolicy:   map[uint32]*healthcheckv3.HealthCheck{},
+		maxConnections:      map[uint32]uint32{},
+		connectionRateLimit: map[uint32]*typev3.TokenBucket{},
 	}
 }
 
@@ -305,9 +348,61 @@ func (p *listenerPolicyPluginGwPass) ApplyListenerPlugin(
 	if cfg.perConnectionBufferLimitBytes != nil {
 		out.PerConnectionBufferLimitBytes = &wrapperspb.UInt32Value{Value: *cfg.perConnectionBufferLimitBytes}
 	}
+	// The connection limit filter is added to the filter chains, which are computed after the listener plugins
+	if cfg.maxConnections != nil {
+		p.maxConnections[pCtx.Port] = *cfg.maxConnections
+	}
 	if http := cfg.http; http != nil {
 		p.healthCheckPolicy[pCtx.Port] = http.healthCheckPolicy
 	}
+	if tcp := cfg.tcp; tcp != nil && tcp.connectionRateLimit != nil {
+		p.connectionRateLimit[pCtx.Port] = tcp.connectionRateLimit
+	}
+}
+
+func (p *listenerPolicyPluginGwPass) NetworkFilters(nCtx ir.NetworkFiltersContext) ([]filters.StagedNetworkFilter, error) {
+	var out []filters.StagedNetworkFilter
+	// Reject the connections over the limits before any other filter processes them
+	if maxConnections, ok := p.maxConnections[nCtx.ListenerPort]; ok {
+		connectionLimit, err := utils.MessageToAny(&connectionlimitv3.ConnectionLimit{
+			StatPrefix:     fmt.Sprintf("listener_%d", nCtx.ListenerPort),
+			MaxConnections: wrapperspb.UInt64(uint64(maxConnections)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, filters.StagedNetworkFilter{
+			Filter: &envoylistenerv3.Filter{
+				Name: connectionLimitFilterName,
+				ConfigType: &envoylistenerv3.Filter_TypedConfig{
+					TypedConfig: connectionLimit,
+				},
+			},
+			Stage: filters.BeforeStage(filters.FaultStage),
+		})
+	}
+
+	if tokenBucket, ok := p.connectionRateLimit[nCtx.ListenerPort]; ok && nCtx.TcpProxy {
+		// The share key makes the filter chains of the listener consume the same token bucket
+		connectionRateLimit, err := utils.MessageToAny(&networkratelimitv3.LocalRateLimit{
+			StatPrefix:  fmt.Sprintf("listener_%d", nCtx.ListenerPort),
+			TokenBucket: tokenBucket,
+			ShareKey:    fmt.Sprintf("listener_%d", nCtx.ListenerPort),
+		})
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, filters.StagedNetworkFilter{
+			Filter: &envoylistenerv3.Filter{
+				Name: connectionRateLimitFilterName,
+				ConfigType: &envoylistenerv3.Filter_TypedConfig{
+					TypedConfig: connectionRateLimit,
+				},
+			},
+			Stage: filters.BeforeStage(filters.FaultStage),
+		})
+	}
+	return out, nil
 }
 
 func (p *listenerPolicyPluginGwPass) HttpFilters(hCtx ir.HttpFiltersContext, fc ir.FilterChainCommon) ([]filters.StagedHttpFilter, error) {
@@ -366,12 +461,23 @@ func (p *listenerPolicyPluginGwPass) ApplyHCM(
 	if policy.generateRequestId != nil {
 		out.GenerateRequestId = wrapperspb.Bool(*policy.generateRequestId)
 	}
+	if policy.skipXffAppend != nil {
+		out.SkipXffAppend = *policy.skipXffAppend
+	}
 
 	// translate xffNumTrustedHops
 	if policy.xffNumTrustedHops != nil {
 		out.XffNumTrustedHops = *policy.xffNumTrustedHops
 	}
 
+	// The CRD rejects a policy combining them, but merged policies still can
+	if policy.clientIPDetection != nil {
+		// Envoy rejects the original IP detection extensions when the remote address or trusted hops are used
+		out.UseRemoteAddress = wrapperspb.Bool(false)
+		out.XffNumTrustedHops = 0
+		out.OriginalIpDetectionExtensions = []*envoycorev3.TypedExtensionConfig{policy.clientIPDetection}
+	}
+
 	// translate serverHeaderTransformation
 	if policy.serverHeaderTransformation != nil {
 		out.ServerHeaderTransformation = *policy.serverHeaderTransformation
@@ -412,6 +518,15 @@ func (p *listenerPolicyPluginGwPass) ApplyHCM(
 				},
 			},
 		}
+	} else if policy.properCaseHttp1Headers != nil && *policy.properCaseHttp1Headers {
+		if out.HttpProtocolOptions == nil {
+			out.HttpProtocolOptions = &envoycorev3.Http1ProtocolOptions{}
+		}
+		out.GetHttpProtocolOptions().HeaderKeyFormat = &envoycorev3.Http1ProtocolOptions_HeaderKeyFormat{
+			HeaderFormat: &envoycorev3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords_{
+				ProperCaseWords: &envoycorev3.Http1ProtocolOptions_HeaderKeyFormat_ProperCaseWords{},
+			},
+		}
 	}
 
 	if policy.acceptHttp10 != nil && *policy.acceptHttp10 {
@@ -433,6 +548,27 @@ func (p *listenerPolicyPluginGwPass) ApplyHCM(
 		out.MaxRequestHeadersKb = wrapperspb.UInt32(*policy.maxRequestHeadersKb)
 	}
 
+	// translate maxRequestHeadersCount
+	if policy.maxRequestHeadersCount != nil {
+		if out.CommonHttpProtocolOptions == nil {
+			out.CommonHttpProtocolOptions = &envoycorev3.HttpProtocolOptions{}
+		}
+		out.GetCommonHttpProtocolOptions().MaxHeadersCount = wrapperspb.UInt32(*policy.maxRequestHeadersCount)
+	}
+
+	// translate maxRequestsPerConnection
+	if policy.maxRequestsPerConnection != nil {
+		if out.CommonHttpProtocolOptions == nil {
+			out.CommonHttpProtocolOptions = &envoycorev3.HttpProtocolOptions{}
+		}
+		out.GetCommonHttpProtocolOptions().MaxRequestsPerConnection = wrapperspb.UInt32(*policy.maxRequestsPerConnection)
+	}
+
+	// translate invalidRequestAction
+	if policy.streamErrorOnInvalidRequest != nil {
+		out.StreamErrorOnInvalidHttpMessage = wrapperspb.Bool(*policy.streamErrorOnInvalidRequest)
+	}
+
 	// translate uuidRequestIdConfig
 	if policy.uuidRequestIdConfig != nil {
 		requestIdExtensionAny, err := utils.MessageToAny(policy.uuidRequestIdConfig)
@@ -445,6 +581,63 @@ func (p *listenerPolicyPluginGwPass) ApplyHCM(
 		}
 	}
 
+	// translate clientCertDetails
+	if policy.forwardClientCertDetails != nil {
+		out.ForwardClientCertDetails = *policy.forwardClientCertDetails
+	}
+	if policy.setCurrentClientCertDetails != nil {
+		out.SetCurrentClientCertDetails = policy.setCurrentClientCertDetails
+	}
+
+	// translate localReply
+	if policy.localReplyConfig != nil {
+		out.LocalReplyConfig = policy.localReplyConfig
+	}
+
+	// simulate the unavailability of the external services of an active outage drill
+	drilled, err := applyOutageDrill(policy.outageDrill, out)
+	if err != nil {
+		return err
+	}
+	p.outageDrillActive = p.outageDrillActive || drilled
+
+	return nil
+}
+
+func (p *listenerPolicyPluginGwPass) ResourcesToAdd() ir.Resources {
+	resources := ir.Resources{}
+	if p.outageDrillActive {
+		resources.Clusters = []*envoyclusterv3.Cluster{buildOutageDrillCluster()}
+	}
+	return resources
+}
+
+func (p *listenerPolicyPluginGwPass) ApplyTcpProxy(
+	pCtx *ir.TcpProxyContext,
+	out *envoytcp.TcpProxy,
+) error {
+	cfg := p.getPolicy(pCtx.Policy, pCtx.ListenerPort)
+	policy := cfg.tcp
+	if policy == nil {
+		return nil
+	}
+
+	// translate access logging configuration
+	accessLogs, err := generateTcpAccessLogConfig(pCtx, policy.accessLogPolicies, policy.accessLogConfig)
+	if err != nil {
+		return err
+	}
+	out.AccessLog = append(out.GetAccessLog(), accessLogs...)
+	if policy.accessLogOptions != nil {
+		out.AccessLogOptions = policy.accessLogOptions
+	}
+	if policy.idleTimeout != nil {
+		out.IdleTimeout = policy.idleTimeout
+	}
+	if policy.maxConnectionDuration != nil {
+		out.MaxDownstreamConnectionDuration = policy.maxConnectionDuration
+	}
+
 	return nil
 }
 
@@ -0,0 +1,143 @@
+package listenerpolicy
+
+import (
+	"errors"
+	"fmt"
+
+	envoyaccesslogv3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const localReplyBodyConfigMapKey = "body"
+
+// convertLocalReply converts the local reply mappers of the policy.
+// Bodies referenced by ConfigMap are inlined, so the policy is translated again when the ConfigMap changes.
+func convertLocalReply(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	objSrc ir.ObjectSource,
+	localReply *kgateway.LocalReply,
+) (*envoy_hcm.LocalReplyConfig, error) {
+	if localReply == nil {
+		return nil, nil
+	}
+
+	out := &envoy_hcm.LocalReplyConfig{}
+	for _, mapper := range localReply.Mappers {
+		responseMapper := &envoy_hcm.ResponseMapper{
+			Filter: convertLocalReplyMatch(mapper.Match),
+		}
+		if mapper.StatusCode != nil {
+			responseMapper.StatusCode = wrapperspb.UInt32(uint32(*mapper.StatusCode)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		}
+		if mapper.Body != nil {
+			body, err := getLocalReplyBody(krtctx, configMaps, objSrc, mapper.Body)
+			if err != nil {
+				return nil, err
+			}
+			responseMapper.BodyFormatOverride = &envoycorev3.SubstitutionFormatString{
+				Format: &envoycorev3.SubstitutionFormatString_TextFormatSource{
+					TextFormatSource: &envoycorev3.DataSource{
+						Specifier: &envoycorev3.DataSource_InlineString{InlineString: body},
+					},
+				},
+				ContentType: ptr.Deref(mapper.Body.ContentType, ""),
+			}
+		}
+		out.Mappers = append(out.Mappers, responseMapper)
+	}
+	return out, nil
+}
+
+// convertLocalReplyMatch converts the match of a mapper to an access log filter, which matches the replies
+// with one of the status codes and one of the response flags.
+func convertLocalReplyMatch(match kgateway.LocalReplyMatch) *envoyaccesslogv3.AccessLogFilter {
+	var filters []*envoyaccesslogv3.AccessLogFilter
+
+	var statusCodeFilters []*envoyaccesslogv3.AccessLogFilter
+	for _, statusCode := range match.StatusCodes {
+		statusCodeFilters = append(statusCodeFilters, &envoyaccesslogv3.AccessLogFilter{
+			FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &envoyaccesslogv3.StatusCodeFilter{
+					Comparison: &envoyaccesslogv3.ComparisonFilter{
+						Op: envoyaccesslogv3.ComparisonFilter_EQ,
+						Value: &envoycorev3.RuntimeUInt32{
+							DefaultValue: uint32(statusCode), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+						},
+					},
+				},
+			},
+		})
+	}
+	// an OrFilter requires at least two filters
+	switch len(statusCodeFilters) {
+	case 0:
+	case 1:
+		filters = append(filters, statusCodeFilters[0])
+	default:
+		filters = append(filters, &envoyaccesslogv3.AccessLogFilter{
+			FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_OrFilter{
+				OrFilter: &envoyaccesslogv3.OrFilter{Filters: statusCodeFilters},
+			},
+		})
+	}
+
+	if len(match.ResponseFlags) > 0 {
+		filters = append(filters, &envoyaccesslogv3.AccessLogFilter{
+			FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_ResponseFlagFilter{
+				ResponseFlagFilter: &envoyaccesslogv3.ResponseFlagFilter{
+					Flags: match.ResponseFlags,
+				},
+			},
+		})
+	}
+
+	if len(filters) == 1 {
+		return filters[0]
+	}
+	return &envoyaccesslogv3.AccessLogFilter{
+		FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_AndFilter{
+			AndFilter: &envoyaccesslogv3.AndFilter{Filters: filters},
+		},
+	}
+}
+
+func getLocalReplyBody(
+	krtctx krt.HandlerContext,
+	configMaps *krtcollections.ConfigMapIndex,
+	objSrc ir.ObjectSource,
+	body *kgateway.LocalReplyBody,
+) (string, error) {
+	switch {
+	case body.Inline != nil:
+		return *body.Inline, nil
+	case body.ConfigMapRef != nil:
+		from := krtcollections.From{
+			GroupKind: objSrc.GetGroupKind(),
+			Namespace: objSrc.Namespace,
+		}
+		cm, err := configMaps.GetConfigMap(krtctx, from, gwv1.ObjectReference{
+			Kind: "ConfigMap",
+			Name: gwv1.ObjectName(body.ConfigMapRef.Name),
+		})
+		if err != nil {
+			return "", fmt.Errorf("local reply: failed to find configmap %s: %w", body.ConfigMapRef.Name, err)
+		}
+		value := cm.Data[localReplyBodyConfigMapKey]
+		if value == "" {
+			return "", fmt.Errorf("local reply: configmap %s key '%s' not found", body.ConfigMapRef.Name, localReplyBodyConfigMapKey)
+		}
+		return value, nil
+	default:
+		return "", errors.New("local reply: one of inline or configMapRef must be set")
+	}
+}
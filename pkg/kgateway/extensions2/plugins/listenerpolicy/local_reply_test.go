@@ -0,0 +1,139 @@
+package listenerpolicy
+
+import (
+	"testing"
+
+	envoyaccesslogv3 "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func statusCodeFilter(code uint32) *envoyaccesslogv3.AccessLogFilter {
+	return &envoyaccesslogv3.AccessLogFilter{
+		FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_StatusCodeFilter{
+			StatusCodeFilter: &envoyaccesslogv3.StatusCodeFilter{
+				Comparison: &envoyaccesslogv3.ComparisonFilter{
+					Op:    envoyaccesslogv3.ComparisonFilter_EQ,
+					Value: &envoycorev3.RuntimeUInt32{DefaultValue: code},
+				},
+			},
+		},
+	}
+}
+
+func responseFlagFilter(flags ...string) *envoyaccesslogv3.AccessLogFilter {
+	return &envoyaccesslogv3.AccessLogFilter{
+		FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_ResponseFlagFilter{
+			ResponseFlagFilter: &envoyaccesslogv3.ResponseFlagFilter{Flags: flags},
+		},
+	}
+}
+
+func TestConvertLocalReplyMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		match    kgateway.LocalReplyMatch
+		expected *envoyaccesslogv3.AccessLogFilter
+	}{
+		{
+			name:     "single status code",
+			match:    kgateway.LocalReplyMatch{StatusCodes: []int32{404}},
+			expected: statusCodeFilter(404),
+		},
+		{
+			name:  "multiple status codes",
+			match: kgateway.LocalReplyMatch{StatusCodes: []int32{502, 503}},
+			expected: &envoyaccesslogv3.AccessLogFilter{
+				FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_OrFilter{
+					OrFilter: &envoyaccesslogv3.OrFilter{
+						Filters: []*envoyaccesslogv3.AccessLogFilter{statusCodeFilter(502), statusCodeFilter(503)},
+					},
+				},
+			},
+		},
+		{
+			name:     "response flags",
+			match:    kgateway.LocalReplyMatch{ResponseFlags: []string{"NR", "UH"}},
+			expected: responseFlagFilter("NR", "UH"),
+		},
+		{
+			name:  "status code and response flags",
+			match: kgateway.LocalReplyMatch{StatusCodes: []int32{403}, ResponseFlags: []string{"UAEX"}},
+			expected: &envoyaccesslogv3.AccessLogFilter{
+				FilterSpecifier: &envoyaccesslogv3.AccessLogFilter_AndFilter{
+					AndFilter: &envoyaccesslogv3.AndFilter{
+						Filters: []*envoyaccesslogv3.AccessLogFilter{statusCodeFilter(403), responseFlagFilter("UAEX")},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, proto.Equal(tt.expected, convertLocalReplyMatch(tt.match)))
+		})
+	}
+}
+
+func TestApplyHCMLocalReply(t *testing.T) {
+	localReplyConfig, err := convertLocalReply(nil, nil, ir.ObjectSource{}, &kgateway.LocalReply{
+		Mappers: []kgateway.LocalReplyMapper{
+			{
+				Match:      kgateway.LocalReplyMatch{ResponseFlags: []string{"NR"}},
+				StatusCode: ptr.To(int32(404)),
+				Body: &kgateway.LocalReplyBody{
+					Inline:      ptr.To(`{"code": %RESPONSE_CODE%, "message": "not found"}`),
+					ContentType: ptr.To("application/json"),
+				},
+			},
+			{
+				Match:      kgateway.LocalReplyMatch{StatusCodes: []int32{503}},
+				StatusCode: ptr.To(int32(502)),
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{
+			http: &HttpListenerPolicyIr{localReplyConfig: localReplyConfig},
+		},
+	}
+
+	pass := &listenerPolicyPluginGwPass{}
+	out := &envoy_hcm.HttpConnectionManager{}
+	require.NoError(t, pass.ApplyHCM(&ir.HcmContext{ListenerPort: 8080, Policy: pol}, out))
+
+	expected := &envoy_hcm.LocalReplyConfig{
+		Mappers: []*envoy_hcm.ResponseMapper{
+			{
+				Filter:     responseFlagFilter("NR"),
+				StatusCode: wrapperspb.UInt32(404),
+				BodyFormatOverride: &envoycorev3.SubstitutionFormatString{
+					Format: &envoycorev3.SubstitutionFormatString_TextFormatSource{
+						TextFormatSource: &envoycorev3.DataSource{
+							Specifier: &envoycorev3.DataSource_InlineString{
+								InlineString: `{"code": %RESPONSE_CODE%, "message": "not found"}`,
+							},
+						},
+					},
+					ContentType: "application/json",
+				},
+			},
+			{
+				Filter:     statusCodeFilter(503),
+				StatusCode: wrapperspb.UInt32(502),
+			},
+		},
+	}
+	assert.True(t, proto.Equal(expected, out.GetLocalReplyConfig()))
+}
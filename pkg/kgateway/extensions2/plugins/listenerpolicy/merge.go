@@ -89,7 +89,9 @@ func mergeListenerPolicy(
 	mergeFuncs := []func(string, *listenerPolicy, *listenerPolicy, *ir.AttachedPolicyRef, ir.MergeOrigins, policy.MergeOptions, ir.MergeOrigins){
 		mergeProxyProtocol,
 		mergePerConnectionBufferLimitBytes,
+		mergeMaxConnections,
 		mergeHttpSettings,
+		mergeTcpSettings,
 	}
 
 	for _, mergeFunc := range mergeFuncs {
@@ -128,6 +130,22 @@ func mergePerConnectionBufferLimitBytes(
 	p1.perConnectionBufferLimitBytes = p2.perConnectionBufferLimitBytes
 	mergeOrigins.SetOne(origin+"perConnectionBufferLimitBytes", p2Ref, p2MergeOrigins)
 }
+
+func mergeMaxConnections(
+	origin string,
+	p1, p2 *listenerPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.maxConnections, p2.maxConnections, opts) {
+		return
+	}
+
+	p1.maxConnections = p2.maxConnections
+	mergeOrigins.SetOne(origin+"maxConnections", p2Ref, p2MergeOrigins)
+}
 func mergeHttpSettings(
 	origin string,
 	p1, p2 *listenerPolicy,
@@ -147,3 +165,23 @@ func mergeHttpSettings(
 	}
 	MergeHttpPolicies(origin, p1.http, p2.http, p2Ref, p2MergeOrigins, opts, mergeOrigins, "" /*no merge settings*/)
 }
+
+func mergeTcpSettings(
+	origin string,
+	p1, p2 *listenerPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if p2.tcp == nil {
+		return
+	}
+	if p1.tcp == nil {
+		p1.tcp = &TcpListenerPolicyIr{}
+	}
+	if origin != "" {
+		origin += "tcpSettings."
+	}
+	MergeTcpPolicies(origin, p1.tcp, p2.tcp, p2Ref, p2MergeOrigins, opts, mergeOrigins)
+}
@@ -28,16 +28,25 @@ func MergeHttpPolicies(
 		mergePreserveExternalRequestId,
 		mergeGenerateRequestId,
 		mergeXffNumTrustedHops,
+		mergeSkipXffAppend,
+		mergeClientIPHeader,
 		mergeServerHeaderTransformation,
 		mergeStreamIdleTimeout,
 		mergeIdleTimeout,
 		mergeHealthCheckPolicy,
 		mergePreserveHttp1HeaderCase,
+		mergeProperCaseHttp1Headers,
 		mergeAcceptHttp10,
 		mergeDefaultHostForHttp10,
 		mergeEarlyHeaderMutation,
 		mergeMaxRequestHeadersKb,
+		mergeMaxRequestHeadersCount,
+		mergeMaxRequestsPerConnection,
+		mergeStreamErrorOnInvalidRequest,
 		mergeUuidRequestIdConfig,
+		mergeClientCertDetails,
+		mergeOutageDrill,
+		mergeLocalReply,
 	}
 	for _, mergeFunc := range mergeFuncs {
 		mergeFunc(origin, p1, p2, p2Ref, p2MergeOrigins, mergeOpts, mergeOrigins)
@@ -165,6 +174,22 @@ func mergePreserveHttp1HeaderCase(
 	mergeOrigins.SetOne(origin+"preserveHttp1HeaderCase", p2Ref, p2MergeOrigins)
 }
 
+func mergeProperCaseHttp1Headers(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.properCaseHttp1Headers, p2.properCaseHttp1Headers, opts) {
+		return
+	}
+
+	p1.properCaseHttp1Headers = p2.properCaseHttp1Headers
+	mergeOrigins.SetOne(origin+"properCaseHttp1Headers", p2Ref, p2MergeOrigins)
+}
+
 func mergeAcceptHttp10(
 	origin string,
 	p1, p2 *HttpListenerPolicyIr,
@@ -213,6 +238,38 @@ func mergeXffNumTrustedHops(
 	mergeOrigins.SetOne(origin+"xffNumTrustedHops", p2Ref, p2MergeOrigins)
 }
 
+func mergeSkipXffAppend(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.skipXffAppend, p2.skipXffAppend, opts) {
+		return
+	}
+
+	p1.skipXffAppend = p2.skipXffAppend
+	mergeOrigins.SetOne(origin+"skipXffAppend", p2Ref, p2MergeOrigins)
+}
+
+func mergeClientIPHeader(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.clientIPDetection, p2.clientIPDetection, opts) {
+		return
+	}
+
+	p1.clientIPDetection = p2.clientIPDetection
+	mergeOrigins.SetOne(origin+"clientIPHeader", p2Ref, p2MergeOrigins)
+}
+
 func mergeServerHeaderTransformation(
 	origin string,
 	p1, p2 *HttpListenerPolicyIr,
@@ -309,6 +366,54 @@ func mergeMaxRequestHeadersKb(
 	mergeOrigins.SetOne(origin+"maxRequestHeadersKb", p2Ref, p2MergeOrigins)
 }
 
+func mergeMaxRequestHeadersCount(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.maxRequestHeadersCount, p2.maxRequestHeadersCount, opts) {
+		return
+	}
+
+	p1.maxRequestHeadersCount = p2.maxRequestHeadersCount
+	mergeOrigins.SetOne(origin+"maxRequestHeadersCount", p2Ref, p2MergeOrigins)
+}
+
+func mergeMaxRequestsPerConnection(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.maxRequestsPerConnection, p2.maxRequestsPerConnection, opts) {
+		return
+	}
+
+	p1.maxRequestsPerConnection = p2.maxRequestsPerConnection
+	mergeOrigins.SetOne(origin+"maxRequestsPerConnection", p2Ref, p2MergeOrigins)
+}
+
+func mergeStreamErrorOnInvalidRequest(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.streamErrorOnInvalidRequest, p2.streamErrorOnInvalidRequest, opts) {
+		return
+	}
+
+	p1.streamErrorOnInvalidRequest = p2.streamErrorOnInvalidRequest
+	mergeOrigins.SetOne(origin+"invalidRequestAction", p2Ref, p2MergeOrigins)
+}
+
 func mergeUuidRequestIdConfig(
 	origin string,
 	p1, p2 *HttpListenerPolicyIr,
@@ -324,3 +429,53 @@ func mergeUuidRequestIdConfig(
 	p1.uuidRequestIdConfig = p2.uuidRequestIdConfig
 	mergeOrigins.SetOne(origin+"uuidRequestIdConfig", p2Ref, p2MergeOrigins)
 }
+
+func mergeClientCertDetails(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.forwardClientCertDetails, p2.forwardClientCertDetails, opts) {
+		return
+	}
+
+	// the details set in the XFCC header only make sense with the forward mode they are configured with
+	p1.forwardClientCertDetails = p2.forwardClientCertDetails
+	p1.setCurrentClientCertDetails = p2.setCurrentClientCertDetails
+	mergeOrigins.SetOne(origin+"clientCertDetails", p2Ref, p2MergeOrigins)
+}
+
+func mergeOutageDrill(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.outageDrill, p2.outageDrill, opts) {
+		return
+	}
+
+	p1.outageDrill = p2.outageDrill
+	mergeOrigins.SetOne(origin+"outageDrill", p2Ref, p2MergeOrigins)
+}
+
+func mergeLocalReply(
+	origin string,
+	p1, p2 *HttpListenerPolicyIr,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+) {
+	if !policy.IsMergeable(p1.localReplyConfig, p2.localReplyConfig, opts) {
+		return
+	}
+
+	p1.localReplyConfig = p2.localReplyConfig
+	mergeOrigins.SetOne(origin+"localReply", p2Ref, p2MergeOrigins)
+}
@@ -0,0 +1,247 @@
+package listenerpolicy
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoyextprocv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+// outageDrillClusterName is the name of the cluster without endpoints that the filters calling the drilled
+// services are pointed at while a drill is active
+const outageDrillClusterName = "kgateway_outage_drill"
+
+var (
+	// outageDrillTrigger recomputes the policies with an outage drill when a drill window starts or ends, as
+	// nothing else changes at that time
+	outageDrillTrigger = krt.NewRecomputeTrigger(true)
+
+	outageDrillTimersMu sync.Mutex
+	// outageDrillTimers are the times at which a recomputation is scheduled
+	outageDrillTimers = map[time.Time]struct{}{}
+)
+
+type outageDrillIr struct {
+	services []kgateway.OutageDrillService
+	start    time.Time
+	end      time.Time
+	// active is true when the policy was computed during the drill window
+	active bool
+}
+
+func (d *outageDrillIr) Equals(d2 *outageDrillIr) bool {
+	if d == nil || d2 == nil {
+		return d == nil && d2 == nil
+	}
+	return slices.Equal(d.services, d2.services) &&
+		d.start.Equal(d2.start) &&
+		d.end.Equal(d2.end) &&
+		d.active == d2.active
+}
+
+func (d *outageDrillIr) drills(service kgateway.OutageDrillService) bool {
+	return d != nil && d.active && slices.Contains(d.services, service)
+}
+
+// newOutageDrill converts the outage drill of the policy, and schedules the recomputation of the policy when
+// the drill window starts and ends.
+func newOutageDrill(krtctx krt.HandlerContext, drill *kgateway.OutageDrill, now time.Time) *outageDrillIr {
+	if drill == nil {
+		return nil
+	}
+
+	if krtctx != nil {
+		outageDrillTrigger.MarkDependant(krtctx)
+	}
+
+	services := slices.Clone(drill.Services)
+	slices.Sort(services)
+	start := drill.StartTime.Time
+	end := start.Add(drill.Duration.Duration)
+	scheduleOutageDrillRecompute(now, start)
+	scheduleOutageDrillRecompute(now, end)
+
+	return &outageDrillIr{
+		services: services,
+		start:    start,
+		end:      end,
+		active:   !now.Before(start) && now.Before(end),
+	}
+}
+
+func scheduleOutageDrillRecompute(now, at time.Time) {
+	if !at.After(now) {
+		return
+	}
+
+	outageDrillTimersMu.Lock()
+	defer outageDrillTimersMu.Unlock()
+	if _, ok := outageDrillTimers[at]; ok {
+		return
+	}
+	outageDrillTimers[at] = struct{}{}
+	time.AfterFunc(at.Sub(now), func() {
+		outageDrillTimersMu.Lock()
+		delete(outageDrillTimers, at)
+		outageDrillTimersMu.Unlock()
+		outageDrillTrigger.TriggerRecomputation()
+	})
+}
+
+// applyOutageDrill points the ext_authz, ext_proc and rate limit filters of the drilled services at the
+// outage drill cluster, so that their calls fail and the failure mode of each filter applies.
+// Returns true when a filter was modified, in which case the outage drill cluster must be added.
+func applyOutageDrill(drill *outageDrillIr, out *envoy_hcm.HttpConnectionManager) (bool, error) {
+	if drill == nil || !drill.active {
+		return false, nil
+	}
+
+	drilled := false
+	for _, f := range out.GetHttpFilters() {
+		typedConfig := f.GetTypedConfig()
+		if typedConfig == nil {
+			continue
+		}
+
+		var (
+			updated *anypb.Any
+			err     error
+		)
+		if typedConfig.MessageIs(&envoymatchingv3.ExtensionWithMatcher{}) {
+			updated, err = drillCompositeFilter(drill, typedConfig)
+		} else {
+			updated, err = drillFilterConfig(drill, typedConfig)
+		}
+		if err != nil {
+			return false, fmt.Errorf("outage drill: filter %s: %w", f.GetName(), err)
+		}
+		if updated != nil {
+			f.ConfigType = &envoy_hcm.HttpFilter_TypedConfig{TypedConfig: updated}
+			drilled = true
+		}
+	}
+	return drilled, nil
+}
+
+// drillCompositeFilter drills the filters executed by the composite filter wrapping the ext_authz and ext_proc
+// filters. Returns nil when no filter is drilled.
+func drillCompositeFilter(drill *outageDrillIr, typedConfig *anypb.Any) (*anypb.Any, error) {
+	ext := &envoymatchingv3.ExtensionWithMatcher{}
+	if err := typedConfig.UnmarshalTo(ext); err != nil {
+		return nil, err
+	}
+
+	drilled := false
+	for _, m := range ext.GetXdsMatcher().GetMatcherList().GetMatchers() {
+		action := m.GetOnMatch().GetAction()
+		if action == nil || !action.GetTypedConfig().MessageIs(&envoycompositev3.ExecuteFilterAction{}) {
+			continue
+		}
+		execute := &envoycompositev3.ExecuteFilterAction{}
+		if err := action.GetTypedConfig().UnmarshalTo(execute); err != nil {
+			return nil, err
+		}
+		updated, err := drillFilterConfig(drill, execute.GetTypedConfig().GetTypedConfig())
+		if err != nil {
+			return nil, err
+		}
+		if updated == nil {
+			continue
+		}
+		execute.GetTypedConfig().TypedConfig = updated
+		action.TypedConfig, err = utils.MessageToAny(execute)
+		if err != nil {
+			return nil, err
+		}
+		drilled = true
+	}
+	if !drilled {
+		return nil, nil
+	}
+	return utils.MessageToAny(ext)
+}
+
+// drillFilterConfig points the service of the filter config at the outage drill cluster.
+// Returns nil when the filter does not call a drilled service.
+func drillFilterConfig(drill *outageDrillIr, typedConfig *anypb.Any) (*anypb.Any, error) {
+	var msg proto.Message
+	switch {
+	case typedConfig.MessageIs(&envoy_ext_authz_v3.ExtAuthz{}) && drill.drills(kgateway.OutageDrillServiceExtAuth):
+		extAuth := &envoy_ext_authz_v3.ExtAuthz{}
+		if err := typedConfig.UnmarshalTo(extAuth); err != nil {
+			return nil, err
+		}
+		switch {
+		case extAuth.GetGrpcService() != nil:
+			drillGrpcService(extAuth.GetGrpcService())
+		case extAuth.GetHttpService().GetServerUri() != nil:
+			extAuth.GetHttpService().GetServerUri().HttpUpstreamType = &envoycorev3.HttpUri_Cluster{
+				Cluster: outageDrillClusterName,
+			}
+		default:
+			return nil, nil
+		}
+		msg = extAuth
+	case typedConfig.MessageIs(&envoyextprocv3.ExternalProcessor{}) && drill.drills(kgateway.OutageDrillServiceExtProc):
+		extProc := &envoyextprocv3.ExternalProcessor{}
+		if err := typedConfig.UnmarshalTo(extProc); err != nil {
+			return nil, err
+		}
+		if extProc.GetGrpcService() == nil {
+			return nil, nil
+		}
+		drillGrpcService(extProc.GetGrpcService())
+		msg = extProc
+	case typedConfig.MessageIs(&ratev3.RateLimit{}) && drill.drills(kgateway.OutageDrillServiceRateLimit):
+		rateLimit := &ratev3.RateLimit{}
+		if err := typedConfig.UnmarshalTo(rateLimit); err != nil {
+			return nil, err
+		}
+		if rateLimit.GetRateLimitService().GetGrpcService() == nil {
+			return nil, nil
+		}
+		drillGrpcService(rateLimit.GetRateLimitService().GetGrpcService())
+		msg = rateLimit
+	default:
+		return nil, nil
+	}
+	return utils.MessageToAny(msg)
+}
+
+func drillGrpcService(svc *envoycorev3.GrpcService) {
+	svc.TargetSpecifier = &envoycorev3.GrpcService_EnvoyGrpc_{
+		EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{
+			ClusterName: outageDrillClusterName,
+		},
+	}
+}
+
+// buildOutageDrillCluster returns the cluster without endpoints that the drilled services are pointed at.
+func buildOutageDrillCluster() *envoyclusterv3.Cluster {
+	return &envoyclusterv3.Cluster{
+		Name: outageDrillClusterName,
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{
+			Type: envoyclusterv3.Cluster_STATIC,
+		},
+		LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+			ClusterName: outageDrillClusterName,
+			Endpoints:   []*envoyendpointv3.LocalityLbEndpoints{},
+		},
+	}
+}
@@ -0,0 +1,180 @@
+package listenerpolicy
+
+import (
+	"testing"
+	"time"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	envoy_ext_authz_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	envoyextprocv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
+	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+)
+
+func grpcService(cluster string) *envoycorev3.GrpcService {
+	return &envoycorev3.GrpcService{
+		TargetSpecifier: &envoycorev3.GrpcService_EnvoyGrpc_{
+			EnvoyGrpc: &envoycorev3.GrpcService_EnvoyGrpc{ClusterName: cluster},
+		},
+	}
+}
+
+// compositeFilter wraps the filter config the way the ext_authz and ext_proc filters are added to the chain
+func compositeFilter(name string, typedConfig *envoycorev3.TypedExtensionConfig) *envoy_hcm.HttpFilter {
+	return &envoy_hcm.HttpFilter{
+		Name: name,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: utils.MustMessageToAny(&envoymatchingv3.ExtensionWithMatcher{
+				ExtensionConfig: &envoycorev3.TypedExtensionConfig{
+					Name:        name,
+					TypedConfig: utils.MustMessageToAny(&envoycompositev3.Composite{}),
+				},
+				XdsMatcher: &xdsmatcherv3.Matcher{
+					MatcherType: &xdsmatcherv3.Matcher_MatcherList_{
+						MatcherList: &xdsmatcherv3.Matcher_MatcherList{
+							Matchers: []*xdsmatcherv3.Matcher_MatcherList_FieldMatcher{{
+								OnMatch: &xdsmatcherv3.Matcher_OnMatch{
+									OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+										Action: &xdscorev3.TypedExtensionConfig{
+											Name: "composite-action",
+											TypedConfig: utils.MustMessageToAny(&envoycompositev3.ExecuteFilterAction{
+												TypedConfig: typedConfig,
+											}),
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			}),
+		},
+	}
+}
+
+// executedFilterConfig unmarshals the config of the filter executed by the composite filter
+func executedFilterConfig(t *testing.T, f *envoy_hcm.HttpFilter, out proto.Message) {
+	t.Helper()
+	ext := &envoymatchingv3.ExtensionWithMatcher{}
+	require.NoError(t, f.GetTypedConfig().UnmarshalTo(ext))
+	execute := &envoycompositev3.ExecuteFilterAction{}
+	require.NoError(t, ext.GetXdsMatcher().GetMatcherList().GetMatchers()[0].GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(execute))
+	require.NoError(t, execute.GetTypedConfig().GetTypedConfig().UnmarshalTo(out))
+}
+
+func TestNewOutageDrill(t *testing.T) {
+	now := time.Now()
+	drill := func(start time.Time) *kgateway.OutageDrill {
+		return &kgateway.OutageDrill{
+			Services:  []kgateway.OutageDrillService{kgateway.OutageDrillServiceRateLimit, kgateway.OutageDrillServiceExtAuth},
+			StartTime: metav1.NewTime(start),
+			Duration:  metav1.Duration{Duration: time.Hour},
+		}
+	}
+
+	assert.Nil(t, newOutageDrill(nil, nil, now))
+
+	active := newOutageDrill(nil, drill(now.Add(-time.Minute)), now)
+	assert.True(t, active.active)
+	assert.Equal(t, []kgateway.OutageDrillService{kgateway.OutageDrillServiceExtAuth, kgateway.OutageDrillServiceRateLimit}, active.services)
+	assert.True(t, active.drills(kgateway.OutageDrillServiceExtAuth))
+	assert.False(t, active.drills(kgateway.OutageDrillServiceExtProc))
+
+	upcoming := newOutageDrill(nil, drill(now.Add(time.Minute)), now)
+	assert.False(t, upcoming.active)
+	assert.False(t, upcoming.drills(kgateway.OutageDrillServiceExtAuth))
+
+	ended := newOutageDrill(nil, drill(now.Add(-2*time.Hour)), now)
+	assert.False(t, ended.active)
+
+	// the policy is recomputed when the drill starts, which must be seen as a change
+	assert.False(t, upcoming.Equals(newOutageDrill(nil, drill(now.Add(time.Minute)), now.Add(2*time.Minute))))
+	assert.True(t, upcoming.Equals(newOutageDrill(nil, drill(now.Add(time.Minute)), now)))
+}
+
+func TestApplyOutageDrill(t *testing.T) {
+	newHcm := func() *envoy_hcm.HttpConnectionManager {
+		return &envoy_hcm.HttpConnectionManager{
+			HttpFilters: []*envoy_hcm.HttpFilter{
+				compositeFilter("ext_authz/default/auth", &envoycorev3.TypedExtensionConfig{
+					Name: "envoy.filters.http.ext_authz",
+					TypedConfig: utils.MustMessageToAny(&envoy_ext_authz_v3.ExtAuthz{
+						Services: &envoy_ext_authz_v3.ExtAuthz_GrpcService{GrpcService: grpcService("auth")},
+					}),
+				}),
+				compositeFilter("ext_proc/default/proc", &envoycorev3.TypedExtensionConfig{
+					Name: "envoy.filters.http.ext_proc",
+					TypedConfig: utils.MustMessageToAny(&envoyextprocv3.ExternalProcessor{
+						GrpcService: grpcService("proc"),
+					}),
+				}),
+				{
+					Name: "ratelimit/default/rl",
+					ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+						TypedConfig: utils.MustMessageToAny(&ratev3.RateLimit{
+							Domain: "rl",
+							RateLimitService: &envoyratelimitv3.RateLimitServiceConfig{
+								GrpcService: grpcService("rl"),
+							},
+						}),
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("inactive drill", func(t *testing.T) {
+		hcm := newHcm()
+		drilled, err := applyOutageDrill(&outageDrillIr{services: []kgateway.OutageDrillService{kgateway.OutageDrillServiceExtAuth}}, hcm)
+		require.NoError(t, err)
+		assert.False(t, drilled)
+		assert.True(t, proto.Equal(newHcm(), hcm))
+	})
+
+	t.Run("active drill", func(t *testing.T) {
+		hcm := newHcm()
+		drilled, err := applyOutageDrill(&outageDrillIr{
+			services: []kgateway.OutageDrillService{kgateway.OutageDrillServiceExtAuth, kgateway.OutageDrillServiceRateLimit},
+			active:   true,
+		}, hcm)
+		require.NoError(t, err)
+		assert.True(t, drilled)
+
+		extAuth := &envoy_ext_authz_v3.ExtAuthz{}
+		executedFilterConfig(t, hcm.GetHttpFilters()[0], extAuth)
+		assert.Equal(t, outageDrillClusterName, extAuth.GetGrpcService().GetEnvoyGrpc().GetClusterName())
+
+		// ext_proc is not part of the drill
+		extProc := &envoyextprocv3.ExternalProcessor{}
+		executedFilterConfig(t, hcm.GetHttpFilters()[1], extProc)
+		assert.Equal(t, "proc", extProc.GetGrpcService().GetEnvoyGrpc().GetClusterName())
+
+		rateLimit := &ratev3.RateLimit{}
+		require.NoError(t, hcm.GetHttpFilters()[2].GetTypedConfig().UnmarshalTo(rateLimit))
+		assert.Equal(t, outageDrillClusterName, rateLimit.GetRateLimitService().GetGrpcService().GetEnvoyGrpc().GetClusterName())
+		assert.Equal(t, "rl", rateLimit.GetDomain())
+	})
+}
+
+func TestOutageDrillCluster(t *testing.T) {
+	pass := &listenerPolicyPluginGwPass{}
+	assert.Empty(t, pass.ResourcesToAdd().Clusters)
+
+	pass.outageDrillActive = true
+	clusters := pass.ResourcesToAdd().Clusters
+	require.Len(t, clusters, 1)
+	assert.Equal(t, outageDrillClusterName, clusters[0].GetName())
+	assert.Empty(t, clusters[0].GetLoadAssignment().GetEndpoints())
+}
@@ -0,0 +1,106 @@
+package listenerpolicy
+
+import (
+	"reflect"
+	"slices"
+
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+type TcpListenerPolicyIr struct {
+	// As for HTTP listeners, the access log configs and policies are stored so that the default serviceName
+	// can be set during translation.
+	accessLogConfig   []proto.Message
+	accessLogPolicies []kgateway.AccessLog
+	accessLogOptions  *envoytcp.TcpProxy_TcpAccessLogOptions
+
+	idleTimeout           *durationpb.Duration
+	maxConnectionDuration *durationpb.Duration
+	connectionRateLimit   *typev3.TokenBucket
+}
+
+func (d *TcpListenerPolicyIr) Equals(in any) bool {
+	d2, ok := in.(*TcpListenerPolicyIr)
+	if !ok {
+		return false
+	}
+
+	if !slices.EqualFunc(d.accessLogConfig, d2.accessLogConfig, func(log proto.Message, log2 proto.Message) bool {
+		return proto.Equal(log, log2)
+	}) {
+		return false
+	}
+	if !slices.EqualFunc(d.accessLogPolicies, d2.accessLogPolicies, func(log kgateway.AccessLog, log2 kgateway.AccessLog) bool {
+		return reflect.DeepEqual(log, log2)
+	}) {
+		return false
+	}
+
+	return proto.Equal(d.accessLogOptions, d2.accessLogOptions) &&
+		proto.Equal(d.idleTimeout, d2.idleTimeout) &&
+		proto.Equal(d.maxConnectionDuration, d2.maxConnectionDuration) &&
+		proto.Equal(d.connectionRateLimit, d2.connectionRateLimit)
+}
+
+func NewTcpListenerPolicy(krtctx krt.HandlerContext, commoncol *collections.CommonCollections, t *kgateway.TCPSettings, objSrc ir.ObjectSource) (*TcpListenerPolicyIr, []error) {
+	if t == nil {
+		return nil, nil
+	}
+	errs := []error{}
+	accessLog, err := convertAccessLogConfig(t.AccessLog, commoncol, krtctx, objSrc)
+	if err != nil {
+		logger.Error("error translating tcp access log", "error", err)
+		errs = append(errs, err)
+	}
+
+	out := &TcpListenerPolicyIr{
+		accessLogConfig:     accessLog,
+		accessLogPolicies:   t.AccessLog,
+		accessLogOptions:    convertTcpAccessLogOptions(t.AccessLogOptions),
+		connectionRateLimit: convertConnectionRateLimit(t.ConnectionRateLimit),
+	}
+	if t.IdleTimeout != nil {
+		out.idleTimeout = durationpb.New(t.IdleTimeout.Duration)
+	}
+	if t.MaxConnectionDuration != nil {
+		out.maxConnectionDuration = durationpb.New(t.MaxConnectionDuration.Duration)
+	}
+	return out, errs
+}
+
+func convertTcpAccessLogOptions(in *kgateway.TCPAccessLogOptions) *envoytcp.TcpProxy_TcpAccessLogOptions {
+	if in == nil {
+		return nil
+	}
+	out := &envoytcp.TcpProxy_TcpAccessLogOptions{
+		FlushAccessLogOnConnected: ptr.Deref(in.FlushOnConnected, false),
+	}
+	if in.FlushInterval != nil {
+		out.AccessLogFlushInterval = durationpb.New(in.FlushInterval.Duration)
+	}
+	return out
+}
+
+func convertConnectionRateLimit(in *kgateway.TokenBucket) *typev3.TokenBucket {
+	if in == nil {
+		return nil
+	}
+	out := &typev3.TokenBucket{
+		MaxTokens:    uint32(in.MaxTokens), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		FillInterval: durationpb.New(in.FillInterval.Duration),
+	}
+	if in.TokensPerFill != nil {
+		out.TokensPerFill = wrapperspb.UInt32(uint32(*in.TokensPerFill)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	return out
+}
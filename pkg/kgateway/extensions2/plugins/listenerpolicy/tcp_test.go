@@ -0,0 +1,98 @@
+package listenerpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoyalfile "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/file/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyTcpProxy(t *testing.T) {
+	accessLogs := []kgateway.AccessLog{
+		{
+			FileSink: &kgateway.FileSink{Path: "/dev/stdout"},
+		},
+		{
+			FileSink: &kgateway.FileSink{Path: "/dev/stderr", StringFormat: ptr.To("%BYTES_SENT%\n")},
+			Filter: &kgateway.AccessLogFilter{
+				FilterType: &kgateway.FilterType{
+					DurationFilter: &kgateway.DurationFilter{Op: kgateway.GE, Value: 1000},
+				},
+			},
+		},
+	}
+	cfgs, err := translateAccessLogs(accessLogs, nil)
+	require.NoError(t, err)
+
+	pol := &ListenerPolicyIR{
+		defaultPolicy: listenerPolicy{
+			tcp: &TcpListenerPolicyIr{
+				accessLogConfig:   cfgs,
+				accessLogPolicies: accessLogs,
+				accessLogOptions: convertTcpAccessLogOptions(&kgateway.TCPAccessLogOptions{
+					FlushOnConnected: ptr.To(true),
+					FlushInterval:    &metav1.Duration{Duration: 30 * time.Second},
+				}),
+			},
+		},
+	}
+
+	pass := &listenerPolicyPluginGwPass{}
+	out := &envoytcp.TcpProxy{}
+	err = pass.ApplyTcpProxy(&ir.TcpProxyContext{
+		ListenerPort: 9000,
+		Policy:       pol,
+		Gateway: ir.GatewayIR{
+			SourceObject: &ir.Gateway{
+				ObjectSource: ir.ObjectSource{
+					Name:      "gw",
+					Namespace: "default",
+				},
+			},
+		},
+	}, out)
+	require.NoError(t, err)
+
+	require.Len(t, out.GetAccessLog(), 2)
+
+	defaultFormat := &envoyalfile.FileAccessLog{}
+	require.NoError(t, out.GetAccessLog()[0].GetTypedConfig().UnmarshalTo(defaultFormat))
+	assert.Equal(t, DefaultTcpAccessLogFormat, defaultFormat.GetLogFormat().GetTextFormatSource().GetInlineString())
+	// the default format must not leak into the policy IR
+	assert.Nil(t, cfgs[0].(*envoyalfile.FileAccessLog).GetAccessLogFormat())
+
+	customFormat := &envoyalfile.FileAccessLog{}
+	require.NoError(t, out.GetAccessLog()[1].GetTypedConfig().UnmarshalTo(customFormat))
+	assert.Equal(t, "%BYTES_SENT%\n", customFormat.GetLogFormat().GetTextFormatSource().GetInlineString())
+	assert.NotNil(t, out.GetAccessLog()[1].GetFilter().GetDurationFilter())
+
+	assert.True(t, out.GetAccessLogOptions().GetFlushAccessLogOnConnected())
+	assert.Equal(t, 30*time.Second, out.GetAccessLogOptions().GetAccessLogFlushInterval().AsDuration())
+}
+
+func TestApplyTcpProxyTimeouts(t *testing.T) {
+	tcp, errs := NewTcpListenerPolicy(nil, nil, &kgateway.TCPSettings{
+		IdleTimeout:           &metav1.Duration{Duration: 5 * time.Minute},
+		MaxConnectionDuration: &metav1.Duration{Duration: time.Hour},
+	}, ir.ObjectSource{})
+	require.Empty(t, errs)
+
+	pass := &listenerPolicyPluginGwPass{}
+	out := &envoytcp.TcpProxy{}
+	err := pass.ApplyTcpProxy(&ir.TcpProxyContext{
+		ListenerPort: 9000,
+		Policy:       &ListenerPolicyIR{defaultPolicy: listenerPolicy{tcp: tcp}},
+	}, out)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5*time.Minute, out.GetIdleTimeout().AsDuration())
+	assert.Equal(t, time.Hour, out.GetMaxDownstreamConnectionDuration().AsDuration())
+}
@@ -1,7 +1,11 @@
 package listenerpolicy
 
 import (
+	"errors"
 	"fmt"
+	"net"
+	"strconv"
+	"time"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoytracev3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
@@ -11,8 +15,12 @@ import (
 	metadatav3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
 	tracingv3 "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
@@ -20,6 +28,12 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 )
 
+const (
+	defaultOTelHttpPath            = "/v1/traces"
+	defaultOTelHttpTimeout         = 10 * time.Second
+	defaultZipkinCollectorEndpoint = "/api/v2/spans"
+)
+
 func convertTracingConfig(
 	policy *kgateway.HTTPSettings,
 	commoncol *collections.CommonCollections,
@@ -31,7 +45,11 @@ func convertTracingConfig(
 		return nil, nil, nil
 	}
 
-	backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, config.Provider.OpenTelemetry.GrpcService.BackendRef.BackendObjectReference)
+	backendRef, err := tracingBackendRef(config.Provider)
+	if err != nil {
+		return nil, nil, err
+	}
+	backend, err := commoncol.BackendIndex.GetBackendFromRef(krtctx, parentSrc, backendRef.BackendObjectReference)
 	if err != nil {
 		return nil, nil, fmt.Errorf("%w: %v", ErrUnresolvedBackendRef, err)
 	}
@@ -39,17 +57,44 @@ func convertTracingConfig(
 	return translateTracing(config, backend)
 }
 
+// tracingBackendRef returns the reference to the backend the provider sends the traces to.
+func tracingBackendRef(provider kgateway.TracingProvider) (*gwv1.BackendRef, error) {
+	switch {
+	case provider.OpenTelemetry != nil && provider.OpenTelemetry.GrpcService != nil:
+		return &provider.OpenTelemetry.GrpcService.BackendRef, nil
+	case provider.OpenTelemetry != nil && provider.OpenTelemetry.HttpService != nil:
+		return &provider.OpenTelemetry.HttpService.BackendRef, nil
+	case provider.Zipkin != nil:
+		return &provider.Zipkin.BackendRef, nil
+	case provider.Datadog != nil:
+		return &provider.Datadog.BackendRef, nil
+	default:
+		return nil, errors.New("no tracing provider specified")
+	}
+}
+
 func translateTracing(
 	config *kgateway.Tracing,
 	backend *ir.BackendObjectIR,
-) (*envoytracev3.OpenTelemetryConfig, *envoy_hcm.HttpConnectionManager_Tracing, error) {
+) (proto.Message, *envoy_hcm.HttpConnectionManager_Tracing, error) {
 	if config == nil {
 		return nil, nil, nil
 	}
 
-	provider, err := convertOTelTracingConfig(config.Provider.OpenTelemetry, backend)
-	if err != nil {
-		return nil, nil, err
+	var provider proto.Message
+	switch {
+	case config.Provider.OpenTelemetry != nil:
+		otelProvider, err := convertOTelTracingConfig(config.Provider.OpenTelemetry, backend)
+		if err != nil {
+			return nil, nil, err
+		}
+		provider = otelProvider
+	case config.Provider.Zipkin != nil:
+		provider = convertZipkinTracingConfig(config.Provider.Zipkin, backend)
+	case config.Provider.Datadog != nil:
+		provider = convertDatadogTracingConfig(config.Provider.Datadog, backend)
+	default:
+		return nil, nil, errors.New("no tracing provider specified")
 	}
 
 	tracingConfig := &envoy_hcm.HttpConnectionManager_Tracing{}
@@ -183,6 +228,16 @@ func translateTracing(
 				}
 				continue
 			}
+
+			if ct.CEL != nil {
+				tracingConfig.GetCustomTags()[i] = &tracingv3.CustomTag{
+					Tag: ct.Name,
+					Type: &tracingv3.CustomTag_Value{
+						Value: fmt.Sprintf("%%CEL(%s)%%", ct.CEL.Expression),
+					},
+				}
+				continue
+			}
 		}
 	}
 	if config.SpawnUpstreamSpan != nil {
@@ -202,13 +257,16 @@ func convertOTelTracingConfig(
 		return nil, nil
 	}
 
-	envoyGrpcService, err := ToEnvoyGrpc(config.GrpcService, backend)
-	if err != nil {
-		return nil, err
-	}
-
-	tracingCfg := &envoytracev3.OpenTelemetryConfig{
-		GrpcService: envoyGrpcService,
+	tracingCfg := &envoytracev3.OpenTelemetryConfig{}
+	switch {
+	case config.GrpcService != nil:
+		envoyGrpcService, err := ToEnvoyGrpc(*config.GrpcService, backend)
+		if err != nil {
+			return nil, err
+		}
+		tracingCfg.GrpcService = envoyGrpcService
+	case config.HttpService != nil:
+		tracingCfg.HttpService = convertOTelHttpService(config.HttpService, backend)
 	}
 	if config.ServiceName != nil {
 		tracingCfg.ServiceName = *config.ServiceName
@@ -240,19 +298,108 @@ func convertOTelTracingConfig(
 	return tracingCfg, nil
 }
 
-func updateTracingConfig(pCtx *ir.HcmContext, tracingProvider *envoytracev3.OpenTelemetryConfig, tracingConfig *envoy_hcm.HttpConnectionManager_Tracing) {
+func convertOTelHttpService(
+	config *kgateway.OpenTelemetryHttpService,
+	backend *ir.BackendObjectIR,
+) *envoycorev3.HttpService {
+	timeout := defaultOTelHttpTimeout
+	if config.Timeout != nil {
+		timeout = config.Timeout.Duration
+	}
+
+	httpService := &envoycorev3.HttpService{
+		HttpUri: &envoycorev3.HttpUri{
+			// the URI sets the :authority and :path of the export requests, which are routed to the cluster
+			Uri: "http://" + collectorHostname(backend) + ptr.Deref(config.Path, defaultOTelHttpPath),
+			HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+				Cluster: backend.ClusterName(),
+			},
+			Timeout: durationpb.New(timeout),
+		},
+	}
+	for _, header := range config.RequestHeaders {
+		httpService.RequestHeadersToAdd = append(httpService.GetRequestHeadersToAdd(), &envoycorev3.HeaderValueOption{
+			Header: &envoycorev3.HeaderValue{
+				Key:   header.Key,
+				Value: ptr.Deref(header.Value, ""),
+			},
+		})
+	}
+	return httpService
+}
+
+func convertZipkinTracingConfig(
+	config *kgateway.ZipkinTracingConfig,
+	backend *ir.BackendObjectIR,
+) *envoytracev3.ZipkinConfig {
+	version := envoytracev3.ZipkinConfig_HTTP_JSON
+	if ptr.Deref(config.Encoding, kgateway.ZipkinEncodingJSON) == kgateway.ZipkinEncodingProto {
+		version = envoytracev3.ZipkinConfig_HTTP_PROTO
+	}
+
+	tracingCfg := &envoytracev3.ZipkinConfig{
+		CollectorCluster:         backend.ClusterName(),
+		CollectorEndpoint:        ptr.Deref(config.CollectorEndpoint, defaultZipkinCollectorEndpoint),
+		CollectorEndpointVersion: version,
+		CollectorHostname:        collectorHostname(backend),
+		TraceId_128Bit:           ptr.Deref(config.TraceID128Bit, false),
+	}
+	if config.SharedSpanContext != nil {
+		tracingCfg.SharedSpanContext = wrapperspb.Bool(*config.SharedSpanContext)
+	}
+	return tracingCfg
+}
+
+func convertDatadogTracingConfig(
+	config *kgateway.DatadogTracingConfig,
+	backend *ir.BackendObjectIR,
+) *envoytracev3.DatadogConfig {
+	return &envoytracev3.DatadogConfig{
+		CollectorCluster:  backend.ClusterName(),
+		CollectorHostname: collectorHostname(backend),
+		// the default service name is set during translation, as it depends on the gateway
+		ServiceName: ptr.Deref(config.ServiceName, ""),
+	}
+}
+
+// collectorHostname returns the host of the collector, sent in the :authority header of the requests
+// exporting the traces.
+func collectorHostname(backend *ir.BackendObjectIR) string {
+	host := backend.CanonicalHostname
+	if host == "" {
+		host = backend.ClusterName()
+	}
+	if backend.Port == 0 {
+		return host
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(backend.Port)))
+}
+
+func updateTracingConfig(pCtx *ir.HcmContext, tracingProvider proto.Message, tracingConfig *envoy_hcm.HttpConnectionManager_Tracing) {
 	if tracingProvider == nil || tracingConfig == nil {
 		return
 	}
-	if tracingProvider.ServiceName == "" {
-		tracingProvider.ServiceName = GenerateDefaultServiceName(pCtx.Gateway.SourceObject.GetName(), pCtx.Gateway.SourceObject.GetNamespace())
+
+	var name string
+	switch provider := tracingProvider.(type) {
+	case *envoytracev3.OpenTelemetryConfig:
+		name = "envoy.tracers.opentelemetry"
+		if provider.ServiceName == "" {
+			provider.ServiceName = GenerateDefaultServiceName(pCtx.Gateway.SourceObject.GetName(), pCtx.Gateway.SourceObject.GetNamespace())
+		}
+	case *envoytracev3.ZipkinConfig:
+		name = "envoy.tracers.zipkin"
+	case *envoytracev3.DatadogConfig:
+		name = "envoy.tracers.datadog"
+		if provider.ServiceName == "" {
+			provider.ServiceName = GenerateDefaultServiceName(pCtx.Gateway.SourceObject.GetName(), pCtx.Gateway.SourceObject.GetNamespace())
+		}
 	}
-	otelCfg := utils.MustMessageToAny(tracingProvider)
 
 	tracingConfig.Provider = &envoytracev3.Tracing_Http{
-		Name: "envoy.tracers.opentelemetry",
+		Name: name,
 		ConfigType: &envoytracev3.Tracing_Http_TypedConfig{
-			TypedConfig: otelCfg,
+			TypedConfig: utils.MustMessageToAny(tracingProvider),
 		},
 	}
 }
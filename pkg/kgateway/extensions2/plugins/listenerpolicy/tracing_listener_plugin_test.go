@@ -3,6 +3,7 @@ package listenerpolicy
 import (
 	"context"
 	"testing"
+	"time"
 
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoytracev3 "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
@@ -15,6 +16,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -40,7 +42,7 @@ func TestTracingConverter(t *testing.T) {
 				config: &kgateway.Tracing{
 					Provider: kgateway.TracingProvider{
 						OpenTelemetry: &kgateway.OpenTelemetryTracingConfig{
-							GrpcService: kgateway.CommonGrpcService{
+							GrpcService: &kgateway.CommonGrpcService{
 								BackendRef: gwv1.BackendRef{
 									BackendObjectReference: gwv1.BackendObjectReference{
 										Name: "test-service",
@@ -73,7 +75,7 @@ func TestTracingConverter(t *testing.T) {
 				config: &kgateway.Tracing{
 					Provider: kgateway.TracingProvider{
 						OpenTelemetry: &kgateway.OpenTelemetryTracingConfig{
-							GrpcService: kgateway.CommonGrpcService{
+							GrpcService: &kgateway.CommonGrpcService{
 								BackendRef: gwv1.BackendRef{
 									BackendObjectReference: gwv1.BackendObjectReference{
 										Name: "test-service",
@@ -107,7 +109,7 @@ func TestTracingConverter(t *testing.T) {
 				config: &kgateway.Tracing{
 					Provider: kgateway.TracingProvider{
 						OpenTelemetry: &kgateway.OpenTelemetryTracingConfig{
-							GrpcService: kgateway.CommonGrpcService{
+							GrpcService: &kgateway.CommonGrpcService{
 								BackendRef: gwv1.BackendRef{
 									BackendObjectReference: gwv1.BackendObjectReference{
 										Name: "test-service",
@@ -142,7 +144,7 @@ func TestTracingConverter(t *testing.T) {
 				config: &kgateway.Tracing{
 					Provider: kgateway.TracingProvider{
 						OpenTelemetry: &kgateway.OpenTelemetryTracingConfig{
-							GrpcService: kgateway.CommonGrpcService{
+							GrpcService: &kgateway.CommonGrpcService{
 								BackendRef: gwv1.BackendRef{
 									BackendObjectReference: gwv1.BackendObjectReference{
 										Name: "test-service",
@@ -234,6 +236,12 @@ func TestTracingConverter(t *testing.T) {
 								},
 							},
 						},
+						{
+							Name: "CEL",
+							CEL: &kgateway.CustomAttributeCEL{
+								Expression: "request.headers['x-tenant-id']",
+							},
+						},
 					},
 					SpawnUpstreamSpan: ptr.To(true),
 				},
@@ -376,10 +384,118 @@ func TestTracingConverter(t *testing.T) {
 								},
 							},
 						},
+						{
+							Tag: "CEL",
+							Type: &tracingv3.CustomTag_Value{
+								Value: "%CEL(request.headers['x-tenant-id'])%",
+							},
+						},
 					},
 					SpawnUpstreamSpan: &wrapperspb.BoolValue{Value: true},
 				},
 			},
+			{
+				name: "OTel Tracing over HTTP",
+				config: &kgateway.Tracing{
+					Provider: kgateway.TracingProvider{
+						OpenTelemetry: &kgateway.OpenTelemetryTracingConfig{
+							HttpService: &kgateway.OpenTelemetryHttpService{
+								BackendRef: gwv1.BackendRef{
+									BackendObjectReference: gwv1.BackendObjectReference{
+										Name: "test-service",
+									},
+								},
+								RequestHeaders: []kgateway.HeaderValue{
+									{Key: "authorization", Value: ptr.To("Bearer token")},
+								},
+							},
+						},
+					},
+				},
+				expected: &envoy_hcm.HttpConnectionManager_Tracing{
+					Provider: &envoytracev3.Tracing_Http{
+						Name: "envoy.tracers.opentelemetry",
+						ConfigType: &envoytracev3.Tracing_Http_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &envoytracev3.OpenTelemetryConfig{
+								HttpService: &envoycorev3.HttpService{
+									HttpUri: &envoycorev3.HttpUri{
+										Uri: "http://backend_default_test-service_0/v1/traces",
+										HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+											Cluster: "backend_default_test-service_0",
+										},
+										Timeout: durationpb.New(10 * time.Second),
+									},
+									RequestHeadersToAdd: []*envoycorev3.HeaderValueOption{{
+										Header: &envoycorev3.HeaderValue{
+											Key:   "authorization",
+											Value: "Bearer token",
+										},
+									}},
+								},
+								ServiceName: "gw.default",
+							}),
+						},
+					},
+				},
+			},
+			{
+				name: "Zipkin Tracing",
+				config: &kgateway.Tracing{
+					Provider: kgateway.TracingProvider{
+						Zipkin: &kgateway.ZipkinTracingConfig{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "test-service",
+								},
+							},
+							Encoding:          ptr.To(kgateway.ZipkinEncodingProto),
+							TraceID128Bit:     ptr.To(true),
+							SharedSpanContext: ptr.To(false),
+						},
+					},
+				},
+				expected: &envoy_hcm.HttpConnectionManager_Tracing{
+					Provider: &envoytracev3.Tracing_Http{
+						Name: "envoy.tracers.zipkin",
+						ConfigType: &envoytracev3.Tracing_Http_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &envoytracev3.ZipkinConfig{
+								CollectorCluster:         "backend_default_test-service_0",
+								CollectorEndpoint:        "/api/v2/spans",
+								CollectorEndpointVersion: envoytracev3.ZipkinConfig_HTTP_PROTO,
+								CollectorHostname:        "backend_default_test-service_0",
+								TraceId_128Bit:           true,
+								SharedSpanContext:        wrapperspb.Bool(false),
+							}),
+						},
+					},
+				},
+			},
+			{
+				name: "Datadog Tracing",
+				config: &kgateway.Tracing{
+					Provider: kgateway.TracingProvider{
+						Datadog: &kgateway.DatadogTracingConfig{
+							BackendRef: gwv1.BackendRef{
+								BackendObjectReference: gwv1.BackendObjectReference{
+									Name: "test-service",
+								},
+							},
+						},
+					},
+				},
+				expected: &envoy_hcm.HttpConnectionManager_Tracing{
+					Provider: &envoytracev3.Tracing_Http{
+						Name: "envoy.tracers.datadog",
+						ConfigType: &envoytracev3.Tracing_Http_TypedConfig{
+							TypedConfig: mustMessageToAny(t, &envoytracev3.DatadogConfig{
+								CollectorCluster:  "backend_default_test-service_0",
+								CollectorHostname: "backend_default_test-service_0",
+								ServiceName:       "gw.default",
+							}),
+						},
+					},
+				},
+			},
 		}
 		for _, tc := range testCases {
 			_, cancel := context.WithCancel(context.Background())
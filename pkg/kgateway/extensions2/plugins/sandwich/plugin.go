@@ -114,7 +114,7 @@ func (s *sandwichedTranslationPass) ApplyListenerPlugin(pCtx *ir.ListenerContext
 // the identity validated by zTunnel readable from Istio RBAC filters.
 // It does this by passing the TLV from PROXY Protocol into filter_state that
 // Istio's RBAC will read from.
-func (s *sandwichedTranslationPass) NetworkFilters() ([]filters.StagedNetworkFilter, error) {
+func (s *sandwichedTranslationPass) NetworkFilters(ir.NetworkFiltersContext) ([]filters.StagedNetworkFilter, error) {
 	if !s.isSandwiched {
 		return nil, nil
 	}
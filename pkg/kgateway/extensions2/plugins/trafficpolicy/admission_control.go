@@ -0,0 +1,152 @@
+package trafficpolicy
+
+import (
+	"strconv"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	admissioncontrolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	admissionControlFilterName = "envoy.filters.http.admission_control"
+
+	admissionControlSuccessRateThresholdRuntimeKey    = "admission_control.sr_threshold"
+	admissionControlAggressionRuntimeKey              = "admission_control.aggression"
+	admissionControlRpsThresholdRuntimeKey            = "admission_control.rps_threshold"
+	admissionControlMaxRejectionProbabilityRuntimeKey = "admission_control.max_rejection_probability"
+)
+
+type admissionControlIR struct {
+	// config is the admission control filter config, shared by all the routes of a filter chain
+	config  *admissioncontrolv3.AdmissionControl
+	disable bool
+}
+
+var _ PolicySubIR = &admissionControlIR{}
+
+func (a *admissionControlIR) Equals(other PolicySubIR) bool {
+	otherAdmissionControl, ok := other.(*admissionControlIR)
+	if !ok {
+		return false
+	}
+	if a == nil || otherAdmissionControl == nil {
+		return a == nil && otherAdmissionControl == nil
+	}
+	if a.disable != otherAdmissionControl.disable {
+		return false
+	}
+	return proto.Equal(a.config, otherAdmissionControl.config)
+}
+
+func (a *admissionControlIR) Validate() error {
+	if a == nil || a.config == nil {
+		return nil
+	}
+	return a.config.Validate()
+}
+
+// constructAdmissionControl constructs the admission control policy IR from the policy specification.
+func constructAdmissionControl(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.AdmissionControl == nil {
+		return nil
+	}
+
+	if spec.AdmissionControl.Disable != nil {
+		out.admissionControl = &admissionControlIR{
+			disable: true,
+		}
+		return nil
+	}
+
+	in := spec.AdmissionControl
+	config := &admissioncontrolv3.AdmissionControl{
+		// the default criteria consider 5xx HTTP statuses and error gRPC statuses as failures
+		EvaluationCriteria: &admissioncontrolv3.AdmissionControl_SuccessCriteria_{
+			SuccessCriteria: &admissioncontrolv3.AdmissionControl_SuccessCriteria{},
+		},
+	}
+	if in.SamplingWindow != nil {
+		config.SamplingWindow = durationpb.New(in.SamplingWindow.Duration)
+	}
+	if in.SuccessRateThreshold != nil {
+		config.SrThreshold = &envoycorev3.RuntimePercent{
+			DefaultValue: &typev3.Percent{Value: float64(*in.SuccessRateThreshold)},
+			RuntimeKey:   admissionControlSuccessRateThresholdRuntimeKey,
+		}
+	}
+	if in.Aggression != nil {
+		aggression, err := strconv.ParseFloat(*in.Aggression, 64)
+		if err != nil {
+			// This should ideally not happen due to CRD validation
+			logger.Error("error parsing admissionControl.aggression", "error", err)
+		} else {
+			config.Aggression = &envoycorev3.RuntimeDouble{
+				DefaultValue: aggression,
+				RuntimeKey:   admissionControlAggressionRuntimeKey,
+			}
+		}
+	}
+	if in.RpsThreshold != nil {
+		config.RpsThreshold = &envoycorev3.RuntimeUInt32{
+			DefaultValue: uint32(*in.RpsThreshold), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+			RuntimeKey:   admissionControlRpsThresholdRuntimeKey,
+		}
+	}
+	if in.MaxRejectionProbability != nil {
+		config.MaxRejectionProbability = &envoycorev3.RuntimePercent{
+			DefaultValue: &typev3.Percent{Value: float64(*in.MaxRejectionProbability)},
+			RuntimeKey:   admissionControlMaxRejectionProbabilityRuntimeKey,
+		}
+	}
+
+	out.admissionControl = &admissionControlIR{
+		config: config,
+	}
+	return nil
+}
+
+// handleAdmissionControl enables the admission control filter for the route or virtual host and registers the
+// disabled admission control filter in the filter chain. The filter does not support per-route configuration,
+// so the first config registered for a filter chain is used for all of its routes, which share its success rate;
+// the conflicts are reported in the status of the policies by reportFilterChainConflicts.
+func (p *trafficPolicyPluginGwPass) handleAdmissionControl(
+	fcn string,
+	pCtxTypedFilterConfig *ir.TypedFilterConfigMap,
+	admissionControl *admissionControlIR,
+) {
+	if admissionControl == nil {
+		return
+	}
+
+	// Handle disable case - disable the filter to override parent policy
+	if admissionControl.disable {
+		pCtxTypedFilterConfig.AddTypedConfig(admissionControlFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(admissionControlFilterName, EnableFilterPerRoute())
+
+	if p.admissionControlInChain == nil {
+		p.admissionControlInChain = make(map[string]*admissioncontrolv3.AdmissionControl)
+	}
+	if existing, ok := p.admissionControlInChain[fcn]; !ok {
+		p.admissionControlInChain[fcn] = admissionControl.config
+	} else if !proto.Equal(existing, admissionControl.config) {
+		logger.Debug("conflicting admission control settings for filter chain; using the first ones", "filter_chain", fcn)
+	}
+}
+
+// admissionControlSettings returns the admission control settings of the policy shared by all the routes of a
+// filter chain, if any.
+func admissionControlSettings(spec kgateway.TrafficPolicySpec) any {
+	if spec.AdmissionControl == nil || spec.AdmissionControl.Disable != nil {
+		return nil
+	}
+	return *spec.AdmissionControl
+}
@@ -0,0 +1,138 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	admissioncontrolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructAdmissionControl(t *testing.T) {
+	successCriteria := &admissioncontrolv3.AdmissionControl_SuccessCriteria_{
+		SuccessCriteria: &admissioncontrolv3.AdmissionControl_SuccessCriteria{},
+	}
+
+	tests := []struct {
+		name string
+		in   *kgateway.AdmissionControl
+		want *admissionControlIR
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "defaults",
+			in:   &kgateway.AdmissionControl{},
+			want: &admissionControlIR{
+				config: &admissioncontrolv3.AdmissionControl{
+					EvaluationCriteria: successCriteria,
+				},
+			},
+		},
+		{
+			name: "all fields",
+			in: &kgateway.AdmissionControl{
+				SamplingWindow:          &metav1.Duration{Duration: time.Minute},
+				SuccessRateThreshold:    ptr.To(int32(90)),
+				Aggression:              ptr.To("1.5"),
+				RpsThreshold:            ptr.To(int32(10)),
+				MaxRejectionProbability: ptr.To(int32(50)),
+			},
+			want: &admissionControlIR{
+				config: &admissioncontrolv3.AdmissionControl{
+					EvaluationCriteria: successCriteria,
+					SamplingWindow:     durationpb.New(time.Minute),
+					SrThreshold: &envoycorev3.RuntimePercent{
+						DefaultValue: &typev3.Percent{Value: 90},
+						RuntimeKey:   admissionControlSuccessRateThresholdRuntimeKey,
+					},
+					Aggression: &envoycorev3.RuntimeDouble{
+						DefaultValue: 1.5,
+						RuntimeKey:   admissionControlAggressionRuntimeKey,
+					},
+					RpsThreshold: &envoycorev3.RuntimeUInt32{
+						DefaultValue: 10,
+						RuntimeKey:   admissionControlRpsThresholdRuntimeKey,
+					},
+					MaxRejectionProbability: &envoycorev3.RuntimePercent{
+						DefaultValue: &typev3.Percent{Value: 50},
+						RuntimeKey:   admissionControlMaxRejectionProbabilityRuntimeKey,
+					},
+				},
+			},
+		},
+		{
+			name: "disable",
+			in: &kgateway.AdmissionControl{
+				Disable: &shared.PolicyDisable{},
+			},
+			want: &admissionControlIR{
+				disable: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			out := &trafficPolicySpecIr{}
+			err := constructAdmissionControl(kgateway.TrafficPolicySpec{
+				AdmissionControl: tt.in,
+			}, out)
+			a.NoError(err)
+
+			a.True(tt.want.Equals(out.admissionControl))
+			a.NoError(out.admissionControl.Validate())
+		})
+	}
+}
+
+func TestHandleAdmissionControl(t *testing.T) {
+	a := assert.New(t)
+
+	enabled := &trafficPolicySpecIr{}
+	a.NoError(constructAdmissionControl(kgateway.TrafficPolicySpec{
+		AdmissionControl: &kgateway.AdmissionControl{SuccessRateThreshold: ptr.To(int32(90))},
+	}, enabled))
+	disabled := &trafficPolicySpecIr{}
+	a.NoError(constructAdmissionControl(kgateway.TrafficPolicySpec{
+		AdmissionControl: &kgateway.AdmissionControl{Disable: &shared.PolicyDisable{}},
+	}, disabled))
+
+	p := &trafficPolicyPluginGwPass{}
+
+	enabledRoute := ir.TypedFilterConfigMap{}
+	p.handleAdmissionControl("fc", &enabledRoute, enabled.admissionControl)
+	a.Equal(EnableFilterPerRoute(), enabledRoute.GetTypedConfig(admissionControlFilterName))
+	a.Contains(p.admissionControlInChain, "fc")
+
+	disabledRoute := ir.TypedFilterConfigMap{}
+	p.handleAdmissionControl("fc", &disabledRoute, disabled.admissionControl)
+	a.Equal(DisableFilterPerRoute(), disabledRoute.GetTypedConfig(admissionControlFilterName))
+}
+
+func TestAdmissionControlSettings(t *testing.T) {
+	a := assert.New(t)
+
+	a.Nil(admissionControlSettings(kgateway.TrafficPolicySpec{}))
+	a.Nil(admissionControlSettings(kgateway.TrafficPolicySpec{
+		AdmissionControl: &kgateway.AdmissionControl{Disable: &shared.PolicyDisable{}},
+	}))
+	a.Equal(
+		kgateway.AdmissionControl{SuccessRateThreshold: ptr.To(int32(90))},
+		admissionControlSettings(kgateway.TrafficPolicySpec{
+			AdmissionControl: &kgateway.AdmissionControl{SuccessRateThreshold: ptr.To(int32(90))},
+		}),
+	)
+}
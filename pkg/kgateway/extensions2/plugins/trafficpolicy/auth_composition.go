@@ -0,0 +1,372 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	cncfcorev3 "github.com/cncf/xds/go/xds/core/v3"
+	cncfmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	cncftypev3 "github.com/cncf/xds/go/xds/type/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoymatcheractionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/matcher/action/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	envoyjwtauthnv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	authCompositionFilterNamePrefix        = "auth_composition"
+	authCompositionMetadataNamespacePrefix = "dev.kgateway.auth_composition"
+)
+
+// authCompositionIR is the internal representation of an anyOf authentication composition.
+// Envoy has no way to accept a request missing the credential of an authentication filter, so each composed
+// filter is skipped when its credential is missing and the credential of another composed filter is present.
+// When no credential is present, no filter is skipped and the request is rejected.
+// An allOf composition is the default behavior of the authentication filters and has no IR.
+type authCompositionIR struct {
+	// name identifies the composition, so that the routes with the same composition share the filter flagging them
+	name string
+	// skipConditions are the CEL expressions, by mechanism, under which the filter of the mechanism is skipped
+	skipConditions map[shared.AuthenticationMechanism]string
+}
+
+var _ PolicySubIR = &authCompositionIR{}
+
+func (a *authCompositionIR) Equals(other PolicySubIR) bool {
+	otherAuthComposition, ok := other.(*authCompositionIR)
+	if !ok {
+		return false
+	}
+	if a == nil || otherAuthComposition == nil {
+		return a == nil && otherAuthComposition == nil
+	}
+	return a.name == otherAuthComposition.name && maps.Equal(a.skipConditions, otherAuthComposition.skipConditions)
+}
+
+func (a *authCompositionIR) Validate() error {
+	if a == nil {
+		return nil
+	}
+	env, err := celEnv()
+	if err != nil {
+		return err
+	}
+	for _, m := range slices.Sorted(maps.Keys(a.skipConditions)) {
+		if _, err := parseCELExpression(env, shared.CELExpression(a.skipConditions[m])); err != nil {
+			return fmt.Errorf("auth composition: %s: %w", m, err)
+		}
+	}
+	return nil
+}
+
+// constructAuthComposition translates the authentication composition of the policy. It must run after the
+// composed authentication mechanisms are constructed, as it detects their credentials from their IR.
+func constructAuthComposition(policy *kgateway.TrafficPolicy, out *trafficPolicySpecIr) error {
+	mechanisms, anyOf := policy.Spec.AuthComposition.Mechanisms()
+	if !anyOf {
+		return nil
+	}
+
+	present := map[shared.AuthenticationMechanism]string{}
+	for _, m := range mechanisms {
+		sources, err := credentialSources(m, out)
+		if err != nil {
+			return fmt.Errorf("auth composition: %w", err)
+		}
+		// mechanisms that are disabled or failed to translate are left out
+		if len(sources) > 0 {
+			present[m] = credentialPresentExpression(sources)
+		}
+	}
+	if len(present) < 2 {
+		return nil
+	}
+
+	h := fnv.New64a()
+	skipConditions := make(map[shared.AuthenticationMechanism]string, len(present))
+	for _, m := range slices.Sorted(maps.Keys(present)) {
+		var others []string
+		for _, o := range slices.Sorted(maps.Keys(present)) {
+			if o != m {
+				others = append(others, present[o])
+			}
+		}
+		skipConditions[m] = fmt.Sprintf("!(%s) && (%s)", present[m], strings.Join(others, " || "))
+		h.Write([]byte(m))
+		h.Write([]byte(skipConditions[m]))
+	}
+	out.authComposition = &authCompositionIR{
+		name:           fmt.Sprintf("%x", h.Sum64()),
+		skipConditions: skipConditions,
+	}
+	return nil
+}
+
+// credentialSource is where a request carries the credential of an authentication mechanism.
+// Exactly one of header, query and cookie is set.
+type credentialSource struct {
+	header string
+	// prefix is the prefix of the header value, e.g. "Bearer "
+	prefix string
+	query  string
+	cookie string
+}
+
+func credentialSources(m shared.AuthenticationMechanism, spec *trafficPolicySpecIr) ([]credentialSource, error) {
+	switch m {
+	case shared.AuthenticationMechanismJWT:
+		if spec.jwt == nil {
+			return nil, nil
+		}
+		var out []credentialSource
+		for _, cfg := range spec.jwt.perProviderConfig {
+			if cfg.provider == nil {
+				continue
+			}
+			sources, err := jwtCredentialSources(cfg.provider.Jwt)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, sources...)
+		}
+		return out, nil
+	case shared.AuthenticationMechanismAPIKey:
+		if spec.apiKeyAuth == nil {
+			return nil, nil
+		}
+		var out []credentialSource
+		for _, ks := range spec.apiKeyAuth.config.GetKeySources() {
+			if ks.GetHeader() != "" {
+				out = append(out, credentialSource{header: ks.GetHeader()})
+			}
+			if ks.GetQuery() != "" {
+				out = append(out, credentialSource{query: ks.GetQuery()})
+			}
+			if ks.GetCookie() != "" {
+				out = append(out, credentialSource{cookie: ks.GetCookie()})
+			}
+		}
+		return out, nil
+	case shared.AuthenticationMechanismBasicAuth:
+		if spec.basicAuth == nil || spec.basicAuth.policy == nil {
+			return nil, nil
+		}
+		return []credentialSource{{header: "authorization", prefix: "Basic "}}, nil
+	default:
+		return nil, fmt.Errorf("unknown authentication mechanism %s", m)
+	}
+}
+
+// jwtCredentialSources returns the token sources of the providers of a JWT filter built by buildCompositeJwtFilter.
+func jwtCredentialSources(jwt *envoymatchingv3.ExtensionWithMatcher) ([]credentialSource, error) {
+	var out []credentialSource
+	for _, m := range jwt.GetXdsMatcher().GetMatcherList().GetMatchers() {
+		action := &envoycompositev3.ExecuteFilterAction{}
+		if err := m.GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(action); err != nil {
+			return nil, fmt.Errorf("failed to read JWT filter: %w", err)
+		}
+		cfg := &envoyjwtauthnv3.JwtAuthentication{}
+		if err := action.GetTypedConfig().GetTypedConfig().UnmarshalTo(cfg); err != nil {
+			return nil, fmt.Errorf("failed to read JWT filter: %w", err)
+		}
+		for _, name := range slices.Sorted(maps.Keys(cfg.GetProviders())) {
+			provider := cfg.GetProviders()[name]
+			for _, h := range provider.GetFromHeaders() {
+				out = append(out, credentialSource{header: h.GetName(), prefix: h.GetValuePrefix()})
+			}
+			for _, q := range provider.GetFromParams() {
+				out = append(out, credentialSource{query: q})
+			}
+			for _, c := range provider.GetFromCookies() {
+				out = append(out, credentialSource{cookie: c})
+			}
+			if len(provider.GetFromHeaders()) == 0 && len(provider.GetFromParams()) == 0 && len(provider.GetFromCookies()) == 0 {
+				// the token sources Envoy uses when none is configured
+				out = append(out,
+					credentialSource{header: "authorization", prefix: "Bearer "},
+					credentialSource{query: "access_token"},
+				)
+			}
+		}
+	}
+	return out, nil
+}
+
+// credentialPresentExpression returns a CEL expression evaluating to true when a credential is present in any of
+// the sources.
+func credentialPresentExpression(sources []credentialSource) string {
+	var exprs []string
+	for _, s := range sources {
+		switch {
+		case s.header != "":
+			header := strconv.Quote(strings.ToLower(s.header))
+			expr := fmt.Sprintf("%s in request.headers", header)
+			if s.prefix != "" {
+				expr += fmt.Sprintf(" && request.headers[%s].startsWith(%s)", header, strconv.Quote(s.prefix))
+			}
+			exprs = append(exprs, expr)
+		case s.query != "":
+			exprs = append(exprs, fmt.Sprintf("request.query.matches(%s)",
+				strconv.Quote("(^|&)"+regexp.QuoteMeta(s.query)+"=")))
+		case s.cookie != "":
+			exprs = append(exprs, fmt.Sprintf(`"cookie" in request.headers && request.headers["cookie"].matches(%s)`,
+				strconv.Quote(`(^|;\s*)`+regexp.QuoteMeta(s.cookie)+"=")))
+		}
+	}
+	slices.Sort(exprs)
+	exprs = slices.Compact(exprs)
+	for i, expr := range exprs {
+		exprs[i] = "(" + expr + ")"
+	}
+	return strings.Join(exprs, " || ")
+}
+
+// handleAuthComposition flags the route with its composition, so that the composed filters are skipped
+// when their credential is missing.
+func (p *trafficPolicyPluginGwPass) handleAuthComposition(
+	fcn string,
+	pCtxTypedFilterConfig *ir.TypedFilterConfigMap,
+	authComposition *authCompositionIR,
+) {
+	if authComposition == nil {
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(authCompositionFilterName(authComposition.name), EnableFilterPerRoute())
+
+	if p.authCompositionsInChain == nil {
+		p.authCompositionsInChain = make(map[string]map[string]*authCompositionIR)
+	}
+	if p.authCompositionsInChain[fcn] == nil {
+		p.authCompositionsInChain[fcn] = make(map[string]*authCompositionIR)
+	}
+	p.authCompositionsInChain[fcn][authComposition.name] = authComposition
+}
+
+// addAuthCompositionFilters adds the disabled filters flagging the routes with each composition of the filter chain.
+func (p *trafficPolicyPluginGwPass) addAuthCompositionFilters(
+	stagedFilters []filters.StagedHttpFilter,
+	fcn string,
+) []filters.StagedHttpFilter {
+	for _, name := range slices.Sorted(maps.Keys(p.authCompositionsInChain[fcn])) {
+		stagedFilters = AddDisableFilterIfNeeded(stagedFilters, authCompositionFilterName(name), authCompositionMetadataNamespace(name))
+	}
+	return stagedFilters
+}
+
+// skipWithoutCredential wraps the config of the filter of an authentication mechanism so that it is skipped when
+// the skip condition of the composition of the route holds. The config is returned as is when no composition
+// of the filter chain composes the mechanism.
+func (p *trafficPolicyPluginGwPass) skipWithoutCredential(
+	fcn string,
+	mechanism shared.AuthenticationMechanism,
+	filterName string,
+	config proto.Message,
+) proto.Message {
+	var conditions []string
+	compositions := p.authCompositionsInChain[fcn]
+	for _, name := range slices.Sorted(maps.Keys(compositions)) {
+		condition, ok := compositions[name].skipConditions[mechanism]
+		if !ok {
+			continue
+		}
+		conditions = append(conditions, fmt.Sprintf("(%s in metadata.filter_metadata && %s)",
+			strconv.Quote(authCompositionMetadataNamespace(name)), condition))
+	}
+	if len(conditions) == 0 {
+		return config
+	}
+
+	skipMatcher, err := buildSkipFilterMatcher(strings.Join(conditions, " || "))
+	if err != nil {
+		// the conditions are validated when the policy is translated
+		logger.Error("failed to build auth composition matcher", "filter", filterName, "error", err)
+		return config
+	}
+
+	// filters already wrapped with a matcher, e.g. by buildCompositeFilter, are checked for the skip condition first
+	if withMatcher, ok := config.(*envoymatchingv3.ExtensionWithMatcher); ok {
+		out := proto.Clone(withMatcher).(*envoymatchingv3.ExtensionWithMatcher)
+		if matcherList := out.GetXdsMatcher().GetMatcherList(); matcherList != nil {
+			matcherList.Matchers = append([]*cncfmatcherv3.Matcher_MatcherList_FieldMatcher{skipMatcher}, matcherList.GetMatchers()...)
+			return out
+		}
+		logger.Error("unexpected matcher for auth composition", "filter", filterName)
+		return config
+	}
+
+	return &envoymatchingv3.ExtensionWithMatcher{
+		ExtensionConfig: &envoycorev3.TypedExtensionConfig{
+			Name:        filterName,
+			TypedConfig: utils.MustMessageToAny(config),
+		},
+		XdsMatcher: &cncfmatcherv3.Matcher{
+			MatcherType: &cncfmatcherv3.Matcher_MatcherList_{
+				MatcherList: &cncfmatcherv3.Matcher_MatcherList{
+					Matchers: []*cncfmatcherv3.Matcher_MatcherList_FieldMatcher{skipMatcher},
+				},
+			},
+		},
+	}
+}
+
+// buildSkipFilterMatcher returns a matcher skipping the filter when the CEL expression evaluates to true.
+func buildSkipFilterMatcher(celExpr string) (*cncfmatcherv3.Matcher_MatcherList_FieldMatcher, error) {
+	env, err := celEnv()
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := parseCELExpression(env, shared.CELExpression(celExpr))
+	if err != nil {
+		return nil, err
+	}
+	return &cncfmatcherv3.Matcher_MatcherList_FieldMatcher{
+		Predicate: &cncfmatcherv3.Matcher_MatcherList_Predicate{
+			MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+				SinglePredicate: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+					Input: &cncfcorev3.TypedExtensionConfig{
+						Name:        "envoy.matching.inputs.cel_data_input",
+						TypedConfig: utils.MustMessageToAny(&cncfmatcherv3.HttpAttributesCelMatchInput{}),
+					},
+					Matcher: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+						CustomMatch: &cncfcorev3.TypedExtensionConfig{
+							Name: "envoy.matching.matchers.cel_matcher",
+							TypedConfig: utils.MustMessageToAny(&cncfmatcherv3.CelMatcher{
+								ExprMatch: &cncftypev3.CelExpression{CelExprParsed: parsed},
+							}),
+						},
+					},
+				},
+			},
+		},
+		OnMatch: &cncfmatcherv3.Matcher_OnMatch{
+			OnMatch: &cncfmatcherv3.Matcher_OnMatch_Action{
+				Action: &cncfcorev3.TypedExtensionConfig{
+					Name:        "skip",
+					TypedConfig: utils.MustMessageToAny(&envoymatcheractionv3.SkipFilter{}),
+				},
+			},
+		},
+	}, nil
+}
+
+func authCompositionFilterName(name string) string {
+	return fmt.Sprintf("%s/%s", authCompositionFilterNamePrefix, name)
+}
+
+func authCompositionMetadataNamespace(name string) string {
+	return fmt.Sprintf("%s.%s", authCompositionMetadataNamespacePrefix, name)
+}
@@ -0,0 +1,119 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoyapikeyauthv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/api_key_auth/v3"
+	envoy_basic_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/basic_auth/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructAuthComposition(t *testing.T) {
+	apiKeyAuth := &apiKeyAuthIR{
+		config: &envoyapikeyauthv3.ApiKeyAuthPerRoute{
+			KeySources: []*envoyapikeyauthv3.KeySource{{Header: "X-API-Key"}, {Query: "api_key"}},
+		},
+	}
+	basicAuth := &basicAuthIR{policy: &envoy_basic_auth_v3.BasicAuthPerRoute{}}
+
+	tests := []struct {
+		name        string
+		composition *shared.AuthenticationComposition
+		spec        trafficPolicySpecIr
+		expected    map[shared.AuthenticationMechanism]string
+	}{
+		{
+			name:        "no composition",
+			composition: nil,
+			spec:        trafficPolicySpecIr{apiKeyAuth: apiKeyAuth, basicAuth: basicAuth},
+		},
+		{
+			name: "allOf is the default behavior",
+			composition: &shared.AuthenticationComposition{
+				AllOf: []shared.AuthenticationMechanism{shared.AuthenticationMechanismAPIKey, shared.AuthenticationMechanismBasicAuth},
+			},
+			spec: trafficPolicySpecIr{apiKeyAuth: apiKeyAuth, basicAuth: basicAuth},
+		},
+		{
+			name: "anyOf API key or basic auth",
+			composition: &shared.AuthenticationComposition{
+				AnyOf: []shared.AuthenticationMechanism{shared.AuthenticationMechanismAPIKey, shared.AuthenticationMechanismBasicAuth},
+			},
+			spec: trafficPolicySpecIr{apiKeyAuth: apiKeyAuth, basicAuth: basicAuth},
+			expected: map[shared.AuthenticationMechanism]string{
+				shared.AuthenticationMechanismAPIKey: `!(("x-api-key" in request.headers) || (request.query.matches("(^|&)api_key="))) && ` +
+					`(("authorization" in request.headers && request.headers["authorization"].startsWith("Basic ")))`,
+				shared.AuthenticationMechanismBasicAuth: `!(("authorization" in request.headers && request.headers["authorization"].startsWith("Basic "))) && ` +
+					`(("x-api-key" in request.headers) || (request.query.matches("(^|&)api_key=")))`,
+			},
+		},
+		{
+			name: "anyOf with a disabled mechanism",
+			composition: &shared.AuthenticationComposition{
+				AnyOf: []shared.AuthenticationMechanism{shared.AuthenticationMechanismAPIKey, shared.AuthenticationMechanismBasicAuth},
+			},
+			spec: trafficPolicySpecIr{apiKeyAuth: apiKeyAuth, basicAuth: &basicAuthIR{disable: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &kgateway.TrafficPolicy{Spec: kgateway.TrafficPolicySpec{AuthComposition: tt.composition}}
+			spec := tt.spec
+
+			err := constructAuthComposition(policy, &spec)
+			require.NoError(t, err)
+
+			if tt.expected == nil {
+				assert.Nil(t, spec.authComposition)
+				return
+			}
+			require.NotNil(t, spec.authComposition)
+			assert.Equal(t, tt.expected, spec.authComposition.skipConditions)
+			assert.NotEmpty(t, spec.authComposition.name)
+			assert.NoError(t, spec.authComposition.Validate())
+		})
+	}
+}
+
+func TestSkipWithoutCredential(t *testing.T) {
+	composition := &authCompositionIR{
+		name: "abc",
+		skipConditions: map[shared.AuthenticationMechanism]string{
+			shared.AuthenticationMechanismAPIKey:    `!("x-api-key" in request.headers) && ("authorization" in request.headers)`,
+			shared.AuthenticationMechanismBasicAuth: `!("authorization" in request.headers) && ("x-api-key" in request.headers)`,
+		},
+	}
+	p := &trafficPolicyPluginGwPass{}
+	typedFilterConfig := ir.TypedFilterConfigMap{}
+	p.handleAuthComposition("fc", &typedFilterConfig, composition)
+
+	assert.NotNil(t, typedFilterConfig.GetTypedConfig(authCompositionFilterName("abc")))
+
+	cfg := &envoyapikeyauthv3.ApiKeyAuth{}
+
+	// the filters of filter chains without composition are not wrapped
+	assert.Same(t, cfg, p.skipWithoutCredential("other", shared.AuthenticationMechanismAPIKey, apiKeyAuthFilterNamePrefix, cfg))
+	// nor are the filters of mechanisms that are not composed
+	assert.Same(t, cfg, p.skipWithoutCredential("fc", shared.AuthenticationMechanismJWT, apiKeyAuthFilterNamePrefix, cfg))
+
+	wrapped, ok := p.skipWithoutCredential("fc", shared.AuthenticationMechanismAPIKey, apiKeyAuthFilterNamePrefix, cfg).(*envoymatchingv3.ExtensionWithMatcher)
+	require.True(t, ok)
+	assert.Equal(t, apiKeyAuthFilterNamePrefix, wrapped.GetExtensionConfig().GetName())
+	matchers := wrapped.GetXdsMatcher().GetMatcherList().GetMatchers()
+	require.Len(t, matchers, 1)
+	assert.Equal(t, "skip", matchers[0].GetOnMatch().GetAction().GetName())
+
+	// filters already wrapped with a matcher check the skip condition first
+	composite := p.skipWithoutCredential("fc", shared.AuthenticationMechanismAPIKey, apiKeyAuthFilterNamePrefix, wrapped).(*envoymatchingv3.ExtensionWithMatcher)
+	require.Len(t, composite.GetXdsMatcher().GetMatcherList().GetMatchers(), 2)
+	assert.Equal(t, "skip", composite.GetXdsMatcher().GetMatcherList().GetMatchers()[0].GetOnMatch().GetAction().GetName())
+	// the original matcher is left untouched
+	assert.Len(t, wrapped.GetXdsMatcher().GetMatcherList().GetMatchers(), 1)
+}
@@ -0,0 +1,146 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"strings"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	jsontometadatav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/json_to_metadata/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+const (
+	bodyMatchFilterName = "envoy.filters.http.json_to_metadata"
+
+	// bodyMatchMetadataNamespace is the dynamic metadata namespace of the fields extracted from the request body,
+	// keyed by their JSON pointer
+	bodyMatchMetadataNamespace = "kgateway.body_match"
+)
+
+// bodyMatchIR matches the routes on a field of the request body. The json_to_metadata filter of the filter
+// chain extracts the field into dynamic metadata and clears the route cache, so that the route is selected
+// again with the metadata matcher of the route.
+type bodyMatchIR struct {
+	rule    *jsontometadatav3.JsonToMetadata_Rule
+	matcher *envoymatcherv3.MetadataMatcher
+}
+
+var _ PolicySubIR = &bodyMatchIR{}
+
+func (b *bodyMatchIR) Equals(other PolicySubIR) bool {
+	otherBodyMatch, ok := other.(*bodyMatchIR)
+	if !ok {
+		return false
+	}
+	if b == nil || otherBodyMatch == nil {
+		return b == nil && otherBodyMatch == nil
+	}
+	return proto.Equal(b.rule, otherBodyMatch.rule) && proto.Equal(b.matcher, otherBodyMatch.matcher)
+}
+
+func (b *bodyMatchIR) Validate() error {
+	if b == nil {
+		return nil
+	}
+	if err := b.rule.Validate(); err != nil {
+		return err
+	}
+	return b.matcher.Validate()
+}
+
+// constructBodyMatch constructs the body match policy IR from the policy specification.
+func constructBodyMatch(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.BodyMatch == nil {
+		return nil
+	}
+
+	keys, err := parseJSONPointer(spec.BodyMatch.JSONPointer)
+	if err != nil {
+		return fmt.Errorf("body match: %w", err)
+	}
+	selectors := make([]*jsontometadatav3.JsonToMetadata_Selector, 0, len(keys))
+	for _, key := range keys {
+		selectors = append(selectors, &jsontometadatav3.JsonToMetadata_Selector{
+			Selector: &jsontometadatav3.JsonToMetadata_Selector_Key{Key: key},
+		})
+	}
+
+	out.bodyMatch = &bodyMatchIR{
+		rule: &jsontometadatav3.JsonToMetadata_Rule{
+			Selectors: selectors,
+			OnPresent: &jsontometadatav3.JsonToMetadata_KeyValuePair{
+				MetadataNamespace: bodyMatchMetadataNamespace,
+				Key:               spec.BodyMatch.JSONPointer,
+				Type:              jsontometadatav3.JsonToMetadata_STRING,
+			},
+		},
+		matcher: &envoymatcherv3.MetadataMatcher{
+			Filter: bodyMatchMetadataNamespace,
+			Path: []*envoymatcherv3.MetadataMatcher_PathSegment{{
+				Segment: &envoymatcherv3.MetadataMatcher_PathSegment_Key{Key: spec.BodyMatch.JSONPointer},
+			}},
+			Value: &envoymatcherv3.ValueMatcher{
+				MatchPattern: &envoymatcherv3.ValueMatcher_StringMatch{
+					StringMatch: &envoymatcherv3.StringMatcher{
+						MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: spec.BodyMatch.Value},
+					},
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// parseJSONPointer returns the unescaped reference tokens of an RFC 6901 JSON pointer.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q, must start with /", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		if token == "" {
+			return nil, fmt.Errorf("invalid JSON pointer %q, reference tokens must not be empty", pointer)
+		}
+		// ~1 must be unescaped before ~0, so that ~01 is unescaped to ~1
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// applyBodyMatch adds the metadata matcher of the body match to the route match.
+func applyBodyMatch(bodyMatch *bodyMatchIR, out *envoyroutev3.Route) {
+	if bodyMatch == nil || out.GetMatch() == nil {
+		return
+	}
+	out.Match.DynamicMetadata = append(out.Match.DynamicMetadata, bodyMatch.matcher)
+}
+
+// handleBodyMatch adds the extraction rule of the body match to the json_to_metadata filter of the filter
+// chain. The filter is enabled for all the routes of the filter chain, as it must run for the requests
+// matching a route without a body match to select a route with one.
+func (p *trafficPolicyPluginGwPass) handleBodyMatch(fcn string, bodyMatch *bodyMatchIR) {
+	if bodyMatch == nil {
+		return
+	}
+
+	if p.bodyMatchInChain == nil {
+		p.bodyMatchInChain = make(map[string]*jsontometadatav3.JsonToMetadata)
+	}
+	config, ok := p.bodyMatchInChain[fcn]
+	if !ok {
+		// the rules only apply to the requests with the default application/json content type
+		config = &jsontometadatav3.JsonToMetadata{
+			RequestRules: &jsontometadatav3.JsonToMetadata_MatchRules{},
+		}
+		p.bodyMatchInChain[fcn] = config
+	}
+	for _, rule := range config.GetRequestRules().GetRules() {
+		if proto.Equal(rule, bodyMatch.rule) {
+			return
+		}
+	}
+	config.RequestRules.Rules = append(config.RequestRules.Rules, bodyMatch.rule)
+}
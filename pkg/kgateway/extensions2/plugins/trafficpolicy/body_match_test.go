@@ -0,0 +1,71 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+func TestParseJSONPointer(t *testing.T) {
+	tests := []struct {
+		pointer string
+		want    []string
+		wantErr bool
+	}{
+		{pointer: "/method", want: []string{"method"}},
+		{pointer: "/params/name", want: []string{"params", "name"}},
+		{pointer: "/a~1b/c~0d/~01", want: []string{"a/b", "c~d", "~1"}},
+		{pointer: "method", wantErr: true},
+		{pointer: "/params//name", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pointer, func(t *testing.T) {
+			got, err := parseJSONPointer(tt.pointer)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBodyMatch(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	require.NoError(t, constructBodyMatch(kgateway.TrafficPolicySpec{
+		BodyMatch: &kgateway.BodyMatch{
+			JSONPointer: "/params/name",
+			Value:       "tools/list",
+		},
+	}, out))
+	require.NotNil(t, out.bodyMatch)
+	require.NoError(t, out.bodyMatch.Validate())
+
+	selectors := out.bodyMatch.rule.GetSelectors()
+	require.Len(t, selectors, 2)
+	assert.Equal(t, "params", selectors[0].GetKey())
+	assert.Equal(t, "name", selectors[1].GetKey())
+	assert.Equal(t, bodyMatchMetadataNamespace, out.bodyMatch.rule.GetOnPresent().GetMetadataNamespace())
+	assert.Equal(t, "/params/name", out.bodyMatch.rule.GetOnPresent().GetKey())
+
+	route := &envoyroutev3.Route{Match: &envoyroutev3.RouteMatch{}}
+	applyBodyMatch(out.bodyMatch, route)
+	require.Len(t, route.GetMatch().GetDynamicMetadata(), 1)
+	matcher := route.GetMatch().GetDynamicMetadata()[0]
+	assert.Equal(t, bodyMatchMetadataNamespace, matcher.GetFilter())
+	assert.Equal(t, "/params/name", matcher.GetPath()[0].GetKey())
+	assert.Equal(t, "tools/list", matcher.GetValue().GetStringMatch().GetExact())
+
+	p := &trafficPolicyPluginGwPass{}
+	p.handleBodyMatch("fc", out.bodyMatch)
+	// the rule is added once per filter chain
+	p.handleBodyMatch("fc", out.bodyMatch)
+	require.Contains(t, p.bodyMatchInChain, "fc")
+	assert.Len(t, p.bodyMatchInChain["fc"].GetRequestRules().GetRules(), 1)
+}
@@ -0,0 +1,280 @@
+package trafficpolicy
+
+import (
+	"fmt"
+	"math"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	mutation_rulesv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	simplehttpcachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	cacheFilterName      = "envoy.filters.http.cache"
+	cachingTTLFilterName = "composite_caching_ttl"
+
+	// cacheableMethodsRegex matches the methods of the requests the cache filter looks up and stores
+	cacheableMethodsRegex = "^(GET|HEAD)$"
+	// cacheableStatusesRegex matches the status codes that are cacheable by default, see RFC 9110 section 15.1
+	cacheableStatusesRegex = "^(200|203|204|206|300|301|308|404|405|410|414|501)$"
+)
+
+// cachingIR enables the cache filter of the filter chain for the route. If a ttl is set, the composite filter
+// in the filter chain executes a header mutation filter adding the Cache-Control header derived from the ttl
+// to the cacheable responses that do not set one, before they reach the cache filter.
+type cachingIR struct {
+	// config is the cache filter config, shared by all the routes of a filter chain
+	config *cachev3.CacheConfig
+	// ttl is the matcher of the route on the composite filter adding the Cache-Control header, if a ttl is set
+	ttl     *envoymatchingv3.ExtensionWithMatcherPerRoute
+	disable bool
+}
+
+var _ PolicySubIR = &cachingIR{}
+
+func (c *cachingIR) Equals(other PolicySubIR) bool {
+	otherCaching, ok := other.(*cachingIR)
+	if !ok {
+		return false
+	}
+	if c == nil || otherCaching == nil {
+		return c == nil && otherCaching == nil
+	}
+	if c.disable != otherCaching.disable {
+		return false
+	}
+	return proto.Equal(c.config, otherCaching.config) && proto.Equal(c.ttl, otherCaching.ttl)
+}
+
+func (c *cachingIR) Validate() error {
+	if c == nil || c.config == nil {
+		return nil
+	}
+	if err := c.config.Validate(); err != nil {
+		return err
+	}
+	if c.ttl != nil {
+		return c.ttl.Validate()
+	}
+	return nil
+}
+
+// constructCaching constructs the response caching policy IR from the policy specification.
+func constructCaching(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.Caching == nil {
+		return nil
+	}
+
+	if spec.Caching.Disable != nil {
+		out.caching = &cachingIR{
+			disable: true,
+		}
+		return nil
+	}
+
+	// InMemory is the only supported storage
+	storage, err := utils.MessageToAny(&simplehttpcachev3.SimpleHttpCacheConfig{})
+	if err != nil {
+		return fmt.Errorf("failed to convert cache storage config: %w", err)
+	}
+	config := &cachev3.CacheConfig{
+		TypedConfig: storage,
+	}
+	for _, h := range spec.Caching.VaryHeaders {
+		config.AllowedVaryHeaders = append(config.AllowedVaryHeaders, &envoymatcherv3.StringMatcher{
+			MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: string(h)},
+			IgnoreCase:   true,
+		})
+	}
+	if key := spec.Caching.Key; key != nil {
+		params := &cachev3.CacheConfig_KeyCreatorParams{
+			ExcludeScheme: key.ExcludeScheme != nil && *key.ExcludeScheme,
+			ExcludeHost:   key.ExcludeHost != nil && *key.ExcludeHost,
+		}
+		for _, q := range key.IncludedQueryParameters {
+			params.QueryParametersIncluded = append(params.QueryParametersIncluded, &envoyroutev3.QueryParameterMatcher{Name: q})
+		}
+		for _, q := range key.ExcludedQueryParameters {
+			params.QueryParametersExcluded = append(params.QueryParametersExcluded, &envoyroutev3.QueryParameterMatcher{Name: q})
+		}
+		config.KeyCreatorParams = params
+	}
+	if size := spec.Caching.MaxBodySize; size != nil {
+		maxBodyBytes := size.Value()
+		if maxBodyBytes < 0 || maxBodyBytes > math.MaxUint32 {
+			maxBodyBytes = math.MaxUint32
+		}
+		config.MaxBodyBytes = uint32(maxBodyBytes) //nolint:gosec // G115: validated above
+	}
+
+	var ttlMatcher *envoymatchingv3.ExtensionWithMatcherPerRoute
+	if ttl := spec.Caching.TTL; ttl != nil {
+		ttlMatcher = cachingTTLMatcher(fmt.Sprintf("max-age=%d", int64(ttl.Seconds())))
+	}
+
+	out.caching = &cachingIR{
+		config: config,
+		ttl:    ttlMatcher,
+	}
+	return nil
+}
+
+// cachingTTLMatcher returns the matcher of the composite filter adding the Cache-Control header to the responses
+// to GET and HEAD requests with a status code that is cacheable by default, unless the upstream set one.
+func cachingTTLMatcher(cacheControl string) *envoymatchingv3.ExtensionWithMatcherPerRoute {
+	addCacheControl := &header_mutationv3.HeaderMutation{
+		Mutations: &header_mutationv3.Mutations{
+			ResponseMutations: []*mutation_rulesv3.HeaderMutation{
+				{
+					Action: &mutation_rulesv3.HeaderMutation_Append{
+						Append: &envoycorev3.HeaderValueOption{
+							Header: &envoycorev3.HeaderValue{
+								Key:   "cache-control",
+								Value: cacheControl,
+							},
+							AppendAction: envoycorev3.HeaderValueOption_ADD_IF_ABSENT,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return &envoymatchingv3.ExtensionWithMatcherPerRoute{
+		XdsMatcher: &xdsmatcherv3.Matcher{
+			MatcherType: &xdsmatcherv3.Matcher_MatcherList_{
+				MatcherList: &xdsmatcherv3.Matcher_MatcherList{
+					Matchers: []*xdsmatcherv3.Matcher_MatcherList_FieldMatcher{
+						{
+							// the response headers input defers the match until the response headers are received
+							Predicate: &xdsmatcherv3.Matcher_MatcherList_Predicate{
+								MatchType: &xdsmatcherv3.Matcher_MatcherList_Predicate_AndMatcher{
+									AndMatcher: &xdsmatcherv3.Matcher_MatcherList_Predicate_PredicateList{
+										Predicate: []*xdsmatcherv3.Matcher_MatcherList_Predicate{
+											headerRegexPredicate("envoy.matching.inputs.request_headers",
+												&envoymatcherv3.HttpRequestHeaderMatchInput{HeaderName: ":method"}, cacheableMethodsRegex),
+											headerRegexPredicate("envoy.matching.inputs.response_headers",
+												&envoymatcherv3.HttpResponseHeaderMatchInput{HeaderName: ":status"}, cacheableStatusesRegex),
+										},
+									},
+								},
+							},
+							OnMatch: &xdsmatcherv3.Matcher_OnMatch{
+								OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+									Action: &xdscorev3.TypedExtensionConfig{
+										Name: "composite-action",
+										TypedConfig: utils.MustMessageToAny(&envoycompositev3.ExecuteFilterAction{
+											TypedConfig: &envoycorev3.TypedExtensionConfig{
+												Name:        headerMutationFilterName,
+												TypedConfig: utils.MustMessageToAny(addCacheControl),
+											},
+										}),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// headerRegexPredicate returns a predicate matching the value of the header of the input against the regex.
+func headerRegexPredicate(inputName string, input proto.Message, regex string) *xdsmatcherv3.Matcher_MatcherList_Predicate {
+	return &xdsmatcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &xdscorev3.TypedExtensionConfig{
+					Name:        inputName,
+					TypedConfig: utils.MustMessageToAny(input),
+				},
+				Matcher: &xdsmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+					ValueMatch: &xdsmatcherv3.StringMatcher{
+						MatchPattern: &xdsmatcherv3.StringMatcher_SafeRegex{
+							SafeRegex: &xdsmatcherv3.RegexMatcher{
+								EngineType: &xdsmatcherv3.RegexMatcher_GoogleRe2{
+									GoogleRe2: &xdsmatcherv3.RegexMatcher_GoogleRE2{},
+								},
+								Regex: regex,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// handleCaching enables the cache filter for the route or virtual host and registers the disabled cache filter
+// in the filter chain, along with the disabled composite filter adding the Cache-Control header if a ttl is set.
+// The cache filter does not support per-route configuration, so the first config registered for a filter chain
+// is used for all of its routes; the conflicts are reported in the status of the policies by
+// reportFilterChainConflicts.
+func (p *trafficPolicyPluginGwPass) handleCaching(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, caching *cachingIR) {
+	if caching == nil {
+		return
+	}
+
+	// Handle disable case - disable the filters to override parent policy
+	if caching.disable {
+		pCtxTypedFilterConfig.AddTypedConfig(cacheFilterName, DisableFilterPerRoute())
+		pCtxTypedFilterConfig.AddTypedConfig(cachingTTLFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(cacheFilterName, EnableFilterPerRoute())
+
+	if p.cacheInChain == nil {
+		p.cacheInChain = make(map[string]*cachev3.CacheConfig)
+	}
+	if existing, ok := p.cacheInChain[fcn]; !ok {
+		p.cacheInChain[fcn] = caching.config
+	} else if !proto.Equal(existing, caching.config) {
+		logger.Debug("conflicting caching settings for filter chain; using the first ones", "filter_chain", fcn)
+	}
+
+	if caching.ttl == nil {
+		return
+	}
+	pCtxTypedFilterConfig.AddTypedConfig(cachingTTLFilterName, caching.ttl)
+
+	if p.cachingTTLInChain == nil {
+		p.cachingTTLInChain = make(map[string]*envoymatchingv3.ExtensionWithMatcher)
+	}
+	if _, ok := p.cachingTTLInChain[fcn]; !ok {
+		p.cachingTTLInChain[fcn] = &envoymatchingv3.ExtensionWithMatcher{
+			ExtensionConfig: &envoycorev3.TypedExtensionConfig{
+				Name:        cachingTTLFilterName,
+				TypedConfig: utils.MustMessageToAny(&envoycompositev3.Composite{}),
+			},
+			XdsMatcher: &xdsmatcherv3.Matcher{},
+		}
+	}
+}
+
+// cachingSettings returns the caching settings of the policy shared by all the routes of a filter chain, if any.
+func cachingSettings(spec kgateway.TrafficPolicySpec) any {
+	if spec.Caching == nil || spec.Caching.Disable != nil {
+		return nil
+	}
+	return kgateway.Caching{
+		Key:         spec.Caching.Key,
+		VaryHeaders: spec.Caching.VaryHeaders,
+		MaxBodySize: spec.Caching.MaxBodySize,
+		Storage:     spec.Caching.Storage,
+	}
+}
@@ -0,0 +1,210 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	simplehttpcachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/simple_http_cache/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+func TestConstructCaching(t *testing.T) {
+	storage, err := utils.MessageToAny(&simplehttpcachev3.SimpleHttpCacheConfig{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		in   *kgateway.Caching
+		want *cachingIR
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "defaults",
+			in:   &kgateway.Caching{},
+			want: &cachingIR{
+				config: &cachev3.CacheConfig{
+					TypedConfig: storage,
+				},
+			},
+		},
+		{
+			name: "all fields",
+			in: &kgateway.Caching{
+				TTL: &metav1.Duration{Duration: 5 * time.Minute},
+				Key: &kgateway.CacheKey{
+					ExcludeHost:             ptr.To(true),
+					IncludedQueryParameters: []string{"page"},
+				},
+				VaryHeaders: []gwv1.HeaderName{"Accept-Encoding"},
+				MaxBodySize: ptr.To(resource.MustParse("1Mi")),
+				Storage:     ptr.To(kgateway.CacheStorageInMemory),
+			},
+			want: &cachingIR{
+				config: &cachev3.CacheConfig{
+					TypedConfig: storage,
+					AllowedVaryHeaders: []*envoymatcherv3.StringMatcher{{
+						MatchPattern: &envoymatcherv3.StringMatcher_Exact{Exact: "Accept-Encoding"},
+						IgnoreCase:   true,
+					}},
+					KeyCreatorParams: &cachev3.CacheConfig_KeyCreatorParams{
+						ExcludeHost:             true,
+						QueryParametersIncluded: []*envoyroutev3.QueryParameterMatcher{{Name: "page"}},
+					},
+					MaxBodyBytes: 1024 * 1024,
+				},
+				ttl: cachingTTLMatcher("max-age=300"),
+			},
+		},
+		{
+			name: "disable",
+			in: &kgateway.Caching{
+				Disable: &shared.PolicyDisable{},
+			},
+			want: &cachingIR{
+				disable: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			out := &trafficPolicySpecIr{}
+			err := constructCaching(kgateway.TrafficPolicySpec{
+				Caching: tt.in,
+			}, out)
+			a.NoError(err)
+
+			a.True(tt.want.Equals(out.caching))
+			a.NoError(out.caching.Validate())
+		})
+	}
+}
+
+func TestHandleCaching(t *testing.T) {
+	a := assert.New(t)
+
+	enabled := &trafficPolicySpecIr{}
+	a.NoError(constructCaching(kgateway.TrafficPolicySpec{
+		Caching: &kgateway.Caching{TTL: &metav1.Duration{Duration: time.Minute}},
+	}, enabled))
+	disabled := &trafficPolicySpecIr{}
+	a.NoError(constructCaching(kgateway.TrafficPolicySpec{
+		Caching: &kgateway.Caching{Disable: &shared.PolicyDisable{}},
+	}, disabled))
+
+	p := &trafficPolicyPluginGwPass{}
+
+	enabledRoute := ir.TypedFilterConfigMap{}
+	p.handleCaching("fc", &enabledRoute, enabled.caching)
+	a.Equal(EnableFilterPerRoute(), enabledRoute.GetTypedConfig(cacheFilterName))
+	a.Contains(p.cacheInChain, "fc")
+
+	disabledRoute := ir.TypedFilterConfigMap{}
+	p.handleCaching("fc", &disabledRoute, disabled.caching)
+	a.Equal(DisableFilterPerRoute(), disabledRoute.GetTypedConfig(cacheFilterName))
+
+	a.Equal(enabled.caching.ttl, enabledRoute.GetTypedConfig(cachingTTLFilterName))
+	a.Contains(p.cachingTTLInChain, "fc")
+	a.Equal(DisableFilterPerRoute(), disabledRoute.GetTypedConfig(cachingTTLFilterName))
+
+	// the header is only added to the cacheable responses
+	matcher := enabled.caching.ttl.GetXdsMatcher().GetMatcherList().GetMatchers()[0]
+	predicates := matcher.GetPredicate().GetAndMatcher().GetPredicate()
+	a.Len(predicates, 2)
+	a.Equal(cacheableMethodsRegex, predicates[0].GetSinglePredicate().GetValueMatch().GetSafeRegex().GetRegex())
+	a.Equal(cacheableStatusesRegex, predicates[1].GetSinglePredicate().GetValueMatch().GetSafeRegex().GetRegex())
+
+	action := &envoycompositev3.ExecuteFilterAction{}
+	a.NoError(matcher.GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(action))
+	mutation := &header_mutationv3.HeaderMutation{}
+	a.NoError(action.GetTypedConfig().GetTypedConfig().UnmarshalTo(mutation))
+	header := mutation.GetMutations().GetResponseMutations()[0].GetAppend()
+	a.Equal("max-age=60", header.GetHeader().GetValue())
+	a.Equal(envoycorev3.HeaderValueOption_ADD_IF_ABSENT, header.GetAppendAction())
+}
+
+func TestReportCachingConflicts(t *testing.T) {
+	gk := wellknown.TrafficPolicyGVK.GroupKind()
+	policy := func(name string, caching *kgateway.Caching) ir.PolicyWrapper {
+		return ir.PolicyWrapper{
+			ObjectSource: ir.ObjectSource{Group: gk.Group, Kind: gk.Kind, Namespace: "default", Name: name},
+			Policy: &kgateway.TrafficPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name},
+				Spec:       kgateway.TrafficPolicySpec{Caching: caching},
+			},
+		}
+	}
+	mock := krttest.NewMock(t, []any{
+		policy("small", &kgateway.Caching{MaxBodySize: ptr.To(resource.MustParse("1Mi"))}),
+		policy("small-ttl", &kgateway.Caching{MaxBodySize: ptr.To(resource.MustParse("1Mi")), TTL: &metav1.Duration{Duration: time.Minute}}),
+		policy("large", &kgateway.Caching{MaxBodySize: ptr.To(resource.MustParse("1Gi"))}),
+		policy("disabled", &kgateway.Caching{Disable: &shared.PolicyDisable{}}),
+		policy("other-gateway", &kgateway.Caching{}),
+	})
+
+	reportMap := reports.NewReportMap()
+	rp := reports.NewReporter(&reportMap)
+	accept := func(name, gateway string) {
+		rp.Policy(reporter.PolicyKey{Group: gk.Group, Kind: gk.Kind, Namespace: "default", Name: name}, 1).
+			AncestorRef(gwv1.ParentReference{Name: gwv1.ObjectName(gateway)}).
+			SetCondition(reporter.PolicyCondition{
+				Type:   string(shared.PolicyConditionAccepted),
+				Status: metav1.ConditionTrue,
+				Reason: string(shared.PolicyReasonValid),
+			})
+	}
+	accept("small", "gw")
+	accept("small-ttl", "gw")
+	accept("large", "gw")
+	accept("disabled", "gw")
+	accept("other-gateway", "other")
+
+	reportFilterChainConflicts(krt.TestingDummyContext{}, gk, krttest.GetMockCollection[ir.PolicyWrapper](mock), &reportMap, "caching", cachingSettings)
+
+	reason := func(name string) (string, string) {
+		for _, ancestor := range reportMap.Policies[reporter.PolicyKey{Group: gk.Group, Kind: gk.Kind, Namespace: "default", Name: name}].Ancestors {
+			cond := meta.FindStatusCondition(ancestor.Conditions, string(shared.PolicyConditionAccepted))
+			return cond.Reason, cond.Message
+		}
+		return "", ""
+	}
+
+	a := assert.New(t)
+	r, msg := reason("small")
+	a.Equal(string(shared.PolicyReasonConflicted), r)
+	a.Contains(msg, "default/large")
+	a.NotContains(msg, "default/small-ttl", "the ttl is not shared by the routes")
+	r, msg = reason("large")
+	a.Equal(string(shared.PolicyReasonConflicted), r)
+	a.Contains(msg, "default/small, default/small-ttl")
+	r, _ = reason("disabled")
+	a.Equal(string(shared.PolicyReasonValid), r)
+	r, _ = reason("other-gateway")
+	a.Equal(string(shared.PolicyReasonValid), r)
+}
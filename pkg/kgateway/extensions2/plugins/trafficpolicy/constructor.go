@@ -3,6 +3,7 @@ package trafficpolicy
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/types"
@@ -13,6 +14,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/collections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
 )
 
 // FetchGatewayExtensionFunc defines the signature for fetching gateway extensions
@@ -22,6 +24,8 @@ type TrafficPolicyConstructor struct {
 	commoncol         *collections.CommonCollections
 	gatewayExtensions krt.Collection[TrafficPolicyGatewayExtensionIR]
 	extBuilder        func(krtctx krt.HandlerContext, gExt ir.GatewayExtension) *TrafficPolicyGatewayExtensionIR
+	wasmImages        *wasmImageFetcher
+	wasmModuleServer  wasmModuleServer
 }
 
 func NewTrafficPolicyConstructor(
@@ -33,10 +37,13 @@ func NewTrafficPolicyConstructor(
 		return extBuilder(krtctx, gExt)
 	}
 	gatewayExtensions := krt.NewCollection(commoncol.GatewayExtensions, defaultExtBuilder)
+	wasmImages.startRefresh(ctx)
 	return &TrafficPolicyConstructor{
 		commoncol:         commoncol,
 		gatewayExtensions: gatewayExtensions,
 		extBuilder:        extBuilder,
+		wasmImages:        wasmImages,
+		wasmModuleServer:  newWasmModuleServer(commoncol.Settings),
 	}
 }
 
@@ -77,6 +84,8 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	constructCSRF(policyCR.Spec, &outSpec)
 	// Construct compression/decompression specific IR
 	constructCompression(policyCR.Spec, &outSpec)
+	// Construct gRPC-Web specific IR
+	constructGrpcWeb(policyCR.Spec, &outSpec)
 
 	// Construct header modifiers specific IR
 	constructHeaderModifiers(policyCR.Spec, &outSpec)
@@ -84,8 +93,12 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 	constructHeaderModifiers(policyCR.Spec, &outSpec)
 	// Construct auto host rewrite specific IR
 	constructAutoHostRewrite(policyCR.Spec, &outSpec)
+	// Construct host rewrite specific IR
+	constructHostRewrite(policyCR.Spec, &outSpec)
 	// Construct buffer specific IR
 	constructBuffer(policyCR.Spec, &outSpec)
+	// Construct fault injection specific IR
+	constructFaultInjection(policyCR.Spec, &outSpec)
 	// Construct timeout and retry specific IR
 	constructTimeoutRetry(policyCR.Spec, &outSpec)
 
@@ -99,6 +112,16 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 		errors = append(errors, err)
 	}
 
+	// Construct request validation specific IR
+	if err := constructRequestValidation(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
+	// Construct body match specific IR
+	if err := constructBodyMatch(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
 	// Construct API key auth specific IR
 	if err := constructAPIKeyAuth(krtctx, policyCR, c.commoncol, &outSpec); err != nil {
 		errors = append(errors, err)
@@ -113,17 +136,54 @@ func (c *TrafficPolicyConstructor) ConstructIR(
 		errors = append(errors, err)
 	}
 
+	// Construct mirroring specific IR
+	if err := constructMirroring(krtctx, policyCR, c.commoncol.BackendIndex, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
+	// Construct caching specific IR
+	if err := constructCaching(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
+	// Construct admission control specific IR
+	if err := constructAdmissionControl(policyCR.Spec, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
+	// Construct lua specific IR
+	if err := constructLua(krtctx, policyCR, c.commoncol.ConfigMaps, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
+	// Construct wasm specific IR
+	if err := constructWasm(krtctx, policyCR, c.commoncol.BackendIndex, c.commoncol.Secrets, c.wasmImages, c.wasmModuleServer, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
+	// Construct tap specific IR
+	if err := constructTap(policyCR.Spec, c.commoncol.Settings.EnableTap, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
+
 	// Construct url rewrite specific IR
 	constructURLRewrite(policyCR.Spec, &outSpec)
+	// Construct redirect specific IR
+	constructRedirect(policyCR.Spec, &outSpec)
 	// Construct basic auth specific IR
 	if err := constructBasicAuth(krtctx, policyCR, &outSpec, c.commoncol.Secrets); err != nil {
 		errors = append(errors, err)
 	}
+	// Construct auth composition specific IR, after the authentication mechanisms it composes
+	if err := constructAuthComposition(policyCR, &outSpec); err != nil {
+		errors = append(errors, err)
+	}
 
 	for _, err := range errors {
 		logger.Error("error translating traffic policy", "namespace", policyCR.GetNamespace(), "name", policyCR.GetName(), "error", err)
 	}
 	policyIr.spec = outSpec
+	policyIr.expiry = pluginsdkutils.NewPolicyExpiry(krtctx, policyCR.Spec.ExpiresAt, time.Now())
 
 	return &policyIr, errors
 }
@@ -312,3 +312,24 @@ func TestExtAuthPolicyPlugin(t *testing.T) {
 		assert.NotEmpty(t, pCtx.TypedFilterConfig[ExtAuthGlobalDisableFilterName])
 	})
 }
+
+func TestBuildExtAuthzAuthorizationResponse(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		assert.Nil(t, buildExtAuthzAuthorizationResponse(nil))
+		assert.Nil(t, buildExtAuthzAuthorizationResponse(&kgateway.AuthorizationResponse{}))
+	})
+
+	t.Run("headers", func(t *testing.T) {
+		out := buildExtAuthzAuthorizationResponse(&kgateway.AuthorizationResponse{
+			HeadersToBackend:         []string{"x-user-id"},
+			HeadersToAppendToBackend: []string{"x-auth-groups"},
+			HeadersToClient:          []string{"www-authenticate"},
+			HeadersToClientOnSuccess: []string{"set-cookie"},
+		})
+		require.NotNil(t, out)
+		assert.Equal(t, "x-user-id", out.GetAllowedUpstreamHeaders().GetPatterns()[0].GetExact())
+		assert.Equal(t, "x-auth-groups", out.GetAllowedUpstreamHeadersToAppend().GetPatterns()[0].GetExact())
+		assert.Equal(t, "www-authenticate", out.GetAllowedClientHeaders().GetPatterns()[0].GetExact())
+		assert.Equal(t, "set-cookie", out.GetAllowedClientHeadersOnSuccess().GetPatterns()[0].GetExact())
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -133,6 +134,9 @@ func translateExtProcPerFilterConfig(
 	if extProc.ProcessingMode != nil {
 		overrides.ProcessingMode = toEnvoyProcessingMode(extProc.ProcessingMode)
 	}
+	if extProc.FailOpen != nil {
+		overrides.FailureModeAllow = wrapperspb.Bool(*extProc.FailOpen)
+	}
 
 	return &envoy_ext_proc_v3.ExtProcPerRoute{
 		Override: &envoy_ext_proc_v3.ExtProcPerRoute_Overrides{
@@ -7,6 +7,7 @@ import (
 	envoy_ext_proc_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_proc/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -184,6 +185,29 @@ func TestBuildEnvoyExtProc(t *testing.T) {
 				assert.Equal(t, envoy_ext_proc_v3.ProcessingMode_DEFAULT, processingMode.ResponseTrailerMode)
 			},
 		},
+		{
+			name: "with fail open override",
+			gatewayExt: &ir.GatewayExtension{
+				ExtProc: &kgateway.ExtProcProvider{
+					GrpcService: kgateway.ExtGrpcService{
+						BackendRef: gwv1.BackendRef{
+							BackendObjectReference: gwv1.BackendObjectReference{
+								Name: "test-service",
+							},
+						},
+					},
+					FailOpen: true,
+				},
+			},
+			extprocConfig: &kgateway.ExtProcPolicy{
+				FailOpen: ptr.To(false),
+			},
+			validateResult: func(t *testing.T, result *envoy_ext_proc_v3.ExtProcPerRoute) {
+				assert.Nil(t, result.GetOverrides().GetProcessingMode())
+				require.NotNil(t, result.GetOverrides().GetFailureModeAllow())
+				assert.False(t, result.GetOverrides().GetFailureModeAllow().GetValue())
+			},
+		},
 		{
 			name: "with invalid processing modes",
 			gatewayExt: &ir.GatewayExtension{
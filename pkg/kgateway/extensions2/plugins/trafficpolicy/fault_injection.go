@@ -0,0 +1,120 @@
+package trafficpolicy
+
+import (
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_fault_common_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const faultFilterName = "envoy.filters.http.fault"
+
+type faultInjectionIR struct {
+	policy  *envoy_fault_v3.HTTPFault
+	disable bool
+}
+
+var _ PolicySubIR = &faultInjectionIR{}
+
+func (f *faultInjectionIR) Equals(other PolicySubIR) bool {
+	otherFault, ok := other.(*faultInjectionIR)
+	if !ok {
+		return false
+	}
+	if f == nil || otherFault == nil {
+		return f == nil && otherFault == nil
+	}
+	if f.disable != otherFault.disable {
+		return false
+	}
+	return proto.Equal(f.policy, otherFault.policy)
+}
+
+func (f *faultInjectionIR) Validate() error {
+	if f == nil || f.policy == nil {
+		return nil
+	}
+	return f.policy.Validate()
+}
+
+// constructFaultInjection constructs the fault injection policy IR from the policy specification.
+func constructFaultInjection(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.FaultInjection == nil {
+		return
+	}
+
+	if spec.FaultInjection.Disable != nil {
+		out.faultInjection = &faultInjectionIR{
+			disable: true,
+		}
+		return
+	}
+
+	policy := &envoy_fault_v3.HTTPFault{}
+	if delay := spec.FaultInjection.Delay; delay != nil {
+		policy.Delay = &envoy_fault_common_v3.FaultDelay{
+			FaultDelaySecifier: &envoy_fault_common_v3.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(delay.FixedDelay.Duration),
+			},
+			Percentage: toFaultPercentage(delay.Percentage),
+		}
+	}
+	if abort := spec.FaultInjection.Abort; abort != nil {
+		policy.Abort = &envoy_fault_v3.FaultAbort{
+			ErrorType: &envoy_fault_v3.FaultAbort_HttpStatus{
+				HttpStatus: uint32(abort.StatusCode), // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+			},
+			Percentage: toFaultPercentage(abort.Percentage),
+		}
+	}
+
+	out.faultInjection = &faultInjectionIR{
+		policy: policy,
+	}
+}
+
+// toFaultPercentage converts the percentage of requests to inject a fault into, defaulting to all requests.
+func toFaultPercentage(percentage *int32) *envoy_type_v3.FractionalPercent {
+	numerator := uint32(100)
+	if percentage != nil {
+		numerator = uint32(*percentage) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	return &envoy_type_v3.FractionalPercent{
+		Numerator:   numerator,
+		Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+	}
+}
+
+// handleFaultInjection configures the per-route fault injection configuration and registers the disabled global filter
+func (p *trafficPolicyPluginGwPass) handleFaultInjection(
+	fcn string,
+	pCtxTypedFilterConfig *ir.TypedFilterConfigMap,
+	fault *faultInjectionIR,
+) {
+	if fault == nil {
+		return
+	}
+
+	// Handle disable case - disable the filter to override parent policy
+	if fault.disable {
+		pCtxTypedFilterConfig.AddTypedConfig(faultFilterName, &envoyroutev3.FilterConfig{Config: &anypb.Any{}, Disabled: true})
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(faultFilterName, fault.policy)
+
+	// Add a filter to the chain. When having a fault injection policy for a route we need to also have a
+	// globally disabled fault filter in the chain otherwise it will be ignored.
+	if p.faultInChain == nil {
+		p.faultInChain = make(map[string]*envoy_fault_v3.HTTPFault)
+	}
+	if _, ok := p.faultInChain[fcn]; !ok {
+		p.faultInChain[fcn] = &envoy_fault_v3.HTTPFault{}
+	}
+}
@@ -0,0 +1,146 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoy_fault_common_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+func TestConstructFaultInjection(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *kgateway.FaultInjection
+		want *faultInjectionIR
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "delay and abort",
+			in: &kgateway.FaultInjection{
+				Delay: &kgateway.FaultDelay{
+					FixedDelay: metav1.Duration{Duration: 2 * time.Second},
+					Percentage: ptr.To(int32(10)),
+				},
+				Abort: &kgateway.FaultAbort{
+					StatusCode: 503,
+				},
+			},
+			want: &faultInjectionIR{
+				policy: &envoy_fault_v3.HTTPFault{
+					Delay: &envoy_fault_common_v3.FaultDelay{
+						FaultDelaySecifier: &envoy_fault_common_v3.FaultDelay_FixedDelay{
+							FixedDelay: durationpb.New(2 * time.Second),
+						},
+						Percentage: &envoy_type_v3.FractionalPercent{
+							Numerator:   10,
+							Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+						},
+					},
+					Abort: &envoy_fault_v3.FaultAbort{
+						ErrorType: &envoy_fault_v3.FaultAbort_HttpStatus{
+							HttpStatus: 503,
+						},
+						Percentage: &envoy_type_v3.FractionalPercent{
+							Numerator:   100,
+							Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "disable",
+			in: &kgateway.FaultInjection{
+				Disable: &shared.PolicyDisable{},
+			},
+			want: &faultInjectionIR{
+				disable: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			out := &trafficPolicySpecIr{}
+			constructFaultInjection(kgateway.TrafficPolicySpec{
+				FaultInjection: tt.in,
+			}, out)
+
+			a.True(tt.want.Equals(out.faultInjection))
+			a.NoError(out.faultInjection.Validate())
+		})
+	}
+}
+
+func TestFaultInjectionIREquals(t *testing.T) {
+	abort := func(status int32) *kgateway.FaultInjection {
+		return &kgateway.FaultInjection{
+			Abort: &kgateway.FaultAbort{StatusCode: status},
+		}
+	}
+
+	tests := []struct {
+		name string
+		a, b *kgateway.FaultInjection
+		want bool
+	}{
+		{
+			name: "both nil are equal",
+			want: true,
+		},
+		{
+			name: "nil vs non-nil are not equal",
+			a:    abort(503),
+			want: false,
+		},
+		{
+			name: "non-nil and equal",
+			a:    abort(503),
+			b:    abort(503),
+			want: true,
+		},
+		{
+			name: "non-nil and not equal",
+			a:    abort(503),
+			b:    abort(500),
+			want: false,
+		},
+		{
+			name: "disable vs abort are not equal",
+			a:    &kgateway.FaultInjection{Disable: &shared.PolicyDisable{}},
+			b:    abort(503),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			aOut := &trafficPolicySpecIr{}
+			constructFaultInjection(kgateway.TrafficPolicySpec{
+				FaultInjection: tt.a,
+			}, aOut)
+
+			bOut := &trafficPolicySpecIr{}
+			constructFaultInjection(kgateway.TrafficPolicySpec{
+				FaultInjection: tt.b,
+			}, bOut)
+
+			a.Equal(tt.want, aOut.faultInjection.Equals(bOut.faultInjection))
+		})
+	}
+}
@@ -23,7 +23,6 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
-	corev1 "k8s.io/api/core/v1"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -118,6 +117,7 @@ func TranslateGatewayExtensionBuilder(
 ) func(krtctx krt.HandlerContext, gExt ir.GatewayExtension) *TrafficPolicyGatewayExtensionIR {
 	oidcDiscoverer := newOIDCProviderConfigDiscoverer()
 	go oidcDiscoverer.refresh(ctx)
+	localJwks := NewLocalJwksCollection(commoncol.ConfigMaps.Collection(), commoncol.GatewayExtensions, commoncol.KrtOpts)
 
 	return func(krtctx krt.HandlerContext, gExt ir.GatewayExtension) *TrafficPolicyGatewayExtensionIR {
 		p := &TrafficPolicyGatewayExtensionIR{
@@ -202,7 +202,7 @@ func TranslateGatewayExtensionBuilder(
 		case gExt.JWT != nil:
 			jwtConfig, err := resolveJwtProviders(
 				krtctx,
-				commoncol.ConfigMaps.Collection(),
+				localJwks,
 				commoncol.BackendIndex,
 				gExt.ObjectSource,
 				gExt.Name,
@@ -229,7 +229,7 @@ func TranslateGatewayExtensionBuilder(
 
 func resolveJwtProviders(
 	krtctx krt.HandlerContext,
-	configMaps krt.Collection[*corev1.ConfigMap],
+	localJwks krt.Collection[LocalJwks],
 	backendResolver backendResolver,
 	gwExtObj ir.ObjectSource,
 	policyName, policyNamespace string,
@@ -244,7 +244,7 @@ func resolveJwtProviders(
 			krtctx,
 			provider.JWTProvider,
 			policyNamespace,
-			configMaps,
+			localJwks,
 			backendResolver,
 			gwExtObj,
 		)
@@ -256,7 +256,7 @@ func resolveJwtProviders(
 
 	requirementsName := fmt.Sprintf("%s_requirements", policyNameNamespace)
 	requirements := make(map[string]*envoyjwtauthnv3.JwtRequirement)
-	requirements[requirementsName] = buildJwtRequirementFromProviders(uniqProviders, jwt.ValidationMode)
+	requirements[requirementsName] = buildJwtRequirementFromProviders(uniqProviders, jwt.ValidationMode, jwt.ProviderRequirement)
 
 	return &envoyjwtauthnv3.JwtAuthentication{
 		RequirementMap: requirements,
@@ -391,15 +391,30 @@ func ResolveExtHttpService(
 	}
 
 	// Configure authorization response
-	if httpService.AuthorizationResponse != nil && len(httpService.AuthorizationResponse.HeadersToBackend) > 0 {
-		envoyHttpService.AuthorizationResponse = &envoy_ext_authz_v3.AuthorizationResponse{
-			AllowedUpstreamHeaders: buildStringListMatcher(httpService.AuthorizationResponse.HeadersToBackend),
-		}
-	}
+	envoyHttpService.AuthorizationResponse = buildExtAuthzAuthorizationResponse(httpService.AuthorizationResponse)
 
 	return envoyHttpService, nil
 }
 
+// buildExtAuthzAuthorizationResponse configures which headers of the authorization response are
+// sent to the upstream and to the client.
+func buildExtAuthzAuthorizationResponse(in *kgateway.AuthorizationResponse) *envoy_ext_authz_v3.AuthorizationResponse {
+	if in == nil {
+		return nil
+	}
+
+	out := &envoy_ext_authz_v3.AuthorizationResponse{
+		AllowedUpstreamHeaders:         buildStringListMatcher(in.HeadersToBackend),
+		AllowedUpstreamHeadersToAppend: buildStringListMatcher(in.HeadersToAppendToBackend),
+		AllowedClientHeaders:           buildStringListMatcher(in.HeadersToClient),
+		AllowedClientHeadersOnSuccess:  buildStringListMatcher(in.HeadersToClientOnSuccess),
+	}
+	if proto.Equal(out, &envoy_ext_authz_v3.AuthorizationResponse{}) {
+		return nil
+	}
+	return out
+}
+
 func buildExtSvcRetryPolicy(in *kgateway.ExtSvcRetryPolicy) *envoycorev3.RetryPolicy {
 	if in == nil {
 		return nil
@@ -454,6 +469,10 @@ func buildRateLimitFilter(grpcService *envoycorev3.GrpcService, rateLimit *kgate
 	// Set timeout (we expect it always to have a valid value or default due to CRD validation)
 	envoyRateLimit.Timeout = durationpb.New(rateLimit.Timeout.Duration)
 
+	if rateLimit.LimitExceededStatusCode != nil {
+		envoyRateLimit.RateLimitedStatus = &envoytypev3.HttpStatus{Code: envoytypev3.StatusCode(*rateLimit.LimitExceededStatusCode)}
+	}
+
 	return envoyRateLimit
 }
 
@@ -509,6 +528,11 @@ func buildCompositeExtProcFilter(in kgateway.ExtProcProvider, envoyGrpcService *
 				Untyped: in.MetadataOptions.Forwarding.Untyped,
 			}
 		}
+		if in.MetadataOptions.Receiving != nil {
+			filter.MetadataOptions.ReceivingNamespaces = &envoyextprocv3.MetadataOptions_MetadataNamespaces{
+				Untyped: in.MetadataOptions.Receiving.Untyped,
+			}
+		}
 	}
 	return buildCompositeFilter(
 		"composite_ext_proc",
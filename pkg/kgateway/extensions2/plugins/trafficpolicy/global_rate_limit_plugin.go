@@ -3,10 +3,14 @@ package trafficpolicy
 import (
 	"errors"
 	"fmt"
+	"strings"
 
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	ratev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
@@ -15,6 +19,8 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
+const rateLimitFilterEnforcedRuntimeKey = "rate_limit_enforced"
+
 // globalRateLimitIR represents the intermediate representation for a global rate limit policy.
 type globalRateLimitIR struct {
 	provider         *TrafficPolicyGatewayExtensionIR
@@ -96,18 +102,58 @@ func constructGlobalRateLimit(
 	if gwExtIR.RateLimit == nil {
 		return pluginutils.ErrInvalidExtensionType(kgateway.GatewayExtensionTypeRateLimit)
 	}
+	rateLimit := &envoyroutev3.RateLimit{
+		Actions: actions,
+	}
+	if globalPolicy.Stage != nil {
+		rateLimit.Stage = wrapperspb.UInt32(uint32(*globalPolicy.Stage)) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
 	// Create route rate limits and store in the RateLimitIR struct
 	out.globalRateLimit = &globalRateLimitIR{
-		provider: gwExtIR,
-		rateLimitActions: []*envoyroutev3.RateLimit{
-			{
-				Actions: actions,
-			},
-		},
+		provider:         rateLimitProviderVariant(gwExtIR, globalPolicy),
+		rateLimitActions: []*envoyroutev3.RateLimit{rateLimit},
 	}
 	return nil
 }
 
+// rateLimitProviderVariant returns the provider with the stage, mode and failure mode of the policy applied
+// to its rate limit filter. Since these are settings of the filter, policies with different settings use
+// separate filters, named after the provider and the settings.
+func rateLimitProviderVariant(
+	provider *TrafficPolicyGatewayExtensionIR,
+	policy *kgateway.RateLimitPolicy,
+) *TrafficPolicyGatewayExtensionIR {
+	shadow := policy.Mode != nil && *policy.Mode == kgateway.RateLimitModeShadow
+	if policy.Stage == nil && !shadow && policy.FailureMode == nil {
+		return provider
+	}
+
+	variant := *provider
+	variant.RateLimit = proto.Clone(provider.RateLimit).(*ratev3.RateLimit)
+	var suffixes []string
+	if policy.Stage != nil {
+		variant.RateLimit.Stage = uint32(*policy.Stage) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+		suffixes = append(suffixes, fmt.Sprintf("stage-%d", *policy.Stage))
+	}
+	if shadow {
+		// the rate limit service is still called, but its decisions are not enforced
+		variant.RateLimit.FilterEnforced = &envoycorev3.RuntimeFractionalPercent{
+			RuntimeKey: rateLimitFilterEnforcedRuntimeKey,
+			DefaultValue: &typev3.FractionalPercent{
+				Numerator:   0,
+				Denominator: typev3.FractionalPercent_HUNDRED,
+			},
+		}
+		suffixes = append(suffixes, "shadow")
+	}
+	if policy.FailureMode != nil {
+		variant.RateLimit.FailureModeDeny = *policy.FailureMode == kgateway.RateLimitFailureModeFailClosed
+		suffixes = append(suffixes, strings.ToLower(string(*policy.FailureMode)))
+	}
+	variant.Name = provider.Name + "~" + strings.Join(suffixes, "~")
+	return &variant
+}
+
 // createRateLimitActions translates the API descriptors to Envoy route config rate limit actions
 func createRateLimitActions(descriptors []kgateway.RateLimitDescriptor) ([]*envoyroutev3.RateLimit_Action, error) {
 	if len(descriptors) == 0 {
@@ -589,3 +589,40 @@ func TestToRateLimitFilterConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestRateLimitProviderVariant(t *testing.T) {
+	provider := &TrafficPolicyGatewayExtensionIR{
+		Name: "default/ratelimit",
+		RateLimit: &ratev3.RateLimit{
+			Domain:          "test-domain",
+			FailureModeDeny: false,
+		},
+	}
+
+	t.Run("without settings", func(t *testing.T) {
+		assert.Same(t, provider, rateLimitProviderVariant(provider, &kgateway.RateLimitPolicy{}))
+	})
+
+	t.Run("with stage, shadow mode and failure mode", func(t *testing.T) {
+		variant := rateLimitProviderVariant(provider, &kgateway.RateLimitPolicy{
+			Stage:       ptr.To(int32(2)),
+			Mode:        ptr.To(kgateway.RateLimitModeShadow),
+			FailureMode: ptr.To(kgateway.RateLimitFailureModeFailClosed),
+		})
+
+		assert.Equal(t, "default/ratelimit~stage-2~shadow~failclosed", variant.Name)
+		assert.Equal(t, "test-domain", variant.RateLimit.GetDomain())
+		assert.Equal(t, uint32(2), variant.RateLimit.GetStage())
+		assert.True(t, variant.RateLimit.GetFailureModeDeny())
+		assert.Equal(t, uint32(0), variant.RateLimit.GetFilterEnforced().GetDefaultValue().GetNumerator())
+		// the provider shared with the other policies is not modified
+		assert.False(t, provider.RateLimit.GetFailureModeDeny())
+		assert.Nil(t, provider.RateLimit.GetFilterEnforced())
+	})
+
+	t.Run("enforce mode", func(t *testing.T) {
+		assert.Same(t, provider, rateLimitProviderVariant(provider, &kgateway.RateLimitPolicy{
+			Mode: ptr.To(kgateway.RateLimitModeEnforce),
+		}))
+	})
+}
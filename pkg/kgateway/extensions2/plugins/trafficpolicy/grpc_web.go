@@ -0,0 +1,58 @@
+package trafficpolicy
+
+import (
+	grpcwebv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const grpcWebFilterName = "envoy.filters.http.grpc_web"
+
+type grpcWebIR struct {
+	enable bool
+}
+
+var _ PolicySubIR = &grpcWebIR{}
+
+func (g *grpcWebIR) Equals(other PolicySubIR) bool {
+	og, ok := other.(*grpcWebIR)
+	if !ok {
+		return false
+	}
+	if g == nil || og == nil {
+		return g == nil && og == nil
+	}
+	return g.enable == og.enable
+}
+
+func (g *grpcWebIR) Validate() error { return nil }
+
+// constructGrpcWeb builds the IR enabling or disabling gRPC-Web translation.
+func constructGrpcWeb(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	if spec.GrpcWeb == nil {
+		return
+	}
+	out.grpcWeb = &grpcWebIR{enable: spec.GrpcWeb.Disable == nil}
+}
+
+// handleGrpcWeb enables or disables the gRPC-Web filter for the route or virtual host, and registers the
+// disabled gRPC-Web filter in the filter chain. The filter has no configuration.
+func (p *trafficPolicyPluginGwPass) handleGrpcWeb(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, grpcWeb *grpcWebIR) {
+	if grpcWeb == nil {
+		return
+	}
+
+	if !grpcWeb.enable {
+		pCtxTypedFilterConfig.AddTypedConfig(grpcWebFilterName, DisableFilterPerRoute())
+		return
+	}
+	pCtxTypedFilterConfig.AddTypedConfig(grpcWebFilterName, EnableFilterPerRoute())
+
+	if p.grpcWebInChain == nil {
+		p.grpcWebInChain = make(map[string]*grpcwebv3.GrpcWeb)
+	}
+	if _, ok := p.grpcWebInChain[fcn]; !ok {
+		p.grpcWebInChain[fcn] = &grpcwebv3.GrpcWeb{}
+	}
+}
@@ -0,0 +1,56 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructGrpcWeb(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *kgateway.GrpcWeb
+		want *grpcWebIR
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "enabled",
+			in:   &kgateway.GrpcWeb{},
+			want: &grpcWebIR{enable: true},
+		},
+		{
+			name: "disabled",
+			in:   &kgateway.GrpcWeb{Disable: &shared.PolicyDisable{}},
+			want: &grpcWebIR{enable: false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &trafficPolicySpecIr{}
+			constructGrpcWeb(kgateway.TrafficPolicySpec{GrpcWeb: tt.in}, out)
+			assert.True(t, tt.want.Equals(out.grpcWeb))
+		})
+	}
+}
+
+func TestHandleGrpcWeb(t *testing.T) {
+	a := assert.New(t)
+	p := &trafficPolicyPluginGwPass{}
+
+	disabledRoute := ir.TypedFilterConfigMap{}
+	p.handleGrpcWeb("fc", &disabledRoute, &grpcWebIR{enable: false})
+	a.Equal(DisableFilterPerRoute(), disabledRoute.GetTypedConfig(grpcWebFilterName))
+	a.NotContains(p.grpcWebInChain, "fc", "a disabled route does not need the filter in the chain")
+
+	enabledRoute := ir.TypedFilterConfigMap{}
+	p.handleGrpcWeb("fc", &enabledRoute, &grpcWebIR{enable: true})
+	a.Equal(EnableFilterPerRoute(), enabledRoute.GetTypedConfig(grpcWebFilterName))
+	a.Contains(p.grpcWebInChain, "fc")
+}
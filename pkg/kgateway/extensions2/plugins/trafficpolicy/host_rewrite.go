@@ -0,0 +1,58 @@
+package trafficpolicy
+
+import (
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+)
+
+// hostRewriteIR rewrites the Host header to either a literal value or the value of another request header
+type hostRewriteIR struct {
+	literal string
+	header  string
+}
+
+var _ PolicySubIR = &hostRewriteIR{}
+
+func (h *hostRewriteIR) Equals(other PolicySubIR) bool {
+	otherHostRewrite, ok := other.(*hostRewriteIR)
+	if !ok {
+		return false
+	}
+	if h == nil || otherHostRewrite == nil {
+		return h == nil && otherHostRewrite == nil
+	}
+	return h.literal == otherHostRewrite.literal && h.header == otherHostRewrite.header
+}
+
+// Validate performs validation on the host rewrite component. No validation is
+// needed as the values are validated by the CRD.
+func (h *hostRewriteIR) Validate() error { return nil }
+
+// constructHostRewrite constructs the host rewrite policy IR from the policy specification.
+func constructHostRewrite(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	switch {
+	case spec.HostRewrite != nil:
+		out.hostRewrite = &hostRewriteIR{literal: *spec.HostRewrite}
+	case spec.HostRewriteHeader != nil:
+		out.hostRewrite = &hostRewriteIR{header: string(*spec.HostRewriteHeader)}
+	}
+}
+
+// applyHostRewrite sets the host rewrite of the route, unless a host rewrite is already set,
+// e.g. by the hostname of an HTTPRoute URLRewrite filter.
+func applyHostRewrite(in *hostRewriteIR, action *envoyroutev3.RouteAction) {
+	if in == nil || action.GetHostRewriteSpecifier() != nil {
+		return
+	}
+	switch {
+	case in.literal != "":
+		action.HostRewriteSpecifier = &envoyroutev3.RouteAction_HostRewriteLiteral{
+			HostRewriteLiteral: in.literal,
+		}
+	case in.header != "":
+		action.HostRewriteSpecifier = &envoyroutev3.RouteAction_HostRewriteHeader{
+			HostRewriteHeader: in.header,
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestApplyForRoute_HostRewrite(t *testing.T) {
+	plugin := &trafficPolicyPluginGwPass{}
+
+	applyPolicy := func(t *testing.T, spec kgateway.TrafficPolicySpec, action *envoyroutev3.RouteAction) *envoyroutev3.RouteAction {
+		t.Helper()
+		policy := &TrafficPolicy{}
+		constructHostRewrite(spec, &policy.spec)
+		out := &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_Route{Route: action},
+		}
+		require.NoError(t, plugin.ApplyForRoute(&ir.RouteContext{Policy: policy}, out))
+		return out.GetRoute()
+	}
+
+	t.Run("literal", func(t *testing.T) {
+		ra := applyPolicy(t, kgateway.TrafficPolicySpec{HostRewrite: ptr.To("example.com")}, &envoyroutev3.RouteAction{})
+		assert.Equal(t, "example.com", ra.GetHostRewriteLiteral())
+	})
+
+	t.Run("header", func(t *testing.T) {
+		ra := applyPolicy(t, kgateway.TrafficPolicySpec{HostRewriteHeader: ptr.To(gwv1.HeaderName("x-forwarded-host"))}, &envoyroutev3.RouteAction{})
+		assert.Equal(t, "x-forwarded-host", ra.GetHostRewriteHeader())
+	})
+
+	t.Run("URLRewrite hostname takes precedence", func(t *testing.T) {
+		ra := applyPolicy(t, kgateway.TrafficPolicySpec{HostRewrite: ptr.To("example.com")}, &envoyroutev3.RouteAction{
+			HostRewriteSpecifier: &envoyroutev3.RouteAction_HostRewriteLiteral{HostRewriteLiteral: "filter.example.com"},
+		})
+		assert.Equal(t, "filter.example.com", ra.GetHostRewriteLiteral())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		ra := applyPolicy(t, kgateway.TrafficPolicySpec{}, &envoyroutev3.RouteAction{})
+		assert.Nil(t, ra.GetHostRewriteSpecifier())
+	})
+}
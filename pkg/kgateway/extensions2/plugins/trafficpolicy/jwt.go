@@ -9,6 +9,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"slices"
 	"sort"
 
@@ -21,12 +22,14 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/pluginutils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
 	"github.com/kgateway-dev/kgateway/v2/pkg/utils/cmputils"
 )
 
@@ -184,7 +187,7 @@ func translateProvider(
 	krtctx krt.HandlerContext,
 	provider kgateway.JWTProvider,
 	policyNs string,
-	configMaps krt.Collection[*corev1.ConfigMap],
+	localJwks krt.Collection[LocalJwks],
 	resolver backendResolver,
 	gwExtObj ir.ObjectSource,
 ) (*jwtauthnv3.JwtProvider, error) {
@@ -211,7 +214,7 @@ func translateProvider(
 		jwtProvider.ClearRouteCache = true
 	}
 	translateTokenSource(provider, jwtProvider)
-	err := translateJwks(krtctx, provider.JWKS, policyNs, jwtProvider, configMaps, resolver, gwExtObj)
+	err := translateJwks(krtctx, provider.JWKS, policyNs, jwtProvider, localJwks, resolver, gwExtObj)
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +251,7 @@ func translateJwks(
 	jwkConfig kgateway.JWKS,
 	policyNs string,
 	out *jwtauthnv3.JwtProvider,
-	configMaps krt.Collection[*corev1.ConfigMap],
+	localJwks krt.Collection[LocalJwks],
 	resolver backendResolver,
 	gwExtObj ir.ObjectSource,
 ) error {
@@ -262,15 +265,14 @@ func translateJwks(
 			}
 			out.JwksSourceSpecifier = jwkSource
 		case jwkConfig.LocalJWKS.ConfigMapRef != nil:
-			cm, err := GetConfigMap(krtctx, configMaps, jwkConfig.LocalJWKS.ConfigMapRef.Name, policyNs)
+			jwks, err := getLocalJwks(krtctx, localJwks, jwkConfig.LocalJWKS.ConfigMapRef.Name, policyNs)
 			if err != nil {
 				return fmt.Errorf("failed to find configmap %s: %v", jwkConfig.LocalJWKS.ConfigMapRef.Name, err)
 			}
-			jwkSource, err := translateJwksConfigMap(cm)
-			if err != nil {
-				return err
+			if jwks.Err != nil {
+				return jwks.Err
 			}
-			out.JwksSourceSpecifier = jwkSource
+			out.JwksSourceSpecifier = jwks.Jwks
 		}
 	case jwkConfig.RemoteJWKS != nil:
 		remote := jwkConfig.RemoteJWKS
@@ -305,6 +307,82 @@ func translateJwksConfigMap(cm *corev1.ConfigMap) (*jwtauthnv3.JwtProvider_Local
 	return translateJwksInline(data)
 }
 
+// LocalJwks is the JWKS compiled from the jwks key of a ConfigMap.
+type LocalJwks struct {
+	krt.Named
+	// Hash is the hash of the jwks key the JWKS is compiled from.
+	Hash uint64
+	Jwks *jwtauthnv3.JwtProvider_LocalJwks
+	Err  error
+}
+
+// Equals compares the content the JWKS is compiled from, so that the policies using the JWKS of a ConfigMap
+// are only translated again when its jwks key changes, and not on changes to its metadata or other keys.
+func (l LocalJwks) Equals(other LocalJwks) bool {
+	return l.Named == other.Named && l.Hash == other.Hash
+}
+
+// NewLocalJwksCollection returns the JWKS compiled from the ConfigMaps referenced by the JWT providers of
+// the GatewayExtensions. ConfigMaps that no GatewayExtension references are skipped, so the JWKS is neither
+// compiled nor kept in memory for unrelated ConfigMaps.
+func NewLocalJwksCollection(
+	configMaps krt.Collection[*corev1.ConfigMap],
+	gwExts krt.Collection[ir.GatewayExtension],
+	krtopts krtutil.KrtOptions,
+) krt.Collection[LocalJwks] {
+	byConfigMap := krt.NewIndex(gwExts, "jwksConfigMap", jwksConfigMapRefs)
+	return krt.NewCollection(configMaps, func(krtctx krt.HandlerContext, cm *corev1.ConfigMap) *LocalJwks {
+		key := types.NamespacedName{Namespace: cm.Namespace, Name: cm.Name}
+		if len(krt.Fetch(krtctx, gwExts, krt.FilterIndex(byConfigMap, key))) == 0 {
+			return nil
+		}
+		return ptr.To(compileLocalJwks(cm))
+	}, krtopts.ToOptions("LocalJwks")...)
+}
+
+// jwksConfigMapRefs returns the ConfigMaps the JWT providers of the GatewayExtension load their JWKS from.
+func jwksConfigMapRefs(gExt ir.GatewayExtension) []types.NamespacedName {
+	if gExt.JWT == nil {
+		return nil
+	}
+	var refs []types.NamespacedName
+	for _, provider := range gExt.JWT.Providers {
+		local := provider.JWKS.LocalJWKS
+		if local == nil || local.ConfigMapRef == nil {
+			continue
+		}
+		refs = append(refs, types.NamespacedName{Namespace: gExt.Namespace, Name: local.ConfigMapRef.Name})
+	}
+	return refs
+}
+
+func compileLocalJwks(cm *corev1.ConfigMap) LocalJwks {
+	out := LocalJwks{
+		Named: krt.NewNamed(cm),
+	}
+	data, ok := cm.Data[jwtConfigMapKey]
+	if !ok {
+		out.Err = fmt.Errorf("configmap key '%s' not found", jwtConfigMapKey)
+		return out
+	}
+	h := fnv.New64a()
+	h.Write([]byte(data))
+	out.Hash = h.Sum64()
+	out.Jwks, out.Err = translateJwksConfigMap(cm)
+	return out
+}
+
+func getLocalJwks(krtctx krt.HandlerContext, localJwks krt.Collection[LocalJwks], cmName, ns string) (LocalJwks, error) {
+	if localJwks == nil {
+		return LocalJwks{}, errors.New("configmaps collection not available")
+	}
+	obj := krt.FetchOne(krtctx, localJwks, krt.FilterKey(types.NamespacedName{Namespace: ns, Name: cmName}.String()))
+	if obj == nil {
+		return LocalJwks{}, &krtcollections.NotFoundError{NotFoundObj: ir.ObjectSource{Group: "", Kind: "ConfigMap", Namespace: ns, Name: cmName}}
+	}
+	return *obj, nil
+}
+
 func translateJwksInline(inlineKey string) (*jwtauthnv3.JwtProvider_LocalJwks, error) {
 	keyset, err := TranslateKey(inlineKey)
 	if err != nil {
@@ -413,6 +491,7 @@ func parsePem(key string) (*jose.JSONWebKeySet, error) {
 func buildJwtRequirementFromProviders(
 	providersMap map[string]*jwtauthnv3.JwtProvider,
 	validationMode *kgateway.ValidationMode,
+	providerRequirement *kgateway.JWTProviderRequirement,
 ) *jwtauthnv3.JwtRequirement {
 	var reqs []*jwtauthnv3.JwtRequirement
 	for providerName := range providersMap {
@@ -430,6 +509,15 @@ func buildJwtRequirementFromProviders(
 	// if there is only one requirement, return it directly
 	if len(reqs) == 1 {
 		jwtReqs = reqs[0]
+	} else if ptr.Deref(providerRequirement, kgateway.JWTProviderRequirementAny) == kgateway.JWTProviderRequirementAll {
+		// Requires All will AND the requirements
+		jwtReqs = &jwtauthnv3.JwtRequirement{
+			RequiresType: &jwtauthnv3.JwtRequirement_RequiresAll{
+				RequiresAll: &jwtauthnv3.JwtRequirementAndList{
+					Requirements: reqs,
+				},
+			},
+		}
 	} else {
 		// if there are multiple requirements, return a RequiresAny requirement. Requires Any will OR the requirements
 		jwtReqs = &jwtauthnv3.JwtRequirement{
@@ -464,17 +552,6 @@ func buildJwtRequirementFromProviders(
 	return jwtReqs
 }
 
-func GetConfigMap(krtctx krt.HandlerContext, configMaps krt.Collection[*corev1.ConfigMap], cmName, ns string) (*corev1.ConfigMap, error) {
-	if configMaps == nil {
-		return nil, errors.New("configmaps collection not available")
-	}
-	obj := krt.FetchOne(krtctx, configMaps, krt.FilterObjectName(types.NamespacedName{Namespace: ns, Name: cmName}))
-	if obj == nil {
-		return nil, &krtcollections.NotFoundError{NotFoundObj: ir.ObjectSource{Group: "", Kind: "ConfigMap", Namespace: ns, Name: cmName}}
-	}
-	return *obj, nil
-}
-
 func jwtFilterName(name string) string {
 	if name == "" {
 		return jwtFilterNamePrefix
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/test"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/krtutil"
 )
 
 func TestTranslateKey(t *testing.T) {
@@ -82,6 +84,7 @@ func TestBuildJwtRequirementFromProviders(t *testing.T) {
 		routeName       string
 		providers       map[string]*jwtauthnv3.JwtProvider
 		validationMode  *kgateway.ValidationMode
+		requirement     *kgateway.JWTProviderRequirement
 		expectedType    string
 		expectedCount   int
 		hasAllowMissing bool
@@ -132,11 +135,34 @@ func TestBuildJwtRequirementFromProviders(t *testing.T) {
 			expectedCount:   2, // requires_any with providers + allow missing
 			hasAllowMissing: true,
 		},
+		{
+			name:      "multiple providers requiring all",
+			routeName: "test-route",
+			providers: map[string]*jwtauthnv3.JwtProvider{
+				"provider1": {Issuer: "test-issuer-1"},
+				"provider2": {Issuer: "test-issuer-2"},
+			},
+			requirement:     ptr.To(kgateway.JWTProviderRequirementAll),
+			expectedType:    "requires_all",
+			expectedCount:   2,
+			hasAllowMissing: false,
+		},
+		{
+			name:      "single provider requiring all",
+			routeName: "test-route",
+			providers: map[string]*jwtauthnv3.JwtProvider{
+				"provider1": {Issuer: "test-issuer"},
+			},
+			requirement:     ptr.To(kgateway.JWTProviderRequirementAll),
+			expectedType:    "provider_name",
+			expectedCount:   1,
+			hasAllowMissing: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			req := buildJwtRequirementFromProviders(tt.providers, tt.validationMode)
+			req := buildJwtRequirementFromProviders(tt.providers, tt.validationMode, tt.requirement)
 			if tt.hasAllowMissing {
 				// When allow missing is enabled, the top level should be RequiresAny
 				assert.NotNil(t, req.GetRequiresAny())
@@ -151,6 +177,9 @@ func TestBuildJwtRequirementFromProviders(t *testing.T) {
 					}
 				}
 				assert.True(t, hasAllowMissing, "expected AllowMissing requirement")
+			} else if tt.expectedType == "requires_all" {
+				assert.NotNil(t, req.GetRequiresAll())
+				assert.Equal(t, tt.expectedCount, len(req.GetRequiresAll().Requirements))
 			} else if tt.expectedType == "provider_name" {
 				assert.NotNil(t, req.GetProviderName())
 				assert.Equal(t, "provider1", req.GetProviderName())
@@ -206,6 +235,88 @@ func TestTranslateJwksConfigMap(t *testing.T) {
 	}
 }
 
+func TestCompileLocalJwks(t *testing.T) {
+	r := require.New(t)
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-cm",
+			Namespace: "test-ns",
+		},
+		Data: map[string]string{
+			"jwks": `{"keys":[{"kty":"RSA","kid":"test-key","use":"sig","alg":"RS256","n":"test-n","e":"AQAB"}]}`,
+		},
+	}
+	jwks := compileLocalJwks(cm)
+	r.NoError(jwks.Err)
+	r.NotNil(jwks.Jwks)
+	r.Equal("test-ns/test-cm", jwks.ResourceName())
+
+	// changes to the metadata or other keys do not change the JWKS
+	updated := cm.DeepCopy()
+	updated.Labels = map[string]string{"foo": "bar"}
+	updated.Data["other"] = "value"
+	r.True(jwks.Equals(compileLocalJwks(updated)))
+
+	// changes to the jwks key do
+	updated.Data["jwks"] = `{"keys":[{"kty":"RSA","kid":"other-key","use":"sig","alg":"RS256","n":"test-n","e":"AQAB"}]}`
+	r.False(jwks.Equals(compileLocalJwks(updated)))
+
+	delete(updated.Data, "jwks")
+	missing := compileLocalJwks(updated)
+	r.Error(missing.Err)
+	r.False(jwks.Equals(missing))
+}
+
+func TestLocalJwksCollection(t *testing.T) {
+	stop := test.NewStop(t)
+	krtopts := krtutil.NewKrtOptions(stop, new(krt.DebugHandler))
+
+	jwks := `{"keys":[{"kty":"RSA","kid":"test-key","use":"sig","alg":"RS256","n":"test-n","e":"AQAB"}]}`
+	configMap := func(ns, name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Data:       map[string]string{"jwks": jwks},
+		}
+	}
+	configMaps := krt.NewStaticCollection(nil, []*corev1.ConfigMap{
+		configMap("test-ns", "referenced"),
+		configMap("test-ns", "unreferenced"),
+		// the reference is local to the namespace of the GatewayExtension
+		configMap("other-ns", "referenced"),
+	}, krtopts.ToOptions("ConfigMaps")...)
+	gwExts := krt.NewStaticCollection(nil, []ir.GatewayExtension{{
+		ObjectSource: ir.ObjectSource{Namespace: "test-ns", Name: "jwt"},
+		JWT: &kgateway.JWT{
+			Providers: []kgateway.NamedJWTProvider{
+				{
+					Name: "local",
+					JWTProvider: kgateway.JWTProvider{
+						JWKS: kgateway.JWKS{LocalJWKS: &kgateway.LocalJWKS{
+							ConfigMapRef: &corev1.LocalObjectReference{Name: "referenced"},
+						}},
+					},
+				},
+				{
+					Name: "inline",
+					JWTProvider: kgateway.JWTProvider{
+						JWKS: kgateway.JWKS{LocalJWKS: &kgateway.LocalJWKS{Inline: ptr.To(jwks)}},
+					},
+				},
+			},
+		},
+	}}, krtopts.ToOptions("GatewayExtensions")...)
+
+	col := NewLocalJwksCollection(configMaps, gwExts, krtopts)
+	col.WaitUntilSynced(stop)
+
+	var got []string
+	for _, l := range col.List() {
+		require.NoError(t, l.Err)
+		got = append(got, l.ResourceName())
+	}
+	assert.Equal(t, []string{"test-ns/referenced"}, got)
+}
+
 func TestConvertJwtValidationConfig(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -2,6 +2,7 @@ package trafficpolicy
 
 import (
 	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"google.golang.org/protobuf/proto"
@@ -107,6 +108,13 @@ func toLocalRateLimitFilterConfig(t *kgateway.LocalRateLimitPolicy) *localrateli
 		},
 	}
 
+	if t.XRateLimitHeaders != nil && *t.XRateLimitHeaders == kgateway.XRateLimitHeaderDraftV03 {
+		lrl.EnableXRatelimitHeaders = ratelimitv3.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03
+	}
+	if t.LimitExceededStatusCode != nil {
+		lrl.Status = &typev3.HttpStatus{Code: typev3.StatusCode(*t.LimitExceededStatusCode)}
+	}
+
 	return lrl
 }
 
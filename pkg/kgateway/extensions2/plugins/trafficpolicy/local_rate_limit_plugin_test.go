@@ -4,11 +4,16 @@ import (
 	"testing"
 	"time"
 
+	ratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 )
 
 func TestLocalRateLimitIREquals(t *testing.T) {
@@ -191,3 +196,25 @@ func TestLocalRateLimitIRValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestToLocalRateLimitFilterConfigResponse(t *testing.T) {
+	policy := &kgateway.LocalRateLimitPolicy{
+		TokenBucket: &kgateway.TokenBucket{
+			MaxTokens:    10,
+			FillInterval: metav1.Duration{Duration: time.Second},
+		},
+		XRateLimitHeaders:       ptr.To(kgateway.XRateLimitHeaderDraftV03),
+		LimitExceededStatusCode: ptr.To(int32(503)),
+	}
+
+	got := toLocalRateLimitFilterConfig(policy)
+	assert.Equal(t, ratelimitv3.XRateLimitHeadersRFCVersion_DRAFT_VERSION_03, got.GetEnableXRatelimitHeaders())
+	assert.Equal(t, typev3.StatusCode_ServiceUnavailable, got.GetStatus().GetCode())
+	assert.NoError(t, (&localRateLimitIR{config: got}).Validate())
+
+	policy.XRateLimitHeaders = ptr.To(kgateway.XRateLimitHeaderOff)
+	policy.LimitExceededStatusCode = nil
+	got = toLocalRateLimitFilterConfig(policy)
+	assert.Equal(t, ratelimitv3.XRateLimitHeadersRFCVersion_OFF, got.GetEnableXRatelimitHeaders())
+	assert.Nil(t, got.GetStatus())
+}
@@ -0,0 +1,121 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/krt"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	luaFilterName   = "envoy.filters.http.lua"
+	luaConfigMapKey = "lua"
+)
+
+type luaIR struct {
+	perRoute *luav3.LuaPerRoute
+}
+
+var _ PolicySubIR = &luaIR{}
+
+func (l *luaIR) Equals(other PolicySubIR) bool {
+	otherLua, ok := other.(*luaIR)
+	if !ok {
+		return false
+	}
+	if l == nil || otherLua == nil {
+		return l == nil && otherLua == nil
+	}
+	return proto.Equal(l.perRoute, otherLua.perRoute)
+}
+
+func (l *luaIR) Validate() error {
+	if l == nil || l.perRoute == nil {
+		return nil
+	}
+	return l.perRoute.Validate()
+}
+
+// constructLua constructs the Lua policy IR from the policy specification.
+// Scripts referenced by ConfigMap are inlined, so the policy is translated again when the ConfigMap changes.
+func constructLua(
+	krtctx krt.HandlerContext,
+	policyCR *kgateway.TrafficPolicy,
+	configMaps *krtcollections.ConfigMapIndex,
+	out *trafficPolicySpecIr,
+) error {
+	spec := policyCR.Spec.Lua
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.lua = &luaIR{
+			perRoute: &luav3.LuaPerRoute{
+				Override: &luav3.LuaPerRoute_Disabled{Disabled: true},
+			},
+		}
+		return nil
+	}
+
+	var script string
+	switch {
+	case spec.Inline != nil:
+		script = *spec.Inline
+	case spec.ConfigMapRef != nil:
+		from := krtcollections.From{
+			GroupKind: wellknown.TrafficPolicyGVK.GroupKind(),
+			Namespace: policyCR.Namespace,
+		}
+		cm, err := configMaps.GetConfigMap(krtctx, from, gwv1.ObjectReference{
+			Kind: "ConfigMap",
+			Name: gwv1.ObjectName(spec.ConfigMapRef.Name),
+		})
+		if err != nil {
+			return fmt.Errorf("lua: failed to find configmap %s: %w", spec.ConfigMapRef.Name, err)
+		}
+		script = cm.Data[luaConfigMapKey]
+		if script == "" {
+			return fmt.Errorf("lua: configmap %s key '%s' not found", spec.ConfigMapRef.Name, luaConfigMapKey)
+		}
+	default:
+		return errors.New("lua: one of inline, configMapRef or disable must be set")
+	}
+
+	out.lua = &luaIR{
+		perRoute: &luav3.LuaPerRoute{
+			Override: &luav3.LuaPerRoute_SourceCode{
+				SourceCode: &envoycorev3.DataSource{
+					Specifier: &envoycorev3.DataSource_InlineString{InlineString: script},
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// handleLua adds the Lua script of the route or virtual host to the typed_per_filter_config and registers the
+// disabled Lua filter, without a default script, in the filter chain.
+func (p *trafficPolicyPluginGwPass) handleLua(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, lua *luaIR) {
+	if lua == nil {
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(luaFilterName, lua.perRoute)
+
+	if p.luaInChain == nil {
+		p.luaInChain = make(map[string]*luav3.Lua)
+	}
+	if _, ok := p.luaInChain[fcn]; !ok {
+		p.luaInChain[fcn] = &luav3.Lua{}
+	}
+}
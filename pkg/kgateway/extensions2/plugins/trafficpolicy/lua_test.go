@@ -0,0 +1,91 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const testLuaScript = `function envoy_on_request(request_handle)
+  request_handle:headers():add("x-lua", "true")
+end`
+
+func TestConstructLua(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *kgateway.Lua
+		want *luaIR
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "inline",
+			in: &kgateway.Lua{
+				Inline: ptr.To(testLuaScript),
+			},
+			want: &luaIR{
+				perRoute: &luav3.LuaPerRoute{
+					Override: &luav3.LuaPerRoute_SourceCode{
+						SourceCode: &envoycorev3.DataSource{
+							Specifier: &envoycorev3.DataSource_InlineString{InlineString: testLuaScript},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "disable",
+			in: &kgateway.Lua{
+				Disable: &shared.PolicyDisable{},
+			},
+			want: &luaIR{
+				perRoute: &luav3.LuaPerRoute{
+					Override: &luav3.LuaPerRoute_Disabled{Disabled: true},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			out := &trafficPolicySpecIr{}
+			err := constructLua(nil, &kgateway.TrafficPolicy{
+				Spec: kgateway.TrafficPolicySpec{
+					Lua: tt.in,
+				},
+			}, nil, out)
+			a.NoError(err)
+
+			a.True(tt.want.Equals(out.lua))
+			a.NoError(out.lua.Validate())
+		})
+	}
+}
+
+func TestHandleLua(t *testing.T) {
+	a := assert.New(t)
+
+	out := &trafficPolicySpecIr{}
+	a.NoError(constructLua(nil, &kgateway.TrafficPolicy{
+		Spec: kgateway.TrafficPolicySpec{
+			Lua: &kgateway.Lua{Inline: ptr.To(testLuaScript)},
+		},
+	}, nil, out))
+
+	p := &trafficPolicyPluginGwPass{}
+
+	route := ir.TypedFilterConfigMap{}
+	p.handleLua("fc", &route, out.lua)
+	a.Equal(out.lua.perRoute, route.GetTypedConfig(luaFilterName))
+	a.Contains(p.luaInChain, "fc")
+}
@@ -7,6 +7,7 @@ import (
 
 	exteniondynamicmodulev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/dynamic_modules/v3"
 	dynamicmodulesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_modules/v3"
+	envoyauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	transformationpb "github.com/solo-io/envoy-gloo/go/config/filter/http/transformation/v2"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -53,15 +54,28 @@ func MergeTrafficPolicies(
 		mergeCSRF,
 		mergeHeaderModifiers,
 		mergeBuffer,
+		mergeFaultInjection,
+		mergeCaching,
+		mergeAdmissionControl,
+		mergeLua,
+		mergeWasm,
+		mergeTap,
+		mergeMirroring,
 		mergeAutoHostRewrite,
+		mergeHostRewrite,
 		mergeTimeouts,
 		mergeRetry,
 		mergeRBAC,
+		mergeRequestValidation,
+		mergeBodyMatch,
 		mergeJwt,
 		mergeCompression,
+		mergeGrpcWeb,
 		mergeBasicAuth,
 		mergeURLRewrite,
+		mergeRedirect,
 		mergeAPIKeyAuth,
+		mergeAuthComposition,
 		mergeOAuth,
 	}
 
@@ -458,6 +472,21 @@ func mergeCompression(
 	}
 }
 
+func mergeGrpcWeb(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[grpcWebIR]{
+		Get: func(spec *trafficPolicySpecIr) *grpcWebIR { return spec.grpcWeb },
+		Set: func(spec *trafficPolicySpecIr, val *grpcWebIR) { spec.grpcWeb = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "grpcWeb")
+}
+
 func mergeOAuth(
 	p1, p2 *TrafficPolicy,
 	p2Ref *ir.AttachedPolicyRef,
@@ -565,6 +594,111 @@ func mergeBuffer(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "buffer")
 }
 
+func mergeFaultInjection(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[faultInjectionIR]{
+		Get: func(spec *trafficPolicySpecIr) *faultInjectionIR { return spec.faultInjection },
+		Set: func(spec *trafficPolicySpecIr, val *faultInjectionIR) { spec.faultInjection = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "faultInjection")
+}
+
+func mergeMirroring(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[mirroringIR]{
+		Get: func(spec *trafficPolicySpecIr) *mirroringIR { return spec.mirroring },
+		Set: func(spec *trafficPolicySpecIr, val *mirroringIR) { spec.mirroring = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "mirroring")
+}
+
+func mergeCaching(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[cachingIR]{
+		Get: func(spec *trafficPolicySpecIr) *cachingIR { return spec.caching },
+		Set: func(spec *trafficPolicySpecIr, val *cachingIR) { spec.caching = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "caching")
+}
+
+func mergeAdmissionControl(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[admissionControlIR]{
+		Get: func(spec *trafficPolicySpecIr) *admissionControlIR { return spec.admissionControl },
+		Set: func(spec *trafficPolicySpecIr, val *admissionControlIR) { spec.admissionControl = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "admissionControl")
+}
+
+func mergeLua(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[luaIR]{
+		Get: func(spec *trafficPolicySpecIr) *luaIR { return spec.lua },
+		Set: func(spec *trafficPolicySpecIr, val *luaIR) { spec.lua = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "lua")
+}
+
+func mergeWasm(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[wasmIR]{
+		Get: func(spec *trafficPolicySpecIr) *wasmIR { return spec.wasm },
+		Set: func(spec *trafficPolicySpecIr, val *wasmIR) { spec.wasm = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "wasm")
+}
+
+func mergeTap(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[tapIR]{
+		Get: func(spec *trafficPolicySpecIr) *tapIR { return spec.tap },
+		Set: func(spec *trafficPolicySpecIr, val *tapIR) { spec.tap = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "tap")
+}
+
 func mergeAutoHostRewrite(
 	p1, p2 *TrafficPolicy,
 	p2Ref *ir.AttachedPolicyRef,
@@ -580,6 +714,21 @@ func mergeAutoHostRewrite(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "autoHostRewrite")
 }
 
+func mergeHostRewrite(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[hostRewriteIR]{
+		Get: func(spec *trafficPolicySpecIr) *hostRewriteIR { return spec.hostRewrite },
+		Set: func(spec *trafficPolicySpecIr, val *hostRewriteIR) { spec.hostRewrite = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "hostRewrite")
+}
+
 func mergeTimeouts(
 	p1, p2 *TrafficPolicy,
 	p2Ref *ir.AttachedPolicyRef,
@@ -607,7 +756,63 @@ func mergeRBAC(
 		Get: func(spec *trafficPolicySpecIr) *rbacIR { return spec.rbac },
 		Set: func(spec *trafficPolicySpecIr, val *rbacIR) { spec.rbac = val },
 	}
+	p1RBAC, p2RBAC := p1.spec.rbac, p2.spec.rbac
+	p1Origins := mergeOrigins.Get("rbac")
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "rbac")
+
+	// An Audit rule is not enforced, so it must not disable the enforced rule it takes precedence over.
+	// The enforced rule is kept alongside the shadow matcher of the Audit rule.
+	merged, other := p1.spec.rbac, p2RBAC
+	if merged == p2RBAC {
+		other = p1RBAC
+	}
+	if merged == nil || other == nil || merged == other || !merged.auditOnly() || other.auditOnly() {
+		return
+	}
+	p1.spec.rbac = &rbacIR{
+		rbacConfig: &envoyauthz.RBACPerRoute{
+			Rbac: &envoyauthz.RBAC{
+				Rules:         other.rbacConfig.GetRbac().GetRules(),
+				Matcher:       other.rbacConfig.GetRbac().GetMatcher(),
+				ShadowMatcher: merged.rbacConfig.GetRbac().GetShadowMatcher(),
+			},
+		},
+	}
+	if other == p2RBAC {
+		mergeOrigins.Append("rbac", p2Ref, p2MergeOrigins)
+	} else {
+		mergeOrigins["rbac"].Insert(p1Origins...)
+	}
+}
+
+func mergeRequestValidation(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[requestValidationIR]{
+		Get: func(spec *trafficPolicySpecIr) *requestValidationIR { return spec.requestValidation },
+		Set: func(spec *trafficPolicySpecIr, val *requestValidationIR) { spec.requestValidation = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "requestValidation")
+}
+
+func mergeBodyMatch(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[bodyMatchIR]{
+		Get: func(spec *trafficPolicySpecIr) *bodyMatchIR { return spec.bodyMatch },
+		Set: func(spec *trafficPolicySpecIr, val *bodyMatchIR) { spec.bodyMatch = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "bodyMatch")
 }
 
 func mergeAPIKeyAuth(
@@ -625,6 +830,21 @@ func mergeAPIKeyAuth(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "apiKeyAuth")
 }
 
+func mergeAuthComposition(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[authCompositionIR]{
+		Get: func(spec *trafficPolicySpecIr) *authCompositionIR { return spec.authComposition },
+		Set: func(spec *trafficPolicySpecIr, val *authCompositionIR) { spec.authComposition = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "authComposition")
+}
+
 func mergeRetry(
 	p1, p2 *TrafficPolicy,
 	p2Ref *ir.AttachedPolicyRef,
@@ -670,6 +890,21 @@ func mergeURLRewrite(
 	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "urlRewrite")
 }
 
+func mergeRedirect(
+	p1, p2 *TrafficPolicy,
+	p2Ref *ir.AttachedPolicyRef,
+	p2MergeOrigins ir.MergeOrigins,
+	opts policy.MergeOptions,
+	mergeOrigins ir.MergeOrigins,
+	_ TrafficPolicyMergeOpts,
+) {
+	accessor := fieldAccessor[redirectIR]{
+		Get: func(spec *trafficPolicySpecIr) *redirectIR { return spec.redirect },
+		Set: func(spec *trafficPolicySpecIr, val *redirectIR) { spec.redirect = val },
+	}
+	defaultMerge(p1, p2, p2Ref, p2MergeOrigins, opts, mergeOrigins, accessor, "redirect")
+}
+
 // fieldAccessor defines how to access and set a field on trafficPolicySpecIr
 type fieldAccessor[T any] struct {
 	Get func(*trafficPolicySpecIr) *T
@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/policy"
 )
@@ -37,3 +39,36 @@ func TestMergePoliciesPreservesErrors(t *testing.T) {
 	assert.Contains(t, merged.Errors, err1)
 	assert.Contains(t, merged.Errors, err2)
 }
+
+func TestMergeRBACAuditKeepsEnforcedRule(t *testing.T) {
+	deny, err := translateRBAC(&shared.Authorization{
+		Action: shared.AuthorizationPolicyActionDeny,
+		Policy: shared.AuthorizationPolicy{
+			MatchExpressions: []shared.CELExpression{"request.headers['x-blocked'] == 'true'"},
+		},
+	})
+	require.NoError(t, err)
+	audit, err := translateRBAC(&shared.Authorization{
+		Action: shared.AuthorizationPolicyActionAudit,
+		Policy: shared.AuthorizationPolicy{
+			MatchExpressions: []shared.CELExpression{"request.headers['x-tenant'] == 'test'"},
+		},
+	})
+	require.NoError(t, err)
+
+	// the route policy with the Audit rule overrides the gateway policy with the Deny rule
+	p1 := &TrafficPolicy{spec: trafficPolicySpecIr{rbac: &rbacIR{rbacConfig: deny}}}
+	p2 := &TrafficPolicy{spec: trafficPolicySpecIr{rbac: &rbacIR{rbacConfig: audit}}}
+	p1Ref := &ir.AttachedPolicyRef{Name: "gateway"}
+	p2Ref := &ir.AttachedPolicyRef{Name: "route"}
+	mergeOrigins := ir.MergeOrigins{}
+	mergeOrigins.SetOne("rbac", p1Ref, nil)
+
+	mergeRBAC(p1, p2, p2Ref, nil, policy.MergeOptions{Strategy: policy.OverridableShallowMerge}, mergeOrigins, TrafficPolicyMergeOpts{})
+
+	// the Deny rule is still enforced, and the Audit rule is evaluated in shadow mode
+	merged := p1.spec.rbac.rbacConfig.GetRbac()
+	assert.Same(t, deny.GetRbac().GetMatcher(), merged.GetMatcher())
+	assert.Same(t, audit.GetRbac().GetShadowMatcher(), merged.GetShadowMatcher())
+	assert.ElementsMatch(t, []string{p1Ref.ID(), p2Ref.ID()}, mergeOrigins.Get("rbac"))
+}
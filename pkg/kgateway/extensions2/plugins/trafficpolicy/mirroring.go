@@ -0,0 +1,94 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
+	"istio.io/istio/pkg/kube/krt"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+type mirroringIR struct {
+	policy *envoyroutev3.RouteAction_RequestMirrorPolicy
+}
+
+var _ PolicySubIR = &mirroringIR{}
+
+func (m *mirroringIR) Equals(other PolicySubIR) bool {
+	otherMirroring, ok := other.(*mirroringIR)
+	if !ok {
+		return false
+	}
+	if m == nil || otherMirroring == nil {
+		return m == nil && otherMirroring == nil
+	}
+	return proto.Equal(m.policy, otherMirroring.policy)
+}
+
+func (m *mirroringIR) Validate() error {
+	if m == nil || m.policy == nil {
+		return nil
+	}
+	return m.policy.Validate()
+}
+
+// constructMirroring constructs the request mirroring policy IR from the policy specification.
+func constructMirroring(
+	krtctx krt.HandlerContext,
+	policy *kgateway.TrafficPolicy,
+	backends *krtcollections.BackendIndex,
+	out *trafficPolicySpecIr,
+) error {
+	spec := policy.Spec.Mirroring
+	if spec == nil {
+		return nil
+	}
+
+	policySource := ir.ObjectSource{
+		Group:     wellknown.TrafficPolicyGVK.Group,
+		Kind:      wellknown.TrafficPolicyGVK.Kind,
+		Namespace: policy.Namespace,
+		Name:      policy.Name,
+	}
+	backend, err := resolveBackend(krtctx, backends, false, policySource, spec.BackendRef)
+	if err != nil {
+		return fmt.Errorf("failed to resolve mirroring backend: %w", err)
+	}
+
+	numerator := uint32(100)
+	if spec.Percentage != nil {
+		numerator = uint32(*spec.Percentage) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	out.mirroring = &mirroringIR{
+		policy: &envoyroutev3.RouteAction_RequestMirrorPolicy{
+			Cluster: backend.ClusterName(),
+			RuntimeFraction: &envoycorev3.RuntimeFractionalPercent{
+				DefaultValue: &envoy_type_v3.FractionalPercent{
+					Numerator:   numerator,
+					Denominator: envoy_type_v3.FractionalPercent_HUNDRED,
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// applyMirroring appends the request mirror policy to the route action. Mirror policies set by the
+// built-in HTTPRoute RequestMirror filter are preserved, as mirrors are cumulative.
+func applyMirroring(mirroring *mirroringIR, out *envoyroutev3.Route) {
+	if mirroring == nil || out == nil {
+		return
+	}
+	action := out.GetRoute()
+	if action == nil {
+		return
+	}
+	action.RequestMirrorPolicies = append(action.GetRequestMirrorPolicies(), mirroring.policy)
+}
@@ -0,0 +1,72 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMirroringIREquals(t *testing.T) {
+	mirror := func(cluster string) *mirroringIR {
+		return &mirroringIR{
+			policy: &envoyroutev3.RouteAction_RequestMirrorPolicy{Cluster: cluster},
+		}
+	}
+
+	tests := []struct {
+		name string
+		a, b *mirroringIR
+		want bool
+	}{
+		{
+			name: "both nil are equal",
+			want: true,
+		},
+		{
+			name: "nil vs non-nil are not equal",
+			a:    mirror("shadow"),
+			want: false,
+		},
+		{
+			name: "non-nil and equal",
+			a:    mirror("shadow"),
+			b:    mirror("shadow"),
+			want: true,
+		},
+		{
+			name: "non-nil and not equal",
+			a:    mirror("shadow"),
+			b:    mirror("other"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.a.Equals(tt.b))
+		})
+	}
+}
+
+func TestApplyMirroring(t *testing.T) {
+	a := assert.New(t)
+
+	builtin := &envoyroutev3.RouteAction_RequestMirrorPolicy{Cluster: "builtin"}
+	route := &envoyroutev3.Route{
+		Action: &envoyroutev3.Route_Route{
+			Route: &envoyroutev3.RouteAction{
+				RequestMirrorPolicies: []*envoyroutev3.RouteAction_RequestMirrorPolicy{builtin},
+			},
+		},
+	}
+
+	applyMirroring(&mirroringIR{
+		policy: &envoyroutev3.RouteAction_RequestMirrorPolicy{Cluster: "shadow"},
+	}, route)
+
+	mirrors := route.GetRoute().GetRequestMirrorPolicies()
+	a.Len(mirrors, 2)
+	a.Equal("builtin", mirrors[0].GetCluster())
+	a.Equal("shadow", mirrors[1].GetCluster())
+}
@@ -12,6 +12,7 @@ import (
 	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 	"istio.io/istio/pkg/kube/krt"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -293,6 +294,18 @@ func buildOAuth2ProviderConfig(
 		cfg.Config.DisableRefreshTokenSetCookie = ptr.Deref(in.Cookies.DisableRefreshTokenSetCookie, false)
 	}
 
+	if in.Session != nil {
+		if in.Session.UseRefreshToken != nil {
+			cfg.Config.UseRefreshToken = wrapperspb.Bool(*in.Session.UseRefreshToken)
+		}
+		if in.Session.AccessTokenExpiry != nil {
+			cfg.Config.DefaultExpiresIn = durationpb.New(in.Session.AccessTokenExpiry.Duration)
+		}
+		if in.Session.RefreshTokenExpiry != nil {
+			cfg.Config.DefaultRefreshTokenExpiresIn = durationpb.New(in.Session.RefreshTokenExpiry.Duration)
+		}
+	}
+
 	if in.DenyRedirect != nil {
 		matcher, err := pluginsdkutils.ToEnvoyHeaderMatchers(in.DenyRedirect.Headers)
 		if err != nil {
@@ -2,20 +2,27 @@ package trafficpolicy
 
 import (
 	"fmt"
+	"net/netip"
+	"sync"
 
 	"cel.dev/expr"
 	cncfcorev3 "github.com/cncf/xds/go/xds/core/v3"
 	cncfmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
 	cncftypev3 "github.com/cncf/xds/go/xds/type/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
 	envoyauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoynetworkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	envoyipmatcherv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/ip/v3"
 	"github.com/google/cel-go/cel"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 	sharedv1alpha1 "github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/celutils"
 )
 
 // rbacIr is the internal representation of an RBAC policy.
@@ -33,6 +40,12 @@ func (r *rbacIR) Equals(other *rbacIR) bool {
 	return proto.Equal(r.rbacConfig, other.rbacConfig)
 }
 
+// auditOnly returns true when the policy only has the shadow matcher of an Audit rule.
+func (r *rbacIR) auditOnly() bool {
+	rbac := r.rbacConfig.GetRbac()
+	return rbac.GetShadowMatcher() != nil && rbac.GetMatcher() == nil && rbac.GetRules() == nil
+}
+
 // Validate performs validation on the rbac component.
 func (r *rbacIR) Validate() error {
 	if r == nil {
@@ -87,8 +100,8 @@ func translateRBAC(rbac *sharedv1alpha1.Authorization) (*envoyauthz.RBACPerRoute
 	// Create matcher-based RBAC configuration
 	var matchers []*cncfmatcherv3.Matcher_MatcherList_FieldMatcher
 
-	if len(rbac.Policy.MatchExpressions) > 0 {
-		matcher, err := createCELMatcher(rbac.Policy.MatchExpressions, rbac.Action)
+	if len(rbac.Policy.MatchExpressions) > 0 || len(rbac.Policy.Matchers) > 0 {
+		matcher, err := createCELMatcher(rbac.Policy.MatchExpressions, rbac.Policy.Matchers, rbac.Action)
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -107,13 +120,18 @@ func translateRBAC(rbac *sharedv1alpha1.Authorization) (*envoyauthz.RBACPerRoute
 		}, nil
 	}
 
+	// an audited rule is evaluated as a deny rule, so the requests it does not match are allowed
+	onNoMatch := envoyrbacv3.RBAC_DENY
+	if rbac.Action == sharedv1alpha1.AuthorizationPolicyActionAudit {
+		onNoMatch = envoyrbacv3.RBAC_ALLOW
+	}
 	celMatcher := &cncfmatcherv3.Matcher{
 		MatcherType: &cncfmatcherv3.Matcher_MatcherList_{
 			MatcherList: &cncfmatcherv3.Matcher_MatcherList{
 				Matchers: matchers,
 			},
 		},
-		OnNoMatch: createDefaultAction(envoyrbacv3.RBAC_DENY),
+		OnNoMatch: createDefaultAction(onNoMatch),
 	}
 
 	res := &envoyauthz.RBACPerRoute{
@@ -121,6 +139,12 @@ func translateRBAC(rbac *sharedv1alpha1.Authorization) (*envoyauthz.RBACPerRoute
 			Matcher: celMatcher, // Use the Matcher field directly
 		},
 	}
+	if rbac.Action == sharedv1alpha1.AuthorizationPolicyActionAudit {
+		// the shadow matcher is evaluated and its result recorded, but not enforced
+		res.Rbac = &envoyauthz.RBAC{
+			ShadowMatcher: celMatcher,
+		}
+	}
 
 	if len(errs) > 0 {
 		return res, fmt.Errorf("RBAC policy encountered CEL matcher errors: %v", errs)
@@ -128,99 +152,55 @@ func translateRBAC(rbac *sharedv1alpha1.Authorization) (*envoyauthz.RBACPerRoute
 	return res, nil
 }
 
-func createCELMatcher(celExprs []sharedv1alpha1.CELExpression, action sharedv1alpha1.AuthorizationPolicyAction) (*cncfmatcherv3.Matcher_MatcherList_FieldMatcher, error) {
-	if len(celExprs) == 0 {
-		return nil, fmt.Errorf("no CEL expressions provided")
-	}
+// celEnv is the CEL environment used to parse the expressions of all policies.
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv()
+})
 
-	// Create CEL match input
-	celMatchInput, err := utils.MessageToAny(&cncfmatcherv3.HttpAttributesCelMatchInput{})
-	if err != nil {
-		return nil, err
-	}
+// envoyAuthorizationAttributes are the Envoy request attributes used by the typed authorization matchers.
+// The source CIDRs are matched with the IP input matcher, as Envoy's CEL has no CIDR functions.
+var envoyAuthorizationAttributes = celutils.AuthorizationAttributes{
+	Path:      "request.url_path",
+	JWTClaims: "metadata.filter_metadata['envoy.filters.http.jwt_authn']['" + PayloadInMetadata + "']",
+}
 
-	celMatchInputConfig := &cncfcorev3.TypedExtensionConfig{
-		Name:        "envoy.matching.inputs.cel_data_input",
-		TypedConfig: celMatchInput,
+func createCELMatcher(
+	celExprs []sharedv1alpha1.CELExpression,
+	typedMatchers []sharedv1alpha1.AuthorizationMatcher,
+	action sharedv1alpha1.AuthorizationPolicyAction,
+) (*cncfmatcherv3.Matcher_MatcherList_FieldMatcher, error) {
+	if len(celExprs) == 0 && len(typedMatchers) == 0 {
+		return nil, fmt.Errorf("no CEL expressions provided")
 	}
 
 	// Create parsed expression
-	env, err := cel.NewEnv()
+	env, err := celEnv()
 	if err != nil {
 		logger.Error("failed to create CEL environment", "err", err.Error())
 		return nil, err
 	}
 
-	var predicate *cncfmatcherv3.Matcher_MatcherList_Predicate
-	if len(celExprs) == 1 {
-		// Single expression - use SinglePredicate
-		celDevParsed, err := parseCELExpression(env, celExprs[0])
+	var predicates []*cncfmatcherv3.Matcher_MatcherList_Predicate
+	for _, celExpr := range celExprs {
+		predicate, err := createCELPredicate(env, celExpr)
 		if err != nil {
-			return nil, fmt.Errorf("failed to parse CEL expression: %w", err)
-		}
-
-		matcher := &cncfmatcherv3.CelMatcher{
-			ExprMatch: &cncftypev3.CelExpression{
-				CelExprParsed: celDevParsed,
-			},
+			return nil, err
 		}
-		pb, err := utils.MessageToAny(matcher)
+		predicates = append(predicates, predicate)
+	}
+	for _, typedMatcher := range typedMatchers {
+		predicate, err := createTypedMatcherPredicate(env, typedMatcher)
 		if err != nil {
 			return nil, err
 		}
+		predicates = append(predicates, predicate)
+	}
 
-		typedCelMatchConfig := &cncfcorev3.TypedExtensionConfig{
-			Name:        "envoy.matching.matchers.cel_matcher",
-			TypedConfig: pb,
-		}
-		predicate = &cncfmatcherv3.Matcher_MatcherList_Predicate{
-			MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
-				SinglePredicate: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
-					Input: celMatchInputConfig,
-					Matcher: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
-						CustomMatch: typedCelMatchConfig,
-					},
-				},
-			},
-		}
+	var predicate *cncfmatcherv3.Matcher_MatcherList_Predicate
+	if len(predicates) == 1 {
+		// Single expression - use SinglePredicate
+		predicate = predicates[0]
 	} else {
-		// Multiple expressions - create a list of predicates
-		var predicates []*cncfmatcherv3.Matcher_MatcherList_Predicate
-
-		for _, celExpr := range celExprs {
-			celDevParsed, err := parseCELExpression(env, celExpr)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse CEL expression: %w", err)
-			}
-
-			matcher := &cncfmatcherv3.CelMatcher{
-				ExprMatch: &cncftypev3.CelExpression{
-					CelExprParsed: celDevParsed,
-				},
-			}
-			pb, err := utils.MessageToAny(matcher)
-			if err != nil {
-				return nil, err
-			}
-
-			typedCelMatchConfig := &cncfcorev3.TypedExtensionConfig{
-				Name:        "envoy.matching.matchers.cel_matcher",
-				TypedConfig: pb,
-			}
-
-			singlePredicate := &cncfmatcherv3.Matcher_MatcherList_Predicate{
-				MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
-					SinglePredicate: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
-						Input: celMatchInputConfig,
-						Matcher: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
-							CustomMatch: typedCelMatchConfig,
-						},
-					},
-				},
-			}
-			predicates = append(predicates, singlePredicate)
-		}
-
 		// Create an OR predicate that contains all the single predicates
 		predicate = &cncfmatcherv3.Matcher_MatcherList_Predicate{
 			MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_OrMatcher{
@@ -233,7 +213,7 @@ func createCELMatcher(celExprs []sharedv1alpha1.CELExpression, action sharedv1al
 
 	// Determine the action based on policy action
 	var onMatchAction *cncfmatcherv3.Matcher_OnMatch
-	if action == sharedv1alpha1.AuthorizationPolicyActionDeny {
+	if action == sharedv1alpha1.AuthorizationPolicyActionDeny || action == sharedv1alpha1.AuthorizationPolicyActionAudit {
 		onMatchAction = createMatchAction(envoyrbacv3.RBAC_DENY)
 	} else {
 		onMatchAction = createMatchAction(envoyrbacv3.RBAC_ALLOW)
@@ -245,6 +225,130 @@ func createCELMatcher(celExprs []sharedv1alpha1.CELExpression, action sharedv1al
 	}, nil
 }
 
+// createCELPredicate creates the predicate matching the requests for which the CEL expression evaluates to true.
+func createCELPredicate(env *cel.Env, celExpr sharedv1alpha1.CELExpression) (*cncfmatcherv3.Matcher_MatcherList_Predicate, error) {
+	celDevParsed, err := parseCELExpression(env, celExpr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CEL expression: %w", err)
+	}
+
+	// Create CEL match input
+	celMatchInput, err := utils.MessageToAny(&cncfmatcherv3.HttpAttributesCelMatchInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	matcher := &cncfmatcherv3.CelMatcher{
+		ExprMatch: &cncftypev3.CelExpression{
+			CelExprParsed: celDevParsed,
+		},
+	}
+	pb, err := utils.MessageToAny(matcher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cncfmatcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &cncfcorev3.TypedExtensionConfig{
+					Name:        "envoy.matching.inputs.cel_data_input",
+					TypedConfig: celMatchInput,
+				},
+				Matcher: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+					CustomMatch: &cncfcorev3.TypedExtensionConfig{
+						Name:        "envoy.matching.matchers.cel_matcher",
+						TypedConfig: pb,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
+// createTypedMatcherPredicate creates the predicate matching the requests satisfying all the conditions of the
+// typed matcher. The conditions are compiled to a CEL expression, except the source CIDRs.
+func createTypedMatcherPredicate(
+	env *cel.Env,
+	typedMatcher sharedv1alpha1.AuthorizationMatcher,
+) (*cncfmatcherv3.Matcher_MatcherList_Predicate, error) {
+	var predicates []*cncfmatcherv3.Matcher_MatcherList_Predicate
+
+	celExpr, err := celutils.AuthorizationMatcherExpression(typedMatcher, envoyAuthorizationAttributes)
+	if err != nil {
+		return nil, err
+	}
+	if celExpr != "" {
+		predicate, err := createCELPredicate(env, sharedv1alpha1.CELExpression(celExpr))
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	if len(typedMatcher.SourceCIDRs) > 0 {
+		predicate, err := createSourceIPPredicate(typedMatcher.SourceCIDRs)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	if len(predicates) == 1 {
+		return predicates[0], nil
+	}
+	return &cncfmatcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_AndMatcher{
+			AndMatcher: &cncfmatcherv3.Matcher_MatcherList_Predicate_PredicateList{
+				Predicate: predicates,
+			},
+		},
+	}, nil
+}
+
+// createSourceIPPredicate creates the predicate matching the requests from a client address in one of the CIDR ranges.
+func createSourceIPPredicate(cidrs []string) (*cncfmatcherv3.Matcher_MatcherList_Predicate, error) {
+	ipMatcher := &envoyipmatcherv3.Ip{
+		StatPrefix: "rbac_source_ip",
+	}
+	for _, cidr := range cidrs {
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid source CIDR %q: %w", cidr, err)
+		}
+		ipMatcher.CidrRanges = append(ipMatcher.GetCidrRanges(), &envoycorev3.CidrRange{
+			AddressPrefix: prefix.Addr().String(),
+			PrefixLen:     wrapperspb.UInt32(uint32(prefix.Bits())), //nolint:gosec // G115: prefix length is at most 128
+		})
+	}
+
+	sourceIPInput, err := utils.MessageToAny(&envoynetworkv3.SourceIPInput{})
+	if err != nil {
+		return nil, err
+	}
+	pb, err := utils.MessageToAny(ipMatcher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cncfmatcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &cncfcorev3.TypedExtensionConfig{
+					Name:        "envoy.matching.inputs.source_ip",
+					TypedConfig: sourceIPInput,
+				},
+				Matcher: &cncfmatcherv3.Matcher_MatcherList_Predicate_SinglePredicate_CustomMatch{
+					CustomMatch: &cncfcorev3.TypedExtensionConfig{
+						Name:        "envoy.matching.matchers.ip",
+						TypedConfig: pb,
+					},
+				},
+			},
+		},
+	}, nil
+}
+
 func createMatchAction(action envoyrbacv3.RBAC_Action) *cncfmatcherv3.Matcher_OnMatch {
 	actionName := "allow-request"
 	if action == envoyrbacv3.RBAC_DENY {
@@ -7,6 +7,7 @@ import (
 	cncfmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
 	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
 	envoyauthz "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	envoyipmatcherv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/ip/v3"
 	"github.com/google/cel-go/cel"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -147,3 +148,59 @@ func TestTranslateRBAC(t *testing.T) {
 		})
 	}
 }
+
+func TestTranslateRBACTypedMatchers(t *testing.T) {
+	got, err := translateRBAC(&shared.Authorization{
+		Action: shared.AuthorizationPolicyActionAllow,
+		Policy: shared.AuthorizationPolicy{
+			Matchers: []shared.AuthorizationMatcher{{
+				Methods:     []string{"GET"},
+				SourceCIDRs: []string{"10.0.0.0/8"},
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	fieldMatchers := got.GetRbac().GetMatcher().GetMatcherList().GetMatchers()
+	require.Len(t, fieldMatchers, 1)
+	predicates := fieldMatchers[0].GetPredicate().GetAndMatcher().GetPredicate()
+	require.Len(t, predicates, 2)
+	assert.Equal(t, "envoy.matching.matchers.cel_matcher", predicates[0].GetSinglePredicate().GetCustomMatch().GetName())
+	assert.Equal(t, "envoy.matching.inputs.source_ip", predicates[1].GetSinglePredicate().GetInput().GetName())
+
+	ipMatcher := &envoyipmatcherv3.Ip{}
+	require.NoError(t, predicates[1].GetSinglePredicate().GetCustomMatch().GetTypedConfig().UnmarshalTo(ipMatcher))
+	require.Len(t, ipMatcher.GetCidrRanges(), 1)
+	assert.Equal(t, "10.0.0.0", ipMatcher.GetCidrRanges()[0].GetAddressPrefix())
+	assert.Equal(t, uint32(8), ipMatcher.GetCidrRanges()[0].GetPrefixLen().GetValue())
+
+	_, err = translateRBAC(&shared.Authorization{
+		Policy: shared.AuthorizationPolicy{
+			Matchers: []shared.AuthorizationMatcher{{SourceCIDRs: []string{"10.0.0.0"}}},
+		},
+	})
+	assert.Error(t, err)
+}
+
+func TestTranslateRBACAudit(t *testing.T) {
+	got, err := translateRBAC(&shared.Authorization{
+		Action: shared.AuthorizationPolicyActionAudit,
+		Policy: shared.AuthorizationPolicy{
+			MatchExpressions: []shared.CELExpression{"request.headers['x-tenant'] == 'test'"},
+		},
+	})
+	require.NoError(t, err)
+
+	// the rule is not enforced
+	assert.Nil(t, got.GetRbac().GetMatcher())
+	assert.Nil(t, got.GetRbac().GetRules())
+
+	shadowMatcher := got.GetRbac().GetShadowMatcher()
+	require.NotNil(t, shadowMatcher)
+	onMatch := &envoyrbacv3.Action{}
+	require.NoError(t, shadowMatcher.GetMatcherList().GetMatchers()[0].GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(onMatch))
+	assert.Equal(t, envoyrbacv3.RBAC_DENY, onMatch.GetAction())
+	onNoMatch := &envoyrbacv3.Action{}
+	require.NoError(t, shadowMatcher.GetOnNoMatch().GetAction().GetTypedConfig().UnmarshalTo(onNoMatch))
+	assert.Equal(t, envoyrbacv3.RBAC_ALLOW, onNoMatch.GetAction())
+}
@@ -0,0 +1,102 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/regexutils"
+)
+
+var redirectResponseCodes = map[int32]envoyroutev3.RedirectAction_RedirectResponseCode{
+	301: envoyroutev3.RedirectAction_MOVED_PERMANENTLY,
+	302: envoyroutev3.RedirectAction_FOUND,
+	303: envoyroutev3.RedirectAction_SEE_OTHER,
+	307: envoyroutev3.RedirectAction_TEMPORARY_REDIRECT,
+	308: envoyroutev3.RedirectAction_PERMANENT_REDIRECT,
+}
+
+type redirectIR struct {
+	action *envoyroutev3.RedirectAction
+}
+
+var _ PolicySubIR = &redirectIR{}
+
+func (r *redirectIR) Equals(other PolicySubIR) bool {
+	otherRedirect, ok := other.(*redirectIR)
+	if !ok {
+		return false
+	}
+	if r == nil || otherRedirect == nil {
+		return r == nil && otherRedirect == nil
+	}
+	return proto.Equal(r.action, otherRedirect.action)
+}
+
+// Validate performs validation on the redirect component.
+func (r *redirectIR) Validate() error {
+	if r == nil || r.action == nil {
+		return nil
+	}
+	if regex := r.action.GetRegexRewrite(); regex != nil {
+		if err := regexutils.CheckRegexString(regex.GetPattern().GetRegex()); err != nil {
+			return fmt.Errorf("invalid redirect path regex: %w", err)
+		}
+	}
+	return r.action.Validate()
+}
+
+// constructRedirect constructs the redirect policy IR from the policy specification.
+func constructRedirect(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) {
+	redirect := spec.Redirect
+	if redirect == nil {
+		return
+	}
+
+	action := &envoyroutev3.RedirectAction{}
+	if redirect.Scheme != nil {
+		action.SchemeRewriteSpecifier = &envoyroutev3.RedirectAction_SchemeRedirect{
+			SchemeRedirect: *redirect.Scheme,
+		}
+	}
+	if redirect.Hostname != nil {
+		action.HostRedirect = string(*redirect.Hostname)
+	}
+	if redirect.Port != nil {
+		action.PortRedirect = uint32(*redirect.Port) // nolint:gosec // G115: Gateway API PortNumber is validated to be 1-65535
+	}
+	if redirect.PathRegex != nil {
+		action.PathRewriteSpecifier = &envoyroutev3.RedirectAction_RegexRewrite{
+			RegexRewrite: &envoy_type_matcher_v3.RegexMatchAndSubstitute{
+				Pattern: &envoy_type_matcher_v3.RegexMatcher{
+					Regex: redirect.PathRegex.Pattern,
+				},
+				Substitution: redirect.PathRegex.Substitution,
+			},
+		}
+	}
+	if redirect.StripQuery != nil {
+		action.StripQuery = *redirect.StripQuery
+	}
+	// the status code defaults to 302, while the zero value of the Envoy response code is 301
+	action.ResponseCode = envoyroutev3.RedirectAction_FOUND
+	if redirect.StatusCode != nil {
+		action.ResponseCode = redirectResponseCodes[*redirect.StatusCode]
+	}
+
+	out.redirect = &redirectIR{action: action}
+}
+
+// applyRedirect replaces the route action of the route with the redirect. Routes that already redirect the
+// requests or respond to them directly are left untouched.
+func applyRedirect(redirect *redirectIR, out *envoyroutev3.Route) {
+	if redirect == nil || out.GetRoute() == nil {
+		return
+	}
+	out.Action = &envoyroutev3.Route_Redirect{
+		Redirect: proto.Clone(redirect.action).(*envoyroutev3.RedirectAction),
+	}
+}
@@ -0,0 +1,92 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_type_matcher_v3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+func TestConstructRedirect(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	constructRedirect(kgateway.TrafficPolicySpec{
+		Redirect: &shared.RequestRedirect{
+			Scheme:   ptr.To("https"),
+			Hostname: ptr.To(gwv1.PreciseHostname("example.com")),
+			Port:     ptr.To(gwv1.PortNumber(8443)),
+			PathRegex: &shared.RedirectPathRegex{
+				Pattern:      "^/v1/(.*)$",
+				Substitution: "/v2/\\1",
+			},
+			StripQuery: ptr.To(true),
+			StatusCode: ptr.To(int32(301)),
+		},
+	}, out)
+
+	require.NotNil(t, out.redirect)
+	require.NoError(t, out.redirect.Validate())
+	expected := &envoyroutev3.RedirectAction{
+		SchemeRewriteSpecifier: &envoyroutev3.RedirectAction_SchemeRedirect{SchemeRedirect: "https"},
+		HostRedirect:           "example.com",
+		PortRedirect:           8443,
+		PathRewriteSpecifier: &envoyroutev3.RedirectAction_RegexRewrite{
+			RegexRewrite: &envoy_type_matcher_v3.RegexMatchAndSubstitute{
+				Pattern:      &envoy_type_matcher_v3.RegexMatcher{Regex: "^/v1/(.*)$"},
+				Substitution: "/v2/\\1",
+			},
+		},
+		StripQuery:   true,
+		ResponseCode: envoyroutev3.RedirectAction_MOVED_PERMANENTLY,
+	}
+	assert.True(t, proto.Equal(expected, out.redirect.action))
+}
+
+func TestConstructRedirectDefaultStatusCode(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	constructRedirect(kgateway.TrafficPolicySpec{
+		Redirect: &shared.RequestRedirect{Scheme: ptr.To("https")},
+	}, out)
+
+	require.NotNil(t, out.redirect)
+	assert.Equal(t, envoyroutev3.RedirectAction_FOUND, out.redirect.action.GetResponseCode())
+}
+
+func TestRedirectValidateInvalidRegex(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	constructRedirect(kgateway.TrafficPolicySpec{
+		Redirect: &shared.RequestRedirect{
+			PathRegex: &shared.RedirectPathRegex{Pattern: "(", Substitution: "/"},
+		},
+	}, out)
+
+	require.NotNil(t, out.redirect)
+	assert.Error(t, out.redirect.Validate())
+}
+
+func TestApplyRedirect(t *testing.T) {
+	redirect := &redirectIR{action: &envoyroutev3.RedirectAction{HostRedirect: "example.com"}}
+
+	t.Run("replaces the route action", func(t *testing.T) {
+		out := &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_Route{Route: &envoyroutev3.RouteAction{}},
+		}
+		applyRedirect(redirect, out)
+		assert.Equal(t, "example.com", out.GetRedirect().GetHostRedirect())
+	})
+
+	t.Run("keeps the RequestRedirect filter", func(t *testing.T) {
+		out := &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_Redirect{Redirect: &envoyroutev3.RedirectAction{HostRedirect: "filter.example.com"}},
+		}
+		applyRedirect(redirect, out)
+		assert.Equal(t, "filter.example.com", out.GetRedirect().GetHostRedirect())
+	})
+}
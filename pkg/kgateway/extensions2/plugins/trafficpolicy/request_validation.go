@@ -0,0 +1,142 @@
+package trafficpolicy
+
+import (
+	"fmt"
+
+	xdscorev3 "github.com/cncf/xds/go/xds/core/v3"
+	xdsmatcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	requestValidationFilterName = "composite_request_validation"
+
+	defaultRequestValidationStatusCode = 400
+)
+
+// requestValidationIR rejects the requests not satisfying the CEL expression of the policy. The composite
+// filter in the filter chain executes a fault filter aborting the requests for which the expression of the
+// route is not true.
+type requestValidationIR struct {
+	perRoute *envoymatchingv3.ExtensionWithMatcherPerRoute
+}
+
+var _ PolicySubIR = &requestValidationIR{}
+
+func (r *requestValidationIR) Equals(other PolicySubIR) bool {
+	otherValidation, ok := other.(*requestValidationIR)
+	if !ok {
+		return false
+	}
+	if r == nil || otherValidation == nil {
+		return r == nil && otherValidation == nil
+	}
+	return proto.Equal(r.perRoute, otherValidation.perRoute)
+}
+
+func (r *requestValidationIR) Validate() error {
+	if r == nil || r.perRoute == nil {
+		return nil
+	}
+	return r.perRoute.Validate()
+}
+
+// constructRequestValidation constructs the request validation policy IR from the policy specification.
+func constructRequestValidation(spec kgateway.TrafficPolicySpec, out *trafficPolicySpecIr) error {
+	if spec.RequestValidation == nil {
+		return nil
+	}
+
+	env, err := celEnv()
+	if err != nil {
+		return err
+	}
+	predicate, err := createCELPredicate(env, spec.RequestValidation.Expression)
+	if err != nil {
+		return fmt.Errorf("request validation: %w", err)
+	}
+
+	statusCode := uint32(defaultRequestValidationStatusCode)
+	if spec.RequestValidation.StatusCode != nil {
+		statusCode = uint32(*spec.RequestValidation.StatusCode) // nolint:gosec // G115: kubebuilder validation ensures safe for uint32
+	}
+	abort := &envoy_fault_v3.HTTPFault{
+		Abort: &envoy_fault_v3.FaultAbort{
+			ErrorType: &envoy_fault_v3.FaultAbort_HttpStatus{
+				HttpStatus: statusCode,
+			},
+			Percentage: toFaultPercentage(nil),
+		},
+	}
+
+	out.requestValidation = &requestValidationIR{
+		perRoute: &envoymatchingv3.ExtensionWithMatcherPerRoute{
+			XdsMatcher: &xdsmatcherv3.Matcher{
+				MatcherType: &xdsmatcherv3.Matcher_MatcherList_{
+					MatcherList: &xdsmatcherv3.Matcher_MatcherList{
+						Matchers: []*xdsmatcherv3.Matcher_MatcherList_FieldMatcher{
+							{
+								// the requests for which the expression is not true are aborted
+								Predicate: &xdsmatcherv3.Matcher_MatcherList_Predicate{
+									MatchType: &xdsmatcherv3.Matcher_MatcherList_Predicate_NotMatcher{
+										NotMatcher: predicate,
+									},
+								},
+								OnMatch: &xdsmatcherv3.Matcher_OnMatch{
+									OnMatch: &xdsmatcherv3.Matcher_OnMatch_Action{
+										Action: &xdscorev3.TypedExtensionConfig{
+											Name: "composite-action",
+											TypedConfig: utils.MustMessageToAny(&envoycompositev3.ExecuteFilterAction{
+												TypedConfig: &envoycorev3.TypedExtensionConfig{
+													Name:        faultFilterName,
+													TypedConfig: utils.MustMessageToAny(abort),
+												},
+											}),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// handleRequestValidation sets the matcher of the route on the composite filter and registers the disabled
+// composite filter in the filter chain. The filter chain matcher is empty, so that only the routes with a
+// request validation policy are validated.
+func (p *trafficPolicyPluginGwPass) handleRequestValidation(
+	fcn string,
+	pCtxTypedFilterConfig *ir.TypedFilterConfigMap,
+	requestValidation *requestValidationIR,
+) {
+	if requestValidation == nil || requestValidation.perRoute == nil {
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(requestValidationFilterName, requestValidation.perRoute)
+
+	if p.requestValidationInChain == nil {
+		p.requestValidationInChain = make(map[string]*envoymatchingv3.ExtensionWithMatcher)
+	}
+	if _, ok := p.requestValidationInChain[fcn]; !ok {
+		p.requestValidationInChain[fcn] = &envoymatchingv3.ExtensionWithMatcher{
+			ExtensionConfig: &envoycorev3.TypedExtensionConfig{
+				Name:        requestValidationFilterName,
+				TypedConfig: utils.MustMessageToAny(&envoycompositev3.Composite{}),
+			},
+			XdsMatcher: &xdsmatcherv3.Matcher{},
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoycompositev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/composite/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructRequestValidation(t *testing.T) {
+	t.Run("rejects with the status code", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		require.NoError(t, constructRequestValidation(kgateway.TrafficPolicySpec{
+			RequestValidation: &kgateway.RequestValidation{
+				Expression: "request.method == 'GET'",
+				StatusCode: ptr.To(int32(422)),
+			},
+		}, out))
+		require.NotNil(t, out.requestValidation)
+		require.NoError(t, out.requestValidation.Validate())
+
+		matchers := out.requestValidation.perRoute.GetXdsMatcher().GetMatcherList().GetMatchers()
+		require.Len(t, matchers, 1)
+		assert.NotNil(t, matchers[0].GetPredicate().GetNotMatcher().GetSinglePredicate())
+
+		action := &envoycompositev3.ExecuteFilterAction{}
+		require.NoError(t, matchers[0].GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(action))
+		assert.Equal(t, faultFilterName, action.GetTypedConfig().GetName())
+		fault := &envoy_fault_v3.HTTPFault{}
+		require.NoError(t, action.GetTypedConfig().GetTypedConfig().UnmarshalTo(fault))
+		assert.Equal(t, uint32(422), fault.GetAbort().GetHttpStatus())
+		assert.Equal(t, uint32(100), fault.GetAbort().GetPercentage().GetNumerator())
+	})
+
+	t.Run("defaults to bad request", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		require.NoError(t, constructRequestValidation(kgateway.TrafficPolicySpec{
+			RequestValidation: &kgateway.RequestValidation{
+				Expression: "'x-api-version' in request.headers",
+			},
+		}, out))
+
+		action := &envoycompositev3.ExecuteFilterAction{}
+		matchers := out.requestValidation.perRoute.GetXdsMatcher().GetMatcherList().GetMatchers()
+		require.NoError(t, matchers[0].GetOnMatch().GetAction().GetTypedConfig().UnmarshalTo(action))
+		fault := &envoy_fault_v3.HTTPFault{}
+		require.NoError(t, action.GetTypedConfig().GetTypedConfig().UnmarshalTo(fault))
+		assert.Equal(t, uint32(defaultRequestValidationStatusCode), fault.GetAbort().GetHttpStatus())
+	})
+
+	t.Run("invalid expression", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructRequestValidation(kgateway.TrafficPolicySpec{
+			RequestValidation: &kgateway.RequestValidation{
+				Expression: "request.method ==",
+			},
+		}, out)
+		assert.ErrorContains(t, err, "request validation")
+		assert.Nil(t, out.requestValidation)
+	})
+}
+
+func TestHandleRequestValidation(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	require.NoError(t, constructRequestValidation(kgateway.TrafficPolicySpec{
+		RequestValidation: &kgateway.RequestValidation{
+			Expression: "request.method == 'GET'",
+		},
+	}, out))
+
+	p := &trafficPolicyPluginGwPass{}
+	typedFilterConfig := ir.TypedFilterConfigMap{}
+	p.handleRequestValidation("fc", &typedFilterConfig, out.requestValidation)
+
+	assert.Equal(t, out.requestValidation.perRoute, typedFilterConfig.GetTypedConfig(requestValidationFilterName))
+	require.Contains(t, p.requestValidationInChain, "fc")
+	// the matcher of the filter chain is empty, only the routes with a policy are validated
+	assert.Nil(t, p.requestValidationInChain["fc"].GetXdsMatcher().GetMatcherType())
+}
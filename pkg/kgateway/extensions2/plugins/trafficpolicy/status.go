@@ -3,14 +3,27 @@ package trafficpolicy
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"istio.io/istio/pkg/kube/kclient"
+	"istio.io/istio/pkg/kube/krt"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/policysupport"
 	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
 )
 
 func getPolicyStatusFn(
@@ -46,3 +59,213 @@ func patchPolicyStatusFn(
 		return nil
 	}
 }
+
+// reportPolicyExpiry reports the Expiring reason on the Accepted condition of the policies about to expire, and
+// the Expired reason on the ancestors of the expired policies, which are no longer attached to anything.
+func reportPolicyExpiry(
+	kctx krt.HandlerContext,
+	gk schema.GroupKind,
+	controllerName string,
+	policies krt.Collection[ir.PolicyWrapper],
+	reportMap *reports.ReportMap,
+) {
+	rp := reports.NewReporter(reportMap)
+	for _, policy := range krt.Fetch(kctx, policies) {
+		policyIR, ok := policy.PolicyIR.(*TrafficPolicy)
+		if !ok || policyIR.expiry == nil {
+			continue
+		}
+		policyCR, ok := policy.Policy.(*kgateway.TrafficPolicy)
+		if !ok {
+			continue
+		}
+		key := reporter.PolicyKey{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policy.Namespace,
+			Name:      policy.Name,
+		}
+
+		switch {
+		case policyIR.expiry.IsExpired():
+			pr := rp.Policy(key, policyCR.GetGeneration())
+			for _, ancestorRef := range expiredAncestorRefs(policyCR, controllerName) {
+				r := pr.AncestorRef(ancestorRef)
+				r.SetCondition(reporter.PolicyCondition{
+					Type:    string(shared.PolicyConditionAccepted),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(shared.PolicyReasonExpired),
+					Message: policyIR.expiry.Message(),
+				})
+				r.SetCondition(reporter.PolicyCondition{
+					Type:    string(shared.PolicyConditionAttached),
+					Status:  metav1.ConditionFalse,
+					Reason:  string(shared.PolicyReasonExpired),
+					Message: policyIR.expiry.Message(),
+				})
+			}
+
+		case policyIR.expiry.IsExpiring():
+			pr := reportMap.Policies[key]
+			if pr == nil {
+				continue
+			}
+			for _, ancestor := range pr.Ancestors {
+				// only warn on accepted policies, errors are more relevant than the expiry
+				cond := meta.FindStatusCondition(ancestor.Conditions, string(shared.PolicyConditionAccepted))
+				if cond == nil || cond.Reason != string(shared.PolicyReasonValid) {
+					continue
+				}
+				ancestor.SetCondition(reporter.PolicyCondition{
+					Type:    string(shared.PolicyConditionAccepted),
+					Status:  metav1.ConditionTrue,
+					Reason:  string(shared.PolicyReasonExpiring),
+					Message: policyIR.expiry.Message(),
+				})
+			}
+		}
+	}
+}
+
+// reportUnsupportedFields reports the UnsupportedField reason on the Accepted condition of the accepted policies
+// setting fields Envoy ignores for one of their targets, as listed by the policy support matrix.
+func reportUnsupportedFields(
+	kctx krt.HandlerContext,
+	gk schema.GroupKind,
+	policies krt.Collection[ir.PolicyWrapper],
+	reportMap *reports.ReportMap,
+) {
+	for _, policy := range krt.Fetch(kctx, policies) {
+		policyCR, ok := policy.Policy.(*kgateway.TrafficPolicy)
+		if !ok {
+			continue
+		}
+		key := reporter.PolicyKey{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policy.Namespace,
+			Name:      policy.Name,
+		}
+		pr := reportMap.Policies[key]
+		if pr == nil {
+			continue
+		}
+		var unsupported []string
+		for _, target := range policy.TargetRefs {
+			for _, f := range policysupport.Unsupported(gk.Kind, policysupport.Envoy, policyCR.Spec, target.Kind) {
+				if !slices.Contains(unsupported, f.String()) {
+					unsupported = append(unsupported, f.String())
+				}
+			}
+		}
+		if len(unsupported) == 0 {
+			continue
+		}
+		for _, ancestor := range pr.Ancestors {
+			// errors are more relevant than the ignored fields
+			cond := meta.FindStatusCondition(ancestor.Conditions, string(shared.PolicyConditionAccepted))
+			if cond == nil || cond.Reason != string(shared.PolicyReasonValid) {
+				continue
+			}
+			ancestor.SetCondition(reporter.PolicyCondition{
+				Type:    string(shared.PolicyConditionAccepted),
+				Status:  metav1.ConditionTrue,
+				Reason:  string(shared.PolicyReasonUnsupportedField),
+				Message: "fields not supported by Envoy: " + strings.Join(unsupported, ", "),
+			})
+		}
+	}
+}
+
+// reportFilterChainConflicts reports the Conflicted reason on the Accepted condition of the accepted policies
+// whose settings of a filter shared by all the routes of a filter chain differ from the ones of another policy
+// attached to the same ancestor, as only the settings of one of them are applied to the filter chain.
+// The settings function returns nil for the policies not configuring the filter.
+func reportFilterChainConflicts(
+	kctx krt.HandlerContext,
+	gk schema.GroupKind,
+	policies krt.Collection[ir.PolicyWrapper],
+	reportMap *reports.ReportMap,
+	field string,
+	settings func(spec kgateway.TrafficPolicySpec) any,
+) {
+	type policySettings struct {
+		key      reporter.PolicyKey
+		settings any
+	}
+	byAncestor := map[reports.ParentRefKey][]policySettings{}
+	for _, policy := range krt.Fetch(kctx, policies) {
+		policyCR, ok := policy.Policy.(*kgateway.TrafficPolicy)
+		if !ok {
+			continue
+		}
+		s := settings(policyCR.Spec)
+		if s == nil {
+			continue
+		}
+		key := reporter.PolicyKey{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policy.Namespace,
+			Name:      policy.Name,
+		}
+		pr := reportMap.Policies[key]
+		if pr == nil {
+			continue
+		}
+		for ancestorKey := range pr.Ancestors {
+			byAncestor[ancestorKey] = append(byAncestor[ancestorKey], policySettings{key: key, settings: s})
+		}
+	}
+
+	for ancestorKey, attached := range byAncestor {
+		for _, pol := range attached {
+			var conflicting []string
+			for _, other := range attached {
+				if other.key != pol.key && !apiequality.Semantic.DeepEqual(pol.settings, other.settings) {
+					conflicting = append(conflicting, other.key.Namespace+"/"+other.key.Name)
+				}
+			}
+			if len(conflicting) == 0 {
+				continue
+			}
+			slices.Sort(conflicting)
+			ancestor := reportMap.Policies[pol.key].Ancestors[ancestorKey]
+			// errors are more relevant than the conflicts
+			cond := meta.FindStatusCondition(ancestor.Conditions, string(shared.PolicyConditionAccepted))
+			if cond == nil || cond.Reason != string(shared.PolicyReasonValid) {
+				continue
+			}
+			ancestor.SetCondition(reporter.PolicyCondition{
+				Type:   string(shared.PolicyConditionAccepted),
+				Status: metav1.ConditionTrue,
+				Reason: string(shared.PolicyReasonConflicted),
+				Message: fmt.Sprintf("%s settings conflict with the ones of %s %s; the routes of a listener share the settings of a single policy",
+					field, gk.Kind, strings.Join(conflicting, ", ")),
+			})
+		}
+	}
+}
+
+// expiredAncestorRefs returns the ancestors to report the expiry of the policy on: the ancestors the policy was
+// attached to before it expired, or its targetRefs when it never was.
+func expiredAncestorRefs(policy *kgateway.TrafficPolicy, controllerName string) []gwv1.ParentReference {
+	var refs []gwv1.ParentReference
+	for _, ancestor := range policy.Status.Ancestors {
+		if string(ancestor.ControllerName) == controllerName {
+			refs = append(refs, ancestor.AncestorRef)
+		}
+	}
+	if len(refs) > 0 {
+		return refs
+	}
+	for _, targetRef := range policy.Spec.TargetRefs {
+		refs = append(refs, gwv1.ParentReference{
+			Group:     ptr.To(targetRef.Group),
+			Kind:      ptr.To(targetRef.Kind),
+			Namespace: ptr.To(gwv1.Namespace(policy.Namespace)),
+			Name:      targetRef.Name,
+		})
+	}
+	return refs
+}
@@ -0,0 +1,227 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoytapv3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	commontapv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	tapv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	pluginsdkutils "github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/utils"
+)
+
+const tapFilterName = "envoy.filters.http.tap"
+
+var errTapNotEnabled = errors.New("tap is not enabled; set KGW_ENABLE_TAP=true in the controller to enable it")
+
+type tapIR struct {
+	// config is the tap filter config, shared by all the routes of a filter chain
+	config  *tapv3.Tap
+	disable bool
+}
+
+var _ PolicySubIR = &tapIR{}
+
+func (t *tapIR) Equals(other PolicySubIR) bool {
+	otherTap, ok := other.(*tapIR)
+	if !ok {
+		return false
+	}
+	if t == nil || otherTap == nil {
+		return t == nil && otherTap == nil
+	}
+	if t.disable != otherTap.disable {
+		return false
+	}
+	return proto.Equal(t.config, otherTap.config)
+}
+
+func (t *tapIR) Validate() error {
+	if t == nil || t.config == nil {
+		return nil
+	}
+	return t.config.Validate()
+}
+
+// constructTap constructs the tap policy IR from the policy specification.
+// Policies setting tap are rejected unless tap is enabled in the controller settings.
+func constructTap(spec kgateway.TrafficPolicySpec, enabled bool, out *trafficPolicySpecIr) error {
+	if spec.Tap == nil {
+		return nil
+	}
+	if !enabled {
+		return errTapNotEnabled
+	}
+
+	if spec.Tap.Disable != nil {
+		out.tap = &tapIR{
+			disable: true,
+		}
+		return nil
+	}
+
+	sink := spec.Tap.Sink
+	if sink == nil {
+		return errors.New("tap sink must be set")
+	}
+	config := &tapv3.Tap{
+		CommonConfig: &commontapv3.CommonExtensionConfig{},
+	}
+	if sink.Admin != nil {
+		config.CommonConfig.ConfigType = &commontapv3.CommonExtensionConfig_AdminConfig{
+			AdminConfig: &commontapv3.AdminConfig{
+				ConfigId: sink.Admin.ConfigID,
+			},
+		}
+		out.tap = &tapIR{
+			config: config,
+		}
+		return nil
+	}
+	if sink.File == nil {
+		return errors.New("tap sink must set one of file or admin")
+	}
+
+	match, err := translateTapMatch(spec.Tap.Match)
+	if err != nil {
+		return fmt.Errorf("tap: %w", err)
+	}
+	streaming := ptr.Deref(sink.File.Streaming, false)
+	outputConfig := &envoytapv3.OutputConfig{
+		Sinks: []*envoytapv3.OutputSink{{
+			Format: translateTapFormat(ptr.Deref(sink.File.Format, kgateway.TapFormatJsonBodyAsString), streaming),
+			OutputSinkType: &envoytapv3.OutputSink_FilePerTap{
+				FilePerTap: &envoytapv3.FilePerTapSink{
+					PathPrefix: sink.File.PathPrefix,
+				},
+			},
+		}},
+		Streaming: streaming,
+	}
+	if size := spec.Tap.MaxBufferedBodySize; size != nil {
+		maxBytes := size.Value()
+		if maxBytes < 0 || maxBytes > math.MaxUint32 {
+			maxBytes = math.MaxUint32
+		}
+		outputConfig.MaxBufferedRxBytes = wrapperspb.UInt32(uint32(maxBytes)) //nolint:gosec // G115: validated above
+		outputConfig.MaxBufferedTxBytes = wrapperspb.UInt32(uint32(maxBytes)) //nolint:gosec // G115: validated above
+	}
+	config.CommonConfig.ConfigType = &commontapv3.CommonExtensionConfig_StaticConfig{
+		StaticConfig: &envoytapv3.TapConfig{
+			Match:        match,
+			OutputConfig: outputConfig,
+		},
+	}
+
+	out.tap = &tapIR{
+		config: config,
+	}
+	return nil
+}
+
+// translateTapMatch returns the predicate matching the requests whose headers and response headers match.
+func translateTapMatch(match *kgateway.TapMatch) (*envoymatcherv3.MatchPredicate, error) {
+	var rules []*envoymatcherv3.MatchPredicate
+	if match != nil && len(match.RequestHeaders) > 0 {
+		headers, err := toEnvoyHeaderMatchers(match.RequestHeaders)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &envoymatcherv3.MatchPredicate{
+			Rule: &envoymatcherv3.MatchPredicate_HttpRequestHeadersMatch{
+				HttpRequestHeadersMatch: &envoymatcherv3.HttpHeadersMatch{Headers: headers},
+			},
+		})
+	}
+	if match != nil && len(match.ResponseHeaders) > 0 {
+		headers, err := toEnvoyHeaderMatchers(match.ResponseHeaders)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, &envoymatcherv3.MatchPredicate{
+			Rule: &envoymatcherv3.MatchPredicate_HttpResponseHeadersMatch{
+				HttpResponseHeadersMatch: &envoymatcherv3.HttpHeadersMatch{Headers: headers},
+			},
+		})
+	}
+	switch len(rules) {
+	case 0:
+		return &envoymatcherv3.MatchPredicate{
+			Rule: &envoymatcherv3.MatchPredicate_AnyMatch{AnyMatch: true},
+		}, nil
+	case 1:
+		return rules[0], nil
+	default:
+		return &envoymatcherv3.MatchPredicate{
+			Rule: &envoymatcherv3.MatchPredicate_AndMatch{
+				AndMatch: &envoymatcherv3.MatchPredicate_MatchSet{Rules: rules},
+			},
+		}, nil
+	}
+}
+
+// toEnvoyHeaderMatchers converts the header matches, defaulting their type to Exact as the API server does.
+func toEnvoyHeaderMatchers(headers []gwv1.HTTPHeaderMatch) ([]*envoyroutev3.HeaderMatcher, error) {
+	defaulted := make([]gwv1.HTTPHeaderMatch, 0, len(headers))
+	for _, h := range headers {
+		if h.Type == nil {
+			h.Type = ptr.To(gwv1.HeaderMatchExact)
+		}
+		defaulted = append(defaulted, h)
+	}
+	return pluginsdkutils.ToEnvoyHeaderMatchers(defaulted)
+}
+
+// translateTapFormat returns the output format of captures.
+// Binary captures written as a stream of events must be length delimited.
+func translateTapFormat(format kgateway.TapFormat, streaming bool) envoytapv3.OutputSink_Format {
+	switch format {
+	case kgateway.TapFormatJsonBodyAsBytes:
+		return envoytapv3.OutputSink_JSON_BODY_AS_BYTES
+	case kgateway.TapFormatProtoBinary:
+		if streaming {
+			return envoytapv3.OutputSink_PROTO_BINARY_LENGTH_DELIMITED
+		}
+		return envoytapv3.OutputSink_PROTO_BINARY
+	case kgateway.TapFormatProtoText:
+		return envoytapv3.OutputSink_PROTO_TEXT
+	default:
+		return envoytapv3.OutputSink_JSON_BODY_AS_STRING
+	}
+}
+
+// handleTap enables the tap filter for the route or virtual host and registers the disabled tap filter in the
+// filter chain. The filter does not support per-route configuration, so the first config registered for a filter
+// chain is used for all of its routes.
+func (p *trafficPolicyPluginGwPass) handleTap(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, tap *tapIR) {
+	if tap == nil {
+		return
+	}
+
+	// Handle disable case - disable the filter to override parent policy
+	if tap.disable {
+		pCtxTypedFilterConfig.AddTypedConfig(tapFilterName, DisableFilterPerRoute())
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(tapFilterName, EnableFilterPerRoute())
+
+	if p.tapInChain == nil {
+		p.tapInChain = make(map[string]*tapv3.Tap)
+	}
+	if existing, ok := p.tapInChain[fcn]; !ok {
+		p.tapInChain[fcn] = tap.config
+	} else if !proto.Equal(existing, tap.config) {
+		logger.Warn("conflicting tap settings for filter chain; using the first ones", "filter_chain", fcn)
+	}
+}
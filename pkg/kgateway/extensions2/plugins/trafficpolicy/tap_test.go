@@ -0,0 +1,164 @@
+package trafficpolicy
+
+import (
+	"testing"
+
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/matcher/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoytapv3 "github.com/envoyproxy/go-control-plane/envoy/config/tap/v3"
+	commontapv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/tap/v3"
+	tapv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	typematcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestConstructTap(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *kgateway.Tap
+		want *tapIR
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "file sink",
+			in: &kgateway.Tap{
+				Match: &kgateway.TapMatch{
+					RequestHeaders: []gwv1.HTTPHeaderMatch{{Name: "x-debug", Value: "true"}},
+				},
+				Sink: &kgateway.TapSink{
+					File: &kgateway.FileTapSink{
+						PathPrefix: "/var/run/tap/capture",
+						Streaming:  ptr.To(true),
+						Format:     ptr.To(kgateway.TapFormatProtoBinary),
+					},
+				},
+				MaxBufferedBodySize: ptr.To(resource.MustParse("64Ki")),
+			},
+			want: &tapIR{
+				config: &tapv3.Tap{
+					CommonConfig: &commontapv3.CommonExtensionConfig{
+						ConfigType: &commontapv3.CommonExtensionConfig_StaticConfig{
+							StaticConfig: &envoytapv3.TapConfig{
+								Match: &envoymatcherv3.MatchPredicate{
+									Rule: &envoymatcherv3.MatchPredicate_HttpRequestHeadersMatch{
+										HttpRequestHeadersMatch: &envoymatcherv3.HttpHeadersMatch{
+											Headers: []*envoyroutev3.HeaderMatcher{{
+												Name: "x-debug",
+												HeaderMatchSpecifier: &envoyroutev3.HeaderMatcher_StringMatch{
+													StringMatch: &typematcherv3.StringMatcher{
+														MatchPattern: &typematcherv3.StringMatcher_Exact{Exact: "true"},
+													},
+												},
+											}},
+										},
+									},
+								},
+								OutputConfig: &envoytapv3.OutputConfig{
+									Sinks: []*envoytapv3.OutputSink{{
+										Format: envoytapv3.OutputSink_PROTO_BINARY_LENGTH_DELIMITED,
+										OutputSinkType: &envoytapv3.OutputSink_FilePerTap{
+											FilePerTap: &envoytapv3.FilePerTapSink{PathPrefix: "/var/run/tap/capture"},
+										},
+									}},
+									MaxBufferedRxBytes: wrapperspb.UInt32(64 * 1024),
+									MaxBufferedTxBytes: wrapperspb.UInt32(64 * 1024),
+									Streaming:          true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "admin sink",
+			in: &kgateway.Tap{
+				Sink: &kgateway.TapSink{
+					Admin: &kgateway.AdminTapSink{ConfigID: "debug"},
+				},
+			},
+			want: &tapIR{
+				config: &tapv3.Tap{
+					CommonConfig: &commontapv3.CommonExtensionConfig{
+						ConfigType: &commontapv3.CommonExtensionConfig_AdminConfig{
+							AdminConfig: &commontapv3.AdminConfig{ConfigId: "debug"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "disable",
+			in: &kgateway.Tap{
+				Disable: &shared.PolicyDisable{},
+			},
+			want: &tapIR{
+				disable: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			out := &trafficPolicySpecIr{}
+			err := constructTap(kgateway.TrafficPolicySpec{
+				Tap: tt.in,
+			}, true, out)
+			a.NoError(err)
+
+			a.True(tt.want.Equals(out.tap))
+			a.NoError(out.tap.Validate())
+		})
+	}
+}
+
+func TestConstructTapNotEnabled(t *testing.T) {
+	a := assert.New(t)
+
+	out := &trafficPolicySpecIr{}
+	err := constructTap(kgateway.TrafficPolicySpec{
+		Tap: &kgateway.Tap{
+			Sink: &kgateway.TapSink{File: &kgateway.FileTapSink{PathPrefix: "/var/run/tap/capture"}},
+		},
+	}, false, out)
+	a.ErrorIs(err, errTapNotEnabled)
+	a.Nil(out.tap)
+}
+
+func TestHandleTap(t *testing.T) {
+	a := assert.New(t)
+
+	enabled := &trafficPolicySpecIr{}
+	a.NoError(constructTap(kgateway.TrafficPolicySpec{
+		Tap: &kgateway.Tap{
+			Sink: &kgateway.TapSink{File: &kgateway.FileTapSink{PathPrefix: "/var/run/tap/capture"}},
+		},
+	}, true, enabled))
+	disabled := &trafficPolicySpecIr{}
+	a.NoError(constructTap(kgateway.TrafficPolicySpec{
+		Tap: &kgateway.Tap{Disable: &shared.PolicyDisable{}},
+	}, true, disabled))
+
+	p := &trafficPolicyPluginGwPass{}
+
+	enabledRoute := ir.TypedFilterConfigMap{}
+	p.handleTap("fc", &enabledRoute, enabled.tap)
+	a.Equal(EnableFilterPerRoute(), enabledRoute.GetTypedConfig(tapFilterName))
+	a.Contains(p.tapInChain, "fc")
+
+	disabledRoute := ir.TypedFilterConfigMap{}
+	p.handleTap("fc", &disabledRoute, disabled.tap)
+	a.Equal(DisableFilterPerRoute(), disabledRoute.GetTypedConfig(tapFilterName))
+}
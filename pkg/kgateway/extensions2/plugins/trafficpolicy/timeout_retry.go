@@ -2,6 +2,7 @@ package trafficpolicy
 
 import (
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 
@@ -33,12 +34,17 @@ func (a *retryIR) Validate() error {
 	if a == nil || a.policy == nil {
 		return nil
 	}
+	if err := policy.ValidateRetryBackOff(a.policy); err != nil {
+		return err
+	}
 	return a.policy.Validate()
 }
 
 type timeoutsIR struct {
 	routeTimeout           *durationpb.Duration
 	routeStreamIdleTimeout *durationpb.Duration
+	maxStreamDuration      *durationpb.Duration
+	perTryIdleTimeout      *durationpb.Duration
 }
 
 func (a *timeoutsIR) Equals(other PolicySubIR) bool {
@@ -50,7 +56,9 @@ func (a *timeoutsIR) Equals(other PolicySubIR) bool {
 		return a == nil && b == nil
 	}
 	return proto.Equal(a.routeTimeout, b.routeTimeout) &&
-		proto.Equal(a.routeStreamIdleTimeout, b.routeStreamIdleTimeout)
+		proto.Equal(a.routeStreamIdleTimeout, b.routeStreamIdleTimeout) &&
+		proto.Equal(a.maxStreamDuration, b.maxStreamDuration) &&
+		proto.Equal(a.perTryIdleTimeout, b.perTryIdleTimeout)
 }
 
 func (a *timeoutsIR) Validate() error {
@@ -69,6 +77,12 @@ func constructTimeoutRetry(
 		if spec.Timeouts.StreamIdle != nil {
 			out.timeouts.routeStreamIdleTimeout = durationpb.New(spec.Timeouts.StreamIdle.Duration)
 		}
+		if spec.Timeouts.MaxStreamDuration != nil {
+			out.timeouts.maxStreamDuration = durationpb.New(spec.Timeouts.MaxStreamDuration.Duration)
+		}
+		if spec.Timeouts.PerTryIdle != nil {
+			out.timeouts.perTryIdleTimeout = durationpb.New(spec.Timeouts.PerTryIdle.Duration)
+		}
 	}
 
 	if spec.Retry != nil {
@@ -77,3 +91,32 @@ func constructTimeoutRetry(
 		}
 	}
 }
+
+// applyPerTryIdleTimeout sets the per try idle timeout on the retry policy of the route, creating it if needed.
+// The retry policy may be shared with other routes, so it is copied before being modified.
+func applyPerTryIdleTimeout(timeouts *timeoutsIR, action *envoyroutev3.RouteAction) {
+	if timeouts == nil || timeouts.perTryIdleTimeout == nil {
+		return
+	}
+	retryPolicy := &envoyroutev3.RetryPolicy{}
+	if action.GetRetryPolicy() != nil {
+		retryPolicy = proto.Clone(action.GetRetryPolicy()).(*envoyroutev3.RetryPolicy)
+	}
+	retryPolicy.PerTryIdleTimeout = timeouts.perTryIdleTimeout
+	action.RetryPolicy = retryPolicy
+}
+
+// applyTcpProxyTimeouts applies the timeouts of a policy attached to a TCPRoute or TLSRoute to its TcpProxy.
+// The stream idle timeout and the max stream duration apply to the proxied connections, the other timeouts
+// are specific to HTTP requests.
+func applyTcpProxyTimeouts(timeouts *timeoutsIR, out *envoytcp.TcpProxy) {
+	if timeouts == nil {
+		return
+	}
+	if timeouts.routeStreamIdleTimeout != nil {
+		out.IdleTimeout = timeouts.routeStreamIdleTimeout
+	}
+	if timeouts.maxStreamDuration != nil {
+		out.MaxDownstreamConnectionDuration = timeouts.maxStreamDuration
+	}
+}
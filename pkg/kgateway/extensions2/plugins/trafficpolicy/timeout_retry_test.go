@@ -0,0 +1,79 @@
+package trafficpolicy
+
+import (
+	"testing"
+	"time"
+
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+func TestConstructTimeouts(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	constructTimeoutRetry(kgateway.TrafficPolicySpec{
+		Timeouts: &shared.Timeouts{
+			MaxStreamDuration: &metav1.Duration{Duration: time.Hour},
+			PerTryIdle:        &metav1.Duration{Duration: 30 * time.Second},
+		},
+	}, out)
+
+	require.NotNil(t, out.timeouts)
+	assert.Nil(t, out.timeouts.routeTimeout)
+	assert.Equal(t, time.Hour, out.timeouts.maxStreamDuration.AsDuration())
+	assert.Equal(t, 30*time.Second, out.timeouts.perTryIdleTimeout.AsDuration())
+}
+
+func TestApplyPerTryIdleTimeout(t *testing.T) {
+	timeouts := &timeoutsIR{perTryIdleTimeout: durationpb.New(30 * time.Second)}
+
+	t.Run("without retry policy", func(t *testing.T) {
+		action := &envoyroutev3.RouteAction{}
+		applyPerTryIdleTimeout(timeouts, action)
+		assert.Equal(t, 30*time.Second, action.GetRetryPolicy().GetPerTryIdleTimeout().AsDuration())
+	})
+
+	t.Run("with shared retry policy", func(t *testing.T) {
+		sharedPolicy := &envoyroutev3.RetryPolicy{RetryOn: "5xx"}
+		action := &envoyroutev3.RouteAction{RetryPolicy: sharedPolicy}
+		applyPerTryIdleTimeout(timeouts, action)
+
+		assert.Equal(t, "5xx", action.GetRetryPolicy().GetRetryOn())
+		assert.Equal(t, 30*time.Second, action.GetRetryPolicy().GetPerTryIdleTimeout().AsDuration())
+		// the retry policy of the other routes is not modified
+		assert.Nil(t, sharedPolicy.GetPerTryIdleTimeout())
+	})
+
+	t.Run("unset", func(t *testing.T) {
+		action := &envoyroutev3.RouteAction{}
+		applyPerTryIdleTimeout(&timeoutsIR{}, action)
+		assert.Nil(t, action.GetRetryPolicy())
+	})
+}
+
+func TestApplyTcpProxyTimeouts(t *testing.T) {
+	out := &trafficPolicySpecIr{}
+	constructTimeoutRetry(kgateway.TrafficPolicySpec{
+		Timeouts: &shared.Timeouts{
+			Request:           &metav1.Duration{Duration: 10 * time.Second},
+			StreamIdle:        &metav1.Duration{Duration: time.Minute},
+			MaxStreamDuration: &metav1.Duration{Duration: time.Hour},
+		},
+	}, out)
+
+	tcpProxy := &envoytcp.TcpProxy{StatPrefix: "test"}
+	applyTcpProxyTimeouts(out.timeouts, tcpProxy)
+	assert.Equal(t, time.Minute, tcpProxy.GetIdleTimeout().AsDuration())
+	assert.Equal(t, time.Hour, tcpProxy.GetMaxDownstreamConnectionDuration().AsDuration())
+
+	unset := &envoytcp.TcpProxy{StatPrefix: "test"}
+	applyTcpProxyTimeouts(nil, unset)
+	assert.Nil(t, unset.GetIdleTimeout())
+	assert.Nil(t, unset.GetMaxDownstreamConnectionDuration())
+}
@@ -5,18 +5,28 @@ import (
 	"time"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoymatchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
 	exteniondynamicmodulev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/dynamic_modules/v3"
+	admissioncontrolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
 	envoy_api_key_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/api_key_auth/v3"
 	envoy_basic_auth_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/basic_auth/v3"
 	bufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
 	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
 	corsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cors/v3"
 	envoy_csrf_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
 	decompressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/decompressor/v3"
 	dynamicmodulesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/dynamic_modules/v3"
+	envoy_fault_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	grpcwebv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
 	header_mutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	jsontometadatav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/json_to_metadata/v3"
 	localratelimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
 	envoyrbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	tapv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/tap/v3"
+	wasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_wellknown "github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	// TODO(nfuden): remove once rustformations are able to be used in a production environment
@@ -29,6 +39,7 @@ import (
 
 	apiannotations "github.com/kgateway-dev/kgateway/v2/api/annotations"
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
@@ -81,30 +92,45 @@ type PolicySubIR interface {
 type TrafficPolicy struct {
 	ct   time.Time
 	spec trafficPolicySpecIr
+	// expiry is not part of the spec as it is not merged: an expired policy is not attached at all
+	expiry *pluginsdkutils.PolicyExpiry
 }
 
 type trafficPolicySpecIr struct {
-	buffer          *bufferIR
-	extProc         *extprocIR
-	transformation  *transformationIR
-	rustformation   *rustformationIR
-	extAuth         *extAuthIR
-	localRateLimit  *localRateLimitIR
-	globalRateLimit *globalRateLimitIR
-	cors            *corsIR
-	csrf            *csrfIR
-	headerModifiers *headerModifiersIR
-	autoHostRewrite *autoHostRewriteIR
-	retry           *retryIR
-	timeouts        *timeoutsIR
-	rbac            *rbacIR
-	jwt             *jwtIr
-	compression     *compressionIR
-	decompression   *decompressionIR
-	basicAuth       *basicAuthIR
-	urlRewrite      *urlRewriteIR
-	apiKeyAuth      *apiKeyAuthIR
-	oauth2          *oauthIR
+	buffer            *bufferIR
+	extProc           *extprocIR
+	transformation    *transformationIR
+	rustformation     *rustformationIR
+	extAuth           *extAuthIR
+	localRateLimit    *localRateLimitIR
+	globalRateLimit   *globalRateLimitIR
+	cors              *corsIR
+	csrf              *csrfIR
+	headerModifiers   *headerModifiersIR
+	autoHostRewrite   *autoHostRewriteIR
+	hostRewrite       *hostRewriteIR
+	retry             *retryIR
+	timeouts          *timeoutsIR
+	rbac              *rbacIR
+	requestValidation *requestValidationIR
+	bodyMatch         *bodyMatchIR
+	jwt               *jwtIr
+	compression       *compressionIR
+	decompression     *decompressionIR
+	grpcWeb           *grpcWebIR
+	basicAuth         *basicAuthIR
+	urlRewrite        *urlRewriteIR
+	redirect          *redirectIR
+	apiKeyAuth        *apiKeyAuthIR
+	authComposition   *authCompositionIR
+	oauth2            *oauthIR
+	faultInjection    *faultInjectionIR
+	caching           *cachingIR
+	admissionControl  *admissionControlIR
+	lua               *luaIR
+	wasm              *wasmIR
+	tap               *tapIR
+	mirroring         *mirroringIR
 }
 
 func (d *TrafficPolicy) CreationTime() time.Time {
@@ -119,6 +145,9 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if d.ct != d2.ct {
 		return false
 	}
+	if !d.expiry.Equals(d2.expiry) {
+		return false
+	}
 
 	if !d.spec.transformation.Equals(d2.spec.transformation) {
 		return false
@@ -150,6 +179,9 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if !d.spec.autoHostRewrite.Equals(d2.spec.autoHostRewrite) {
 		return false
 	}
+	if !d.spec.hostRewrite.Equals(d2.spec.hostRewrite) {
+		return false
+	}
 	if !d.spec.buffer.Equals(d2.spec.buffer) {
 		return false
 	}
@@ -162,6 +194,12 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if !d.spec.rbac.Equals(d2.spec.rbac) {
 		return false
 	}
+	if !d.spec.requestValidation.Equals(d2.spec.requestValidation) {
+		return false
+	}
+	if !d.spec.bodyMatch.Equals(d2.spec.bodyMatch) {
+		return false
+	}
 	if !d.spec.jwt.Equals(d2.spec.jwt) {
 		return false
 	}
@@ -171,18 +209,48 @@ func (d *TrafficPolicy) Equals(in any) bool {
 	if !d.spec.decompression.Equals(d2.spec.decompression) {
 		return false
 	}
+	if !d.spec.grpcWeb.Equals(d2.spec.grpcWeb) {
+		return false
+	}
 	if !d.spec.basicAuth.Equals(d2.spec.basicAuth) {
 		return false
 	}
 	if !d.spec.urlRewrite.Equals(d2.spec.urlRewrite) {
 		return false
 	}
+	if !d.spec.redirect.Equals(d2.spec.redirect) {
+		return false
+	}
 	if !d.spec.apiKeyAuth.Equals(d2.spec.apiKeyAuth) {
 		return false
 	}
+	if !d.spec.authComposition.Equals(d2.spec.authComposition) {
+		return false
+	}
 	if !d.spec.oauth2.Equals(d2.spec.oauth2) {
 		return false
 	}
+	if !d.spec.faultInjection.Equals(d2.spec.faultInjection) {
+		return false
+	}
+	if !d.spec.caching.Equals(d2.spec.caching) {
+		return false
+	}
+	if !d.spec.admissionControl.Equals(d2.spec.admissionControl) {
+		return false
+	}
+	if !d.spec.lua.Equals(d2.spec.lua) {
+		return false
+	}
+	if !d.spec.wasm.Equals(d2.spec.wasm) {
+		return false
+	}
+	if !d.spec.tap.Equals(d2.spec.tap) {
+		return false
+	}
+	if !d.spec.mirroring.Equals(d2.spec.mirroring) {
+		return false
+	}
 	return true
 }
 
@@ -202,14 +270,27 @@ func (p *TrafficPolicy) Validate() error {
 	validators = append(validators, p.spec.headerModifiers.Validate)
 	validators = append(validators, p.spec.buffer.Validate)
 	validators = append(validators, p.spec.autoHostRewrite.Validate)
+	validators = append(validators, p.spec.hostRewrite.Validate)
 	validators = append(validators, p.spec.rbac.Validate)
+	validators = append(validators, p.spec.requestValidation.Validate)
+	validators = append(validators, p.spec.bodyMatch.Validate)
 	validators = append(validators, p.spec.jwt.Validate)
 	validators = append(validators, p.spec.compression.Validate)
 	validators = append(validators, p.spec.decompression.Validate)
+	validators = append(validators, p.spec.grpcWeb.Validate)
 	validators = append(validators, p.spec.basicAuth.Validate)
 	validators = append(validators, p.spec.urlRewrite.Validate)
+	validators = append(validators, p.spec.redirect.Validate)
 	validators = append(validators, p.spec.apiKeyAuth.Validate)
+	validators = append(validators, p.spec.authComposition.Validate)
 	validators = append(validators, p.spec.oauth2.Validate)
+	validators = append(validators, p.spec.faultInjection.Validate)
+	validators = append(validators, p.spec.caching.Validate)
+	validators = append(validators, p.spec.admissionControl.Validate)
+	validators = append(validators, p.spec.lua.Validate)
+	validators = append(validators, p.spec.wasm.Validate)
+	validators = append(validators, p.spec.tap.Validate)
+	validators = append(validators, p.spec.mirroring.Validate)
 	for _, validator := range validators {
 		if err := validator(); err != nil {
 			return err
@@ -231,14 +312,28 @@ type trafficPolicyPluginGwPass struct {
 	rateLimitPerProvider     ProviderNeededMap
 	oauth2PerProvider        ProviderNeededMap
 	rbacInChain              map[string]*envoyrbacv3.RBAC
+	requestValidationInChain map[string]*envoymatchingv3.ExtensionWithMatcher
+	bodyMatchInChain         map[string]*jsontometadatav3.JsonToMetadata
 	corsInChain              map[string]*corsv3.Cors
 	csrfInChain              map[string]*envoy_csrf_v3.CsrfPolicy
 	headerMutationInChain    map[string]*header_mutationv3.HeaderMutationPerRoute
 	bufferInChain            map[string]*bufferv3.Buffer
-	compressorInChain        map[string]*compressorv3.Compressor
-	decompressorInChain      map[string]*decompressorv3.Decompressor
-	basicAuthInChain         map[string]*envoy_basic_auth_v3.BasicAuth
-	apiKeyAuthInChain        map[string]*envoy_api_key_auth_v3.ApiKeyAuth
+	faultInChain             map[string]*envoy_fault_v3.HTTPFault
+	cacheInChain             map[string]*cachev3.CacheConfig
+	cachingTTLInChain        map[string]*envoymatchingv3.ExtensionWithMatcher
+	admissionControlInChain  map[string]*admissioncontrolv3.AdmissionControl
+	luaInChain               map[string]*luav3.Lua
+	wasmInChain              map[string]map[string]*wasmfilterv3.Wasm
+	// wasmModuleServer is set when a Wasm filter fetches its module from the controller
+	wasmModuleServer    *wasmModuleServer
+	tapInChain          map[string]*tapv3.Tap
+	compressorInChain   map[string]*compressorv3.Compressor
+	decompressorInChain map[string]*decompressorv3.Decompressor
+	grpcWebInChain      map[string]*grpcwebv3.GrpcWeb
+	basicAuthInChain    map[string]*envoy_basic_auth_v3.BasicAuth
+	apiKeyAuthInChain   map[string]*envoy_api_key_auth_v3.ApiKeyAuth
+	// maps filter chain name to the auth compositions of its routes, by composition name
+	authCompositionsInChain map[string]map[string]*authCompositionIR
 	// maps secret name to secret in case the same secret is referenced in multiple attachment points (e.g., vhost and route)
 	secrets map[string]*envoytlsv3.Secret
 }
@@ -283,6 +378,9 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 		if err != nil {
 			errors = append(errors, err)
 		}
+		if policyCR.Spec.Priority != nil {
+			precedenceWeight = *policyCR.Spec.Priority
+		}
 
 		var statusMarker *krtcollections.StatusMarker
 		for _, ancestor := range policyCR.Status.Ancestors {
@@ -292,11 +390,17 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 			}
 		}
 
+		targetRefs := pluginsdkutils.TargetRefsToPolicyRefsWithSectionName(policyCR.Spec.TargetRefs, policyCR.Spec.TargetSelectors)
+		if policyIR.expiry.IsExpired() {
+			// expired policies are deactivated by not attaching them; their status is reported by processMarkers
+			targetRefs = nil
+		}
+
 		pol := &ir.PolicyWrapper{
 			ObjectSource:     objSrc,
 			Policy:           policyCR,
 			PolicyIR:         policyIR,
-			TargetRefs:       pluginsdkutils.TargetRefsToPolicyRefsWithSectionName(policyCR.Spec.TargetRefs, policyCR.Spec.TargetSelectors),
+			TargetRefs:       targetRefs,
 			Errors:           errors,
 			PrecedenceWeight: precedenceWeight,
 		}
@@ -305,6 +409,16 @@ func NewPlugin(ctx context.Context, commoncol *collections.CommonCollections, me
 
 	// processMarkers for policies that have existing status but no current report
 	processMarkers := func(kctx krt.HandlerContext, reportMap *reports.ReportMap) {
+		// flag targetRefs that resolve to nothing while a targetSelector matches another object, as the target was possibly renamed
+		krtcollections.ReportPossibleTargetRenames(kctx, gk, policyCol, commoncol.GatewayIndex, commoncol.Routes, reportMap)
+		// flag the fields Envoy ignores for the targets of the policies
+		reportUnsupportedFields(kctx, gk, policyCol, reportMap)
+		// flag the policies whose settings shared by the routes of a listener conflict with another policy
+		reportFilterChainConflicts(kctx, gk, policyCol, reportMap, "caching", cachingSettings)
+		reportFilterChainConflicts(kctx, gk, policyCol, reportMap, "admission control", admissionControlSettings)
+		// flag policies that are about to expire or expired
+		reportPolicyExpiry(kctx, gk, commoncol.ControllerName, policyCol, reportMap)
+
 		objStatus := krt.Fetch(kctx, statusCol)
 		for _, status := range objStatus {
 			policyKey := reporter.PolicyKey{
@@ -386,6 +500,7 @@ func (p *trafficPolicyPluginGwPass) ApplyForRoute(pCtx *ir.RouteContext, outputR
 
 	p.handlePerRoutePolicies(policy.spec, outputRoute)
 	p.handlePolicies(pCtx.FilterChainName, &pCtx.TypedFilterConfig, policy.spec)
+	p.handleBodyMatch(pCtx.FilterChainName, policy.spec.bodyMatch)
 
 	return nil
 }
@@ -404,6 +519,18 @@ func (p *trafficPolicyPluginGwPass) ApplyForRouteBackend(
 	return nil
 }
 
+// called 1 time per TCP filter chain, with the policies attached to its TCPRoute or TLSRoute
+func (p *trafficPolicyPluginGwPass) ApplyTcpProxy(pCtx *ir.TcpProxyContext, out *envoytcp.TcpProxy) error {
+	policy, ok := pCtx.Policy.(*TrafficPolicy)
+	if !ok {
+		return nil
+	}
+
+	applyTcpProxyTimeouts(policy.spec.timeouts, out)
+
+	return nil
+}
+
 // called 1 time per listener
 // if a plugin emits new filters, they must be with a plugin unique name.
 // any filter returned from route config must be disabled, so it doesnt impact other routes.
@@ -520,6 +647,10 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.
 		stagedFilters = append(stagedFilters, stagedFilter)
 	}
 
+	// Add the filters flagging the routes with an auth composition, for the authentication filters to be skipped
+	// when their credential is missing
+	stagedFilters = p.addAuthCompositionFilters(stagedFilters, fcc.FilterChainName)
+
 	if len(p.jwtPerProvider.Providers[fcc.FilterChainName]) > 0 {
 		stagedFilters = AddDisableFilterIfNeeded(stagedFilters, jwtGlobalDisableFilterName, jwtGlobalDisableFilterMetadataNamespace)
 	}
@@ -533,7 +664,7 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.
 		jwtName := jwtFilterName(provider.Name)
 		stagedJwtFilter := filters.MustNewStagedFilter(
 			jwtName,
-			jwtFilter,
+			p.skipWithoutCredential(fcc.FilterChainName, shared.AuthenticationMechanismJWT, jwtName, jwtFilter),
 			filters.DuringStage(filters.AuthNStage),
 		)
 
@@ -594,23 +725,108 @@ func (p *trafficPolicyPluginGwPass) HttpFilters(_ ir.HttpFiltersContext, fcc ir.
 		stagedFilters = append(stagedFilters, filter)
 	}
 
+	// Add fault filter to enable fault injection for the listener.
+	// Requires the fault injection policy to be set as typed_per_filter_config.
+	if f := p.faultInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(faultFilterName, f, filters.DuringStage(filters.FaultStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add cache filter to enable response caching for the listener.
+	// Requires the filter to be enabled in typed_per_filter_config.
+	if f := p.cacheInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(cacheFilterName, f, filters.DuringStage(filters.RouteStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add the composite filter adding the Cache-Control header derived from the caching ttl. It follows the cache
+	// filter, so that the header is added to the responses before the cache filter stores them.
+	// Requires the matcher of the route to be set as typed_per_filter_config.
+	if f := p.cachingTTLInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(cachingTTLFilterName, f, filters.AfterStage(filters.RouteStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add admission control filter to shed load when the success rate of the requests drops.
+	// Requires the filter to be enabled in typed_per_filter_config.
+	if f := p.admissionControlInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(admissionControlFilterName, f, filters.DuringStage(filters.RateLimitStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add Lua filter to run the scripts of the routes of the listener.
+	// Requires the script to be set as typed_per_filter_config.
+	if f := p.luaInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(luaFilterName, f, filters.DuringStage(filters.AcceptedStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add the Wasm filters running the modules of the routes of the listener.
+	// Requires the filters to be enabled in typed_per_filter_config.
+	stagedFilters = p.addWasmFilters(fcc.FilterChainName, stagedFilters)
+
+	// Add tap filter to enable request/response capture for the listener.
+	// Requires the filter to be enabled in typed_per_filter_config.
+	if f := p.tapInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(tapFilterName, f, filters.BeforeStage(filters.FaultStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
 	if f := p.rbacInChain[fcc.FilterChainName]; f != nil {
 		filter := filters.MustNewStagedFilter(rbacFilterNamePrefix, f, filters.DuringStage(filters.AuthZStage))
 		stagedFilters = append(stagedFilters, filter)
 	}
 
+	// Add request validation filter after authorization, so that unauthorized requests are denied first.
+	// Requires the matcher of the route to be set as typed_per_filter_config.
+	if f := p.requestValidationInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(requestValidationFilterName, f, filters.AfterStage(filters.AuthZStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
+	// Add json_to_metadata filter after the buffer filter, so that the body buffered up to the limit of the
+	// route is available to extract the fields matched by the routes.
+	if f := p.bodyMatchInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(bodyMatchFilterName, f, filters.AfterStage(filters.RouteStage))
+		stagedFilters = append(stagedFilters, filter)
+	}
+
 	// Add compression and decompression filters after CORS
 	stagedFilters = addCompressionFiltersIfNeeded(stagedFilters, p, fcc.FilterChainName)
+
+	// Add gRPC-Web filter after CORS, so that CORS preflight requests of browsers are answered first.
+	// Requires the filter to be enabled in typed_per_filter_config.
+	if f := p.grpcWebInChain[fcc.FilterChainName]; f != nil {
+		filter := filters.MustNewStagedFilter(grpcWebFilterName, f, filters.AfterStage(filters.CorsStage))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+
 	// Add Basic Auth filter
 	if f := p.basicAuthInChain[fcc.FilterChainName]; f != nil {
-		filter := filters.MustNewStagedFilter(basicAuthFilterName, f, filters.DuringStage(filters.AuthNStage))
+		filter := filters.MustNewStagedFilter(
+			basicAuthFilterName,
+			p.skipWithoutCredential(fcc.FilterChainName, shared.AuthenticationMechanismBasicAuth, basicAuthFilterName, f),
+			filters.DuringStage(filters.AuthNStage),
+		)
 		filter.Filter.Disabled = true
 		stagedFilters = append(stagedFilters, filter)
 	}
 
 	// Add API key auth filter to the chain
 	if f := p.apiKeyAuthInChain[fcc.FilterChainName]; f != nil {
-		filter := filters.MustNewStagedFilter(apiKeyAuthFilterNamePrefix, f, filters.DuringStage(filters.AuthNStage))
+		filter := filters.MustNewStagedFilter(
+			apiKeyAuthFilterNamePrefix,
+			p.skipWithoutCredential(fcc.FilterChainName, shared.AuthenticationMechanismAPIKey, apiKeyAuthFilterNamePrefix, f),
+			filters.DuringStage(filters.AuthNStage),
+		)
 		filter.Filter.Disabled = true
 		stagedFilters = append(stagedFilters, filter)
 	}
@@ -627,6 +843,9 @@ func (p *trafficPolicyPluginGwPass) ResourcesToAdd() ir.Resources {
 	for _, secret := range p.secrets {
 		resources.Secrets = append(resources.Secrets, secret)
 	}
+	if p.wasmModuleServer != nil {
+		resources.Clusters = append(resources.Clusters, wasmModuleServerCluster(p.wasmModuleServer))
+	}
 	return resources
 }
 
@@ -656,11 +875,20 @@ func (p *trafficPolicyPluginGwPass) handlePolicies(
 	p.handleHeaderModifiers(fcn, typedFilterConfig, spec.headerModifiers)
 	p.handleBuffer(fcn, typedFilterConfig, spec.buffer)
 	p.handleRBAC(fcn, typedFilterConfig, spec.rbac)
+	p.handleRequestValidation(fcn, typedFilterConfig, spec.requestValidation)
 	p.handleCompression(fcn, typedFilterConfig, spec.compression)
 	p.handleDecompression(fcn, typedFilterConfig, spec.decompression)
+	p.handleGrpcWeb(fcn, typedFilterConfig, spec.grpcWeb)
 	p.handleBasicAuth(fcn, typedFilterConfig, spec.basicAuth)
 	p.handleAPIKeyAuth(fcn, typedFilterConfig, spec.apiKeyAuth)
+	p.handleAuthComposition(fcn, typedFilterConfig, spec.authComposition)
 	p.handleOauth2(fcn, typedFilterConfig, spec.oauth2)
+	p.handleFaultInjection(fcn, typedFilterConfig, spec.faultInjection)
+	p.handleCaching(fcn, typedFilterConfig, spec.caching)
+	p.handleAdmissionControl(fcn, typedFilterConfig, spec.admissionControl)
+	p.handleLua(fcn, typedFilterConfig, spec.lua)
+	p.handleWasm(fcn, typedFilterConfig, spec.wasm)
+	p.handleTap(fcn, typedFilterConfig, spec.tap)
 }
 
 // handlePerRoutePolicies handles policies that are meant to be processed at the route level
@@ -675,6 +903,9 @@ func (p *trafficPolicyPluginGwPass) handlePerRoutePolicies(
 
 	action := out.GetRoute()
 
+	// A literal or header host rewrite is more specific than the auto host rewrite, so it is applied first
+	applyHostRewrite(spec.hostRewrite, action)
+
 	if spec.autoHostRewrite != nil && spec.autoHostRewrite.enabled != nil && spec.autoHostRewrite.enabled.GetValue() {
 		// Only apply TrafficPolicy's AutoHostRewrite if built-in policy's AutoHostRewrite is not already set
 		if action.GetHostRewriteSpecifier() == nil {
@@ -691,6 +922,11 @@ func (p *trafficPolicyPluginGwPass) handlePerRoutePolicies(
 		if action.GetTimeout() == nil {
 			action.Timeout = spec.timeouts.routeTimeout
 		}
+		if spec.timeouts.maxStreamDuration != nil {
+			action.MaxStreamDuration = &envoyroutev3.RouteAction_MaxStreamDuration{
+				MaxStreamDuration: spec.timeouts.maxStreamDuration,
+			}
+		}
 	}
 
 	// Only set the retry policy if it is not already set, which implies that it was
@@ -698,9 +934,19 @@ func (p *trafficPolicyPluginGwPass) handlePerRoutePolicies(
 	if action.GetRetryPolicy() == nil && spec.retry != nil {
 		action.RetryPolicy = spec.retry.policy
 	}
+	applyPerTryIdleTimeout(spec.timeouts, action)
 
 	// Apply URL rewrite configuration
 	applyURLRewrite(spec.urlRewrite, out)
+
+	// Apply request mirroring configuration
+	applyMirroring(spec.mirroring, out)
+
+	// Restrict the route to the requests with the field of the body match
+	applyBodyMatch(spec.bodyMatch, out)
+
+	// Apply the redirect last, as it replaces the route action configured above
+	applyRedirect(spec.redirect, out)
 }
 
 // handlePerVHostPolicies handles policies that are meant to be processed at the vhost level
@@ -0,0 +1,293 @@
+package trafficpolicy
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"slices"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoyendpointv3 "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	wasmfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/wasm/v3"
+	wasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/utils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/filters"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/kubeutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/utils/namespaces"
+)
+
+const (
+	wasmFilterNamePrefix = "envoy.filters.http.wasm"
+	wasmRuntimeV8        = "envoy.wasm.runtime.v8"
+	// wasmRemoteFetchTimeoutSecs is the timeout of Envoy fetching a module served over HTTP
+	wasmRemoteFetchTimeoutSecs = 30
+	// wasmModuleServerClusterName is the cluster of the controller serving the modules pulled from images
+	wasmModuleServerClusterName = "kgateway_wasm_modules"
+)
+
+// wasmModuleServer is the address of the controller serving the modules pulled from images to the proxies.
+type wasmModuleServer struct {
+	host string
+	port uint32
+}
+
+type wasmIR struct {
+	// name is the name of the filter running the module, shared by the routes with the same module and config
+	name   string
+	filter *wasmfilterv3.Wasm
+	// moduleServer is set when the module is fetched from the controller, which requires its cluster
+	moduleServer *wasmModuleServer
+	// disable is true when the policy disables the Wasm modules of the policies applied at a higher level
+	disable bool
+}
+
+var _ PolicySubIR = &wasmIR{}
+
+func (w *wasmIR) Equals(other PolicySubIR) bool {
+	otherWasm, ok := other.(*wasmIR)
+	if !ok {
+		return false
+	}
+	if w == nil || otherWasm == nil {
+		return w == nil && otherWasm == nil
+	}
+	return w.name == otherWasm.name && w.disable == otherWasm.disable && proto.Equal(w.filter, otherWasm.filter) &&
+		ptr.Equal(w.moduleServer, otherWasm.moduleServer)
+}
+
+func (w *wasmIR) Validate() error {
+	if w == nil || w.filter == nil {
+		return nil
+	}
+	return w.filter.Validate()
+}
+
+// constructWasm constructs the Wasm policy IR from the policy specification.
+// Modules of OCI images are pulled by the controller in the background and fetched by Envoy from the controller,
+// while modules served over HTTP are fetched by Envoy from their backend.
+func constructWasm(
+	krtctx krt.HandlerContext,
+	policyCR *kgateway.TrafficPolicy,
+	backends *krtcollections.BackendIndex,
+	secrets *krtcollections.SecretIndex,
+	fetcher *wasmImageFetcher,
+	moduleServer wasmModuleServer,
+	out *trafficPolicySpecIr,
+) error {
+	spec := policyCR.Spec.Wasm
+	if spec == nil {
+		return nil
+	}
+
+	if spec.Disable != nil {
+		out.wasm = &wasmIR{disable: true}
+		return nil
+	}
+
+	var (
+		code             *envoycorev3.AsyncDataSource
+		usesModuleServer bool
+	)
+	switch {
+	case spec.Image != nil:
+		var pullSecret *ir.Secret
+		if spec.Image.PullSecretRef != nil {
+			secret, err := secrets.GetSecretWithoutRefGrant(krtctx, spec.Image.PullSecretRef.Name, policyCR.Namespace)
+			if err != nil {
+				return fmt.Errorf("wasm: failed to find pull secret %s: %w", spec.Image.PullSecretRef.Name, err)
+			}
+			pullSecret = secret
+		}
+		checksum, err := fetcher.get(krtctx, spec.Image.Ref, pullSecret)
+		if err != nil {
+			return fmt.Errorf("wasm: image %s: %w", spec.Image.Ref, err)
+		}
+		if spec.Image.SHA256 != nil && *spec.Image.SHA256 != checksum {
+			return fmt.Errorf("wasm: image %s: module checksum %s does not match the expected checksum %s", spec.Image.Ref, checksum, *spec.Image.SHA256)
+		}
+		code = &envoycorev3.AsyncDataSource{
+			Specifier: &envoycorev3.AsyncDataSource_Remote{
+				Remote: &envoycorev3.RemoteDataSource{
+					HttpUri: &envoycorev3.HttpUri{
+						Uri:     fmt.Sprintf("http://%s:%d%s%s", moduleServer.host, moduleServer.port, wasmModulePathPrefix, checksum),
+						Timeout: &durationpb.Duration{Seconds: wasmRemoteFetchTimeoutSecs},
+						HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+							Cluster: wasmModuleServerClusterName,
+						},
+					},
+					Sha256: checksum,
+				},
+			},
+		}
+		usesModuleServer = true
+	case spec.HTTP != nil:
+		policySource := ir.ObjectSource{
+			Group:     wellknown.TrafficPolicyGVK.Group,
+			Kind:      wellknown.TrafficPolicyGVK.Kind,
+			Namespace: policyCR.Namespace,
+			Name:      policyCR.Name,
+		}
+		backend, err := resolveBackend(krtctx, backends, false, policySource, spec.HTTP.BackendRef)
+		if err != nil {
+			return fmt.Errorf("wasm: unresolved backend ref: %w", err)
+		}
+		code = &envoycorev3.AsyncDataSource{
+			Specifier: &envoycorev3.AsyncDataSource_Remote{
+				Remote: &envoycorev3.RemoteDataSource{
+					HttpUri: &envoycorev3.HttpUri{
+						Uri:     spec.HTTP.URL,
+						Timeout: &durationpb.Duration{Seconds: wasmRemoteFetchTimeoutSecs},
+						HttpUpstreamType: &envoycorev3.HttpUri_Cluster{
+							Cluster: backend.ClusterName(),
+						},
+					},
+					Sha256: spec.HTTP.SHA256,
+				},
+			},
+		}
+	default:
+		return errors.New("wasm: one of image, http or disable must be set")
+	}
+
+	pluginConfig := &wasmv3.PluginConfig{
+		RootId: ptr.Deref(spec.RootID, ""),
+		Vm: &wasmv3.PluginConfig_VmConfig{
+			VmConfig: &wasmv3.VmConfig{
+				Runtime: wasmRuntimeV8,
+				Code:    code,
+			},
+		},
+		FailurePolicy: wasmv3.FailurePolicy_FAIL_CLOSED,
+	}
+	if ptr.Deref(spec.FailurePolicy, kgateway.WasmFailurePolicyFailClosed) == kgateway.WasmFailurePolicyFailOpen {
+		pluginConfig.FailurePolicy = wasmv3.FailurePolicy_FAIL_OPEN
+	}
+	if spec.Config != nil && len(spec.Config.Raw) > 0 {
+		configuration, err := utils.MessageToAny(&wrapperspb.StringValue{Value: string(spec.Config.Raw)})
+		if err != nil {
+			return fmt.Errorf("wasm: failed to convert config: %w", err)
+		}
+		pluginConfig.Configuration = configuration
+	}
+
+	// name the filter after its config, so that the routes running the same module with the same config share
+	// the filter and its VM
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(pluginConfig)
+	if err != nil {
+		return fmt.Errorf("wasm: failed to marshal config: %w", err)
+	}
+	h := fnv.New64a()
+	h.Write(b)
+	name := fmt.Sprintf("%x", h.Sum64())
+	pluginConfig.Name = name
+	pluginConfig.GetVmConfig().VmId = name
+
+	out.wasm = &wasmIR{
+		name:   wasmFilterName(name),
+		filter: &wasmfilterv3.Wasm{Config: pluginConfig},
+	}
+	if usesModuleServer {
+		out.wasm.moduleServer = &moduleServer
+	}
+	return nil
+}
+
+// handleWasm enables the Wasm filter of the module of the route or virtual host, and registers the disabled
+// filter in the filter chain.
+func (p *trafficPolicyPluginGwPass) handleWasm(fcn string, pCtxTypedFilterConfig *ir.TypedFilterConfigMap, wasm *wasmIR) {
+	if wasm == nil || wasm.disable {
+		return
+	}
+
+	pCtxTypedFilterConfig.AddTypedConfig(wasm.name, EnableFilterPerRoute())
+
+	if p.wasmInChain == nil {
+		p.wasmInChain = make(map[string]map[string]*wasmfilterv3.Wasm)
+	}
+	if p.wasmInChain[fcn] == nil {
+		p.wasmInChain[fcn] = make(map[string]*wasmfilterv3.Wasm)
+	}
+	p.wasmInChain[fcn][wasm.name] = wasm.filter
+	if wasm.moduleServer != nil {
+		p.wasmModuleServer = wasm.moduleServer
+	}
+}
+
+// addWasmFilters adds the disabled Wasm filters of the filter chain, after the authorization filters so that
+// modules only see authenticated and authorized requests.
+func (p *trafficPolicyPluginGwPass) addWasmFilters(fcn string, stagedFilters []filters.StagedHttpFilter) []filters.StagedHttpFilter {
+	wasmFilters := p.wasmInChain[fcn]
+	for _, name := range slices.Sorted(maps.Keys(wasmFilters)) {
+		filter := filters.MustNewStagedFilter(name, wasmFilters[name], filters.AfterStage(filters.WellKnownFilterStage(filters.AuthZStage)))
+		filter.Filter.Disabled = true
+		stagedFilters = append(stagedFilters, filter)
+	}
+	return stagedFilters
+}
+
+// wasmModuleServerCluster returns the cluster of the controller serving the modules pulled from images.
+func wasmModuleServerCluster(server *wasmModuleServer) *envoyclusterv3.Cluster {
+	return &envoyclusterv3.Cluster{
+		Name:                 wasmModuleServerClusterName,
+		AltStatName:          wasmModuleServerClusterName,
+		ConnectTimeout:       &durationpb.Duration{Seconds: 5},
+		ClusterDiscoveryType: &envoyclusterv3.Cluster_Type{Type: envoyclusterv3.Cluster_STRICT_DNS},
+		RespectDnsTtl:        true,
+		LoadAssignment: &envoyendpointv3.ClusterLoadAssignment{
+			ClusterName: wasmModuleServerClusterName,
+			Endpoints: []*envoyendpointv3.LocalityLbEndpoints{
+				{
+					LbEndpoints: []*envoyendpointv3.LbEndpoint{
+						{
+							HostIdentifier: &envoyendpointv3.LbEndpoint_Endpoint{
+								Endpoint: &envoyendpointv3.Endpoint{
+									Address: &envoycorev3.Address{
+										Address: &envoycorev3.Address_SocketAddress{
+											SocketAddress: &envoycorev3.SocketAddress{
+												Address: server.host,
+												PortSpecifier: &envoycorev3.SocketAddress_PortValue{
+													PortValue: server.port,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newWasmModuleServer returns the address of the controller serving the modules pulled from images, on the host
+// serving xDS to the proxies.
+func newWasmModuleServer(settings apisettings.Settings) wasmModuleServer {
+	host := settings.XdsServiceHost
+	if host == "" {
+		host = kubeutils.ServiceFQDN(metav1.ObjectMeta{
+			Name:      settings.XdsServiceName,
+			Namespace: namespaces.GetPodNamespace(),
+		})
+	}
+	return wasmModuleServer{host: host, port: settings.WasmServicePort}
+}
+
+func wasmFilterName(name string) string {
+	return fmt.Sprintf("%s/%s", wasmFilterNamePrefix, name)
+}
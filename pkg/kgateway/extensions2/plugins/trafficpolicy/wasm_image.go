@@ -0,0 +1,393 @@
+package trafficpolicy
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/avast/retry-go/v4"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"istio.io/istio/pkg/kube/krt"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+const (
+	// maxWasmModuleSize is the maximum size of a module pulled from an image
+	maxWasmModuleSize = 16 << 20
+	// maxWasmModuleCacheSize is the maximum total size of the modules held by the controller to serve them to the
+	// proxies. Pulling a module which does not fit fails until the modules of deleted policies are released.
+	maxWasmModuleCacheSize = 256 << 20
+
+	// wasmLayerMediaType is the media type of the layer of Wasm OCI artifacts
+	// See https://tag-runtime.cncf.io/wgs/wasm/deliverables/wasm-oci-artifact/
+	wasmLayerMediaType = "application/vnd.wasm.content.layer.v1+wasm"
+	// wasmModuleLayerMediaType is the media type of the layer of Wasm OCI artifacts built by older tools
+	wasmModuleLayerMediaType = "application/vnd.module.wasm.content.layer.v1+wasm"
+
+	// wasmModulePathPrefix is the path prefix of the modules served to the proxies, followed by their sha256
+	wasmModulePathPrefix = "/wasm/"
+)
+
+// wasmMagic is the preamble of binary Wasm modules
+var wasmMagic = []byte{0x00, 'a', 's', 'm'}
+
+// wasmImages pulls the modules of the images of all the policies, and serves them to the proxies
+var wasmImages = newWasmImageFetcher()
+
+// errWasmModulePulling is returned while the module of an image is being pulled. The policies using the image are
+// recomputed once the pull completes.
+var errWasmModulePulling = errors.New("module is being pulled")
+
+// wasmImageFetcher pulls the Wasm modules of OCI images in the background, so that policies are not blocked on
+// registries, and holds them in memory to serve them to the proxies.
+type wasmImageFetcher struct {
+	mu sync.Mutex
+	// modules are the modules per image reference and pull secret
+	modules map[string]*wasmModule
+	// size is the total size of the modules
+	size    int
+	maxSize int
+
+	cacheRefreshInterval time.Duration
+	refreshOnce          sync.Once
+	// trigger recomputes the policies using images when a pull completes, or when the module of a tag changes
+	trigger *krt.RecomputeTrigger
+	// remoteOptions are added to the options used to pull the images
+	remoteOptions []remote.Option
+}
+
+type wasmModule struct {
+	imageRef   string
+	pullSecret *ir.Secret
+
+	module []byte
+	// sha256 is the hex encoded checksum of the module, which the proxies fetch it by
+	sha256 string
+	// err is the error of the last pull, when no module was pulled yet
+	err     error
+	pulling bool
+	// pinned is true for images referenced by digest, which never change
+	pinned bool
+	// used is true when a policy used the module since the last refresh
+	used bool
+}
+
+// newWasmImageFetcher returns a wasmImageFetcher instance that is responsible for pulling the Wasm modules of
+// OCI images, and for periodically refreshing the modules of images referenced by tag
+func newWasmImageFetcher() *wasmImageFetcher {
+	return &wasmImageFetcher{
+		modules:              map[string]*wasmModule{},
+		maxSize:              maxWasmModuleCacheSize,
+		cacheRefreshInterval: 5 * time.Minute,
+		trigger:              krt.NewRecomputeTrigger(true),
+	}
+}
+
+// startRefresh starts refreshing the modules in the background, once per fetcher.
+func (f *wasmImageFetcher) startRefresh(ctx context.Context) {
+	f.refreshOnce.Do(func() {
+		go f.refresh(ctx)
+	})
+}
+
+// refresh periodically releases the modules no policy uses anymore, pulls the images referenced by tag again to
+// pick up retagged images, and retries the failed pulls.
+func (f *wasmImageFetcher) refresh(ctx context.Context) {
+	ticker := time.NewTicker(f.cacheRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			f.refreshModules()
+		}
+	}
+}
+
+func (f *wasmImageFetcher) refreshModules() {
+	f.mu.Lock()
+	for key, m := range f.modules {
+		if !m.used && !m.pulling {
+			delete(f.modules, key)
+			f.size -= len(m.module)
+			continue
+		}
+		// the policies still using the module mark it again when they are recomputed below
+		m.used = false
+		if m.pulling || (m.pinned && m.err == nil) {
+			continue
+		}
+		m.pulling = true
+		go f.pull(key, m)
+	}
+	f.mu.Unlock()
+	f.trigger.TriggerRecomputation()
+}
+
+// get returns the sha256 of the Wasm module of the image, pulled with the credentials of the optional pull
+// secret, or errWasmModulePulling when the image is being pulled in the background.
+func (f *wasmImageFetcher) get(krtctx krt.HandlerContext, imageRef string, pullSecret *ir.Secret) (string, error) {
+	if krtctx != nil {
+		f.trigger.MarkDependant(krtctx)
+	}
+	key := imageRef
+	if pullSecret != nil {
+		// key on the credentials, so that the image is pulled again when they change
+		sum := sha256.Sum256(pullSecret.Data[corev1.DockerConfigJsonKey])
+		key = fmt.Sprintf("%s@%x", imageRef, sum)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	m, ok := f.modules[key]
+	if !ok {
+		m = &wasmModule{imageRef: imageRef, pullSecret: pullSecret, pulling: true}
+		f.modules[key] = m
+		go f.pull(key, m)
+	}
+	m.used = true
+	switch {
+	case m.sha256 != "":
+		return m.sha256, nil
+	case m.err != nil:
+		return "", m.err
+	default:
+		return "", errWasmModulePulling
+	}
+}
+
+// pull pulls the module of the image, and recomputes the policies using it when the result changed.
+func (f *wasmImageFetcher) pull(key string, m *wasmModule) {
+	module, pinned, err := f.pullImage(m.imageRef, m.pullSecret)
+
+	f.mu.Lock()
+	changed := f.updateModule(key, m, module, pinned, err)
+	f.mu.Unlock()
+	if changed {
+		f.trigger.TriggerRecomputation()
+	}
+}
+
+// updateModule records the result of a pull, keeping the previous module when a refresh fails. Must be called
+// with the lock held. Returns whether the module or its error changed.
+func (f *wasmImageFetcher) updateModule(key string, m *wasmModule, module []byte, pinned bool, err error) bool {
+	m.pulling = false
+	if f.modules[key] != m {
+		// released while being pulled
+		return false
+	}
+	if err == nil && f.size-len(m.module)+len(module) > f.maxSize {
+		err = fmt.Errorf("image %s: module does not fit in the module cache of %d bytes", m.imageRef, f.maxSize)
+	}
+	if err != nil {
+		if m.sha256 != "" {
+			logger.Warn("failed to refresh wasm module, keeping the previous module", "image", m.imageRef, "error", err)
+			return false
+		}
+		changed := m.err == nil || m.err.Error() != err.Error()
+		m.err = err
+		return changed
+	}
+
+	sum := sha256.Sum256(module)
+	checksum := hex.EncodeToString(sum[:])
+	changed := checksum != m.sha256 || m.err != nil
+	f.size += len(module) - len(m.module)
+	m.module, m.sha256, m.pinned, m.err = module, checksum, pinned, nil
+	return changed
+}
+
+// pullImage pulls the image and returns its Wasm module, and whether the image is referenced by digest.
+func (f *wasmImageFetcher) pullImage(imageRef string, pullSecret *ir.Secret) ([]byte, bool, error) {
+	ref, err := name.ParseReference(imageRef)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid image reference %s: %w", imageRef, err)
+	}
+	auth, err := pullSecretAuthenticator(pullSecret, ref.Context().RegistryStr())
+	if err != nil {
+		return nil, false, err
+	}
+
+	var module []byte
+	err = retry.Do(func() error {
+		opts := append([]remote.Option{remote.WithAuth(auth)}, f.remoteOptions...)
+		img, err := remote.Image(ref, opts...)
+		if err != nil {
+			return fmt.Errorf("failed to pull image %s: %w", imageRef, err)
+		}
+		module, err = wasmModuleFromImage(img)
+		if err != nil {
+			return retry.Unrecoverable(fmt.Errorf("image %s: %w", imageRef, err))
+		}
+		return nil
+	}, retry.Attempts(3), retry.Delay(100*time.Millisecond), retry.MaxDelay(2*time.Second), retry.DelayType(retry.BackOffDelay))
+	if err != nil {
+		return nil, false, err
+	}
+
+	_, pinned := ref.(name.Digest)
+	return module, pinned, nil
+}
+
+// ServeHTTP serves the modules to the proxies by their sha256, as the proxies cannot pull OCI images.
+func (f *wasmImageFetcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	checksum, ok := strings.CutPrefix(r.URL.Path, wasmModulePathPrefix)
+	if r.Method != http.MethodGet || !ok {
+		http.NotFound(w, r)
+		return
+	}
+	var module []byte
+	f.mu.Lock()
+	for _, m := range f.modules {
+		if m.sha256 == checksum {
+			module = m.module
+			break
+		}
+	}
+	f.mu.Unlock()
+	if module == nil {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/wasm")
+	w.Write(module) //nolint:errcheck // the proxy retries failed fetches
+}
+
+// RunWasmModuleServer serves the Wasm modules pulled from OCI images to the proxies until the context is done.
+func RunWasmModuleServer(ctx context.Context, port uint32) {
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", port),
+		Handler:           wasmImages,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	logger.Info("wasm module server starting", "address", server.Addr)
+	go func() {
+		err := server.ListenAndServe()
+		if err == http.ErrServerClosed {
+			logger.Info("wasm module server closed")
+		} else {
+			logger.Warn("wasm module server closed with unexpected error", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		if err := server.Close(); err != nil {
+			logger.Warn("wasm module server shutdown returned error", "error", err)
+		}
+	}()
+}
+
+// wasmModuleFromImage extracts the Wasm module of an image, either from the layer of a Wasm OCI artifact or from
+// the .wasm file of the single layer of an image.
+func wasmModuleFromImage(img v1.Image) ([]byte, error) {
+	layers, err := img.Layers()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read layers: %w", err)
+	}
+
+	for _, layer := range layers {
+		mediaType, err := layer.MediaType()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer media type: %w", err)
+		}
+		if mediaType != wasmLayerMediaType && mediaType != wasmModuleLayerMediaType {
+			continue
+		}
+		rc, err := layer.Compressed()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer: %w", err)
+		}
+		defer rc.Close()
+		return readWasmModule(rc)
+	}
+
+	if len(layers) != 1 {
+		return nil, fmt.Errorf("expected a Wasm layer or a single layer holding a .wasm file, found %d layers", len(layers))
+	}
+	rc, err := layers[0].Uncompressed()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read layer: %w", err)
+	}
+	defer rc.Close()
+
+	tr := tar.NewReader(rc)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("no .wasm file found in the image")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read layer: %w", err)
+		}
+		if hdr.Typeflag == tar.TypeReg && path.Ext(hdr.Name) == ".wasm" {
+			return readWasmModule(tr)
+		}
+	}
+}
+
+func readWasmModule(r io.Reader) ([]byte, error) {
+	module, err := io.ReadAll(io.LimitReader(r, maxWasmModuleSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read module: %w", err)
+	}
+	if len(module) > maxWasmModuleSize {
+		return nil, fmt.Errorf("module exceeds the maximum size of %d bytes", maxWasmModuleSize)
+	}
+	if !bytes.HasPrefix(module, wasmMagic) {
+		return nil, errors.New("not a binary Wasm module")
+	}
+	return module, nil
+}
+
+// pullSecretAuthenticator returns the credentials of the dockerconfigjson pull secret for the registry.
+func pullSecretAuthenticator(secret *ir.Secret, registry string) (authn.Authenticator, error) {
+	if secret == nil {
+		return authn.Anonymous, nil
+	}
+	data, ok := secret.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("secret %s/%s does not contain key '%s'", secret.Namespace, secret.Name, corev1.DockerConfigJsonKey)
+	}
+	var cfg struct {
+		Auths map[string]authn.AuthConfig `json:"auths"`
+	}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("secret %s/%s: invalid docker config: %w", secret.Namespace, secret.Name, err)
+	}
+	for server, auth := range cfg.Auths {
+		if registryHost(server) == registryHost(registry) {
+			return authn.FromConfig(auth), nil
+		}
+	}
+	return authn.Anonymous, nil
+}
+
+// registryHost returns the host of a docker config server, which may be a URL such as https://index.docker.io/v1/
+func registryHost(server string) string {
+	if u, err := url.Parse(server); err == nil && u.Host != "" {
+		server = u.Host
+	}
+	server = strings.TrimSuffix(server, "/")
+	if server == "docker.io" || server == "registry-1.docker.io" {
+		return name.DefaultRegistry
+	}
+	return server
+}
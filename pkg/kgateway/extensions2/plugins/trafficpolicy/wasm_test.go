@@ -0,0 +1,301 @@
+package trafficpolicy
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	wasmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/crane"
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+// testWasmModule is the smallest valid binary Wasm module
+var testWasmModule = []byte{0x00, 'a', 's', 'm', 0x01, 0x00, 0x00, 0x00}
+
+func wasmArtifactImage(t *testing.T, module []byte) v1.Image {
+	t.Helper()
+	img, err := mutate.AppendLayers(empty.Image, static.NewLayer(module, types.MediaType(wasmLayerMediaType)))
+	require.NoError(t, err)
+	return img
+}
+
+// pushTestImage pushes the image to an in-memory registry and returns its reference.
+func pushTestImage(t *testing.T, img v1.Image) string {
+	t.Helper()
+	server := httptest.NewServer(registry.New())
+	t.Cleanup(server.Close)
+
+	ref := strings.TrimPrefix(server.URL, "http://") + "/filters/test:v1"
+	tag, err := name.NewTag(ref)
+	require.NoError(t, err)
+	require.NoError(t, remote.Write(tag, img))
+	return ref
+}
+
+var testWasmModuleServer = wasmModuleServer{host: "kgateway.kgateway-system.svc.cluster.local", port: 9979}
+
+// pulledWasmImageFetcher returns a fetcher that has pulled the module of the image.
+func pulledWasmImageFetcher(t *testing.T, ref string) *wasmImageFetcher {
+	t.Helper()
+	f := newWasmImageFetcher()
+	require.Eventually(t, func() bool {
+		_, err := f.get(nil, ref, nil)
+		return !errors.Is(err, errWasmModulePulling)
+	}, 10*time.Second, 10*time.Millisecond)
+	return f
+}
+
+func TestConstructWasm(t *testing.T) {
+	ref := pushTestImage(t, wasmArtifactImage(t, testWasmModule))
+	sum := sha256.Sum256(testWasmModule)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("disable", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructWasm(nil, &kgateway.TrafficPolicy{
+			Spec: kgateway.TrafficPolicySpec{
+				Wasm: &kgateway.Wasm{Disable: &shared.PolicyDisable{}},
+			},
+		}, nil, nil, newWasmImageFetcher(), testWasmModuleServer, out)
+		require.NoError(t, err)
+		assert.True(t, (&wasmIR{disable: true}).Equals(out.wasm))
+	})
+
+	fetcher := pulledWasmImageFetcher(t, ref)
+
+	t.Run("image being pulled", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructWasm(nil, &kgateway.TrafficPolicy{
+			Spec: kgateway.TrafficPolicySpec{
+				Wasm: &kgateway.Wasm{Image: &kgateway.WasmImageSource{Ref: ref}},
+			},
+		}, nil, nil, newWasmImageFetcher(), testWasmModuleServer, out)
+		assert.ErrorIs(t, err, errWasmModulePulling)
+		assert.Nil(t, out.wasm)
+	})
+
+	t.Run("image", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructWasm(nil, &kgateway.TrafficPolicy{
+			Spec: kgateway.TrafficPolicySpec{
+				Wasm: &kgateway.Wasm{
+					Image:         &kgateway.WasmImageSource{Ref: ref, SHA256: ptr.To(checksum)},
+					RootID:        ptr.To("add_header"),
+					Config:        &runtime.RawExtension{Raw: []byte(`{"header":"x-wasm"}`)},
+					FailurePolicy: ptr.To(kgateway.WasmFailurePolicyFailOpen),
+				},
+			},
+		}, nil, nil, fetcher, testWasmModuleServer, out)
+		require.NoError(t, err)
+		require.NotNil(t, out.wasm)
+		require.NoError(t, out.wasm.Validate())
+
+		cfg := out.wasm.filter.GetConfig()
+		assert.True(t, strings.HasPrefix(out.wasm.name, wasmFilterNamePrefix+"/"))
+		assert.Equal(t, "add_header", cfg.GetRootId())
+		assert.Equal(t, wasmv3.FailurePolicy_FAIL_OPEN, cfg.GetFailurePolicy())
+		assert.Equal(t, wasmRuntimeV8, cfg.GetVmConfig().GetRuntime())
+		remote := cfg.GetVmConfig().GetCode().GetRemote()
+		assert.Equal(t, "http://kgateway.kgateway-system.svc.cluster.local:9979/wasm/"+checksum, remote.GetHttpUri().GetUri())
+		assert.Equal(t, wasmModuleServerClusterName, remote.GetHttpUri().GetCluster())
+		assert.Equal(t, checksum, remote.GetSha256())
+		assert.Equal(t, &testWasmModuleServer, out.wasm.moduleServer)
+		assert.NotNil(t, cfg.GetConfiguration())
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		out := &trafficPolicySpecIr{}
+		err := constructWasm(nil, &kgateway.TrafficPolicy{
+			Spec: kgateway.TrafficPolicySpec{
+				Wasm: &kgateway.Wasm{
+					Image: &kgateway.WasmImageSource{Ref: ref, SHA256: ptr.To(strings.Repeat("0", 64))},
+				},
+			},
+		}, nil, nil, fetcher, testWasmModuleServer, out)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "does not match the expected checksum")
+		assert.Nil(t, out.wasm)
+	})
+}
+
+func TestWasmModuleFromImage(t *testing.T) {
+	t.Run("wasm artifact", func(t *testing.T) {
+		module, err := wasmModuleFromImage(wasmArtifactImage(t, testWasmModule))
+		require.NoError(t, err)
+		assert.Equal(t, testWasmModule, module)
+	})
+
+	t.Run("image with a .wasm file", func(t *testing.T) {
+		layer, err := crane.Layer(map[string][]byte{"plugin.wasm": testWasmModule})
+		require.NoError(t, err)
+		img, err := mutate.AppendLayers(empty.Image, layer)
+		require.NoError(t, err)
+
+		module, err := wasmModuleFromImage(img)
+		require.NoError(t, err)
+		assert.Equal(t, testWasmModule, module)
+	})
+
+	t.Run("image without a .wasm file", func(t *testing.T) {
+		layer, err := crane.Layer(map[string][]byte{"plugin.txt": []byte("hello")})
+		require.NoError(t, err)
+		img, err := mutate.AppendLayers(empty.Image, layer)
+		require.NoError(t, err)
+
+		_, err = wasmModuleFromImage(img)
+		assert.ErrorContains(t, err, "no .wasm file found")
+	})
+
+	t.Run("not a wasm module", func(t *testing.T) {
+		_, err := wasmModuleFromImage(wasmArtifactImage(t, []byte("hello")))
+		assert.ErrorContains(t, err, "not a binary Wasm module")
+	})
+}
+
+func TestWasmImageFetcher(t *testing.T) {
+	ref := pushTestImage(t, wasmArtifactImage(t, testWasmModule))
+	sum := sha256.Sum256(testWasmModule)
+	checksum := hex.EncodeToString(sum[:])
+
+	t.Run("serves pulled modules", func(t *testing.T) {
+		f := pulledWasmImageFetcher(t, ref)
+		got, err := f.get(nil, ref, nil)
+		require.NoError(t, err)
+		assert.Equal(t, checksum, got)
+
+		rec := httptest.NewRecorder()
+		f.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, wasmModulePathPrefix+checksum, nil))
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/wasm", rec.Header().Get("Content-Type"))
+		assert.Equal(t, testWasmModule, rec.Body.Bytes())
+
+		rec = httptest.NewRecorder()
+		f.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, wasmModulePathPrefix+strings.Repeat("0", 64), nil))
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("releases unused modules on refresh", func(t *testing.T) {
+		f := pulledWasmImageFetcher(t, ref)
+
+		// the module is still used, and is pulled again as the image is referenced by tag
+		f.refreshModules()
+		require.Eventually(t, func() bool {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			return !f.modules[ref].pulling
+		}, 10*time.Second, 10*time.Millisecond)
+		f.mu.Lock()
+		assert.Len(t, f.modules, 1)
+		f.mu.Unlock()
+
+		// no policy used the module since the last refresh
+		f.refreshModules()
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		assert.Empty(t, f.modules)
+		assert.Zero(t, f.size)
+	})
+
+	t.Run("bounds the module cache", func(t *testing.T) {
+		f := newWasmImageFetcher()
+		f.maxSize = len(testWasmModule) - 1
+		require.Eventually(t, func() bool {
+			_, err := f.get(nil, ref, nil)
+			return !errors.Is(err, errWasmModulePulling)
+		}, 10*time.Second, 10*time.Millisecond)
+		_, err := f.get(nil, ref, nil)
+		assert.ErrorContains(t, err, "does not fit in the module cache")
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		assert.Zero(t, f.size)
+	})
+}
+
+func TestPullSecretAuthenticator(t *testing.T) {
+	secret := &ir.Secret{
+		ObjectSource: ir.ObjectSource{Namespace: "default", Name: "pull"},
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(`{"auths":{"https://index.docker.io/v1/":{"username":"user","password":"pass"},"ghcr.io":{"auth":"Z2g6dG9rZW4="}}}`),
+		},
+	}
+
+	tests := []struct {
+		registry string
+		want     authn.AuthConfig
+	}{
+		{registry: name.DefaultRegistry, want: authn.AuthConfig{Username: "user", Password: "pass"}},
+		{registry: "ghcr.io", want: authn.AuthConfig{Username: "gh", Password: "token"}},
+		{registry: "quay.io", want: authn.AuthConfig{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.registry, func(t *testing.T) {
+			auth, err := pullSecretAuthenticator(secret, tt.registry)
+			require.NoError(t, err)
+			cfg, err := auth.Authorization()
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Username, cfg.Username)
+			assert.Equal(t, tt.want.Password, cfg.Password)
+		})
+	}
+
+	_, err := pullSecretAuthenticator(&ir.Secret{Data: map[string][]byte{}}, "ghcr.io")
+	assert.Error(t, err)
+}
+
+func TestHandleWasm(t *testing.T) {
+	a := assert.New(t)
+
+	ref := pushTestImage(t, wasmArtifactImage(t, testWasmModule))
+	out := &trafficPolicySpecIr{}
+	a.NoError(constructWasm(nil, &kgateway.TrafficPolicy{
+		Spec: kgateway.TrafficPolicySpec{
+			Wasm: &kgateway.Wasm{Image: &kgateway.WasmImageSource{Ref: ref}},
+		},
+	}, nil, nil, pulledWasmImageFetcher(t, ref), testWasmModuleServer, out))
+
+	p := &trafficPolicyPluginGwPass{}
+
+	route := ir.TypedFilterConfigMap{}
+	p.handleWasm("fc", &route, out.wasm)
+	a.NotNil(route.GetTypedConfig(out.wasm.name))
+
+	stagedFilters := p.addWasmFilters("fc", nil)
+	if a.Len(stagedFilters, 1) {
+		a.Equal(out.wasm.name, stagedFilters[0].Filter.GetName())
+		a.True(stagedFilters[0].Filter.GetDisabled())
+	}
+	a.Empty(p.addWasmFilters("other", nil))
+
+	// the module is fetched from the controller through its cluster
+	if clusters := p.ResourcesToAdd().Clusters; a.Len(clusters, 1) {
+		a.Equal(wasmModuleServerClusterName, clusters[0].GetName())
+	}
+
+	// disabling Wasm does not enable any filter
+	disabled := ir.TypedFilterConfigMap{}
+	p.handleWasm("fc", &disabled, &wasmIR{disable: true})
+	a.Empty(disabled)
+}
@@ -0,0 +1,395 @@
+// Package policysupport describes which policy fields are honored by the Envoy and agentgateway dataplanes,
+// so the differences between the dataplanes are not only documented in comments.
+package policysupport
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+)
+
+// HTTPRouteFilterKind is the kind used to report the support of the HTTPRoute filter types.
+const HTTPRouteFilterKind = "HTTPRouteFilter"
+
+// Dataplane is a dataplane implementing the policies.
+type Dataplane string
+
+const (
+	Envoy        Dataplane = "envoy"
+	Agentgateway Dataplane = "agentgateway"
+)
+
+// Support describes whether a dataplane honors a field.
+type Support struct {
+	Supported bool   `json:"supported"`
+	Note      string `json:"note,omitempty"`
+	// TargetKinds restricts the support to the policies targeting these kinds. Empty means all the target kinds.
+	TargetKinds []string `json:"targetKinds,omitempty"`
+	// MaxItems restricts the support of a list to its first items; the items after them are ignored. Zero means all
+	// the items.
+	MaxItems int `json:"maxItems,omitempty"`
+}
+
+// FieldSupport describes the support of a field of a policy on each dataplane. Nested fields are named by their
+// json path, e.g. traffic.mirroring, and a field only unsupported for a value is suffixed with it, e.g.
+// traffic.authorization.action=Audit.
+type FieldSupport struct {
+	Field        string  `json:"field"`
+	Envoy        Support `json:"envoy"`
+	Agentgateway Support `json:"agentgateway"`
+}
+
+// KindSupport describes the support of the fields of a policy kind.
+type KindSupport struct {
+	Kind   string         `json:"kind"`
+	Fields []FieldSupport `json:"fields"`
+}
+
+type kindMatrix struct {
+	// spec is the spec struct of the kind, whose json fields are reported
+	spec any
+	// fields are reported instead of the spec fields for kinds without a spec, such as the HTTPRoute filters
+	fields []string
+
+	// envoy and agentgateway are the support of the top-level fields not listed in the overrides; the nested
+	// fields are supported unless they are listed in the overrides
+	envoy, agentgateway                   Support
+	envoyOverrides, agentgatewayOverrides map[string]Support
+}
+
+var (
+	supported = Support{Supported: true}
+
+	httpRouteOnly = Support{Supported: true, Note: "only honored for HTTPRoute targets", TargetKinds: []string{wellknown.HTTPRouteKind}}
+
+	// httpTargetKinds are the target kinds of the TrafficPolicy fields configuring the HTTP requests, which do not
+	// apply to the connections proxied by TCPRoutes and TLSRoutes.
+	httpTargetKinds = []string{wellknown.GatewayKind, wellknown.XListenerSetKind, wellknown.HTTPRouteKind, wellknown.GRPCRouteKind}
+	httpTargets     = Support{Supported: true, Note: "not honored for TCPRoute and TLSRoute targets", TargetKinds: httpTargetKinds}
+
+	useAgentgatewayPolicy = Support{Note: "ignored by agentgateway; use AgentgatewayPolicy instead"}
+	useEnvoyPolicies      = Support{Note: "ignored by Envoy; use TrafficPolicy, BackendConfigPolicy or ListenerPolicy instead"}
+)
+
+var matrix = map[string]kindMatrix{
+	wellknown.TrafficPolicyGVK.Kind: {
+		spec:         kgateway.TrafficPolicySpec{},
+		envoy:        httpTargets,
+		agentgateway: useAgentgatewayPolicy,
+		envoyOverrides: map[string]Support{
+			"expiresAt":       supported,
+			"priority":        supported,
+			"autoHostRewrite": httpRouteOnly,
+			"urlRewrite":      httpRouteOnly,
+			"timeouts": {
+				Supported:   true,
+				Note:        "only honored for HTTPRoute, GRPCRoute, TCPRoute and TLSRoute targets",
+				TargetKinds: []string{wellknown.HTTPRouteKind, wellknown.GRPCRouteKind, wellknown.TCPRouteKind, wellknown.TLSRouteKind},
+			},
+			"retry": {
+				Supported:   true,
+				Note:        "only honored for HTTPRoute, Gateway listener and ListenerSet targets",
+				TargetKinds: []string{wellknown.HTTPRouteKind, wellknown.GatewayKind, wellknown.XListenerSetKind},
+			},
+			"compression": {
+				Supported:   true,
+				Note:        "response compression is only honored for HTTPRoute targets; not honored for TCPRoute and TLSRoute targets",
+				TargetKinds: httpTargetKinds,
+			},
+			"mirroring": httpRouteOnly,
+			"tap": {
+				Supported:   true,
+				Note:        "requires KGW_ENABLE_TAP to be enabled in the controller; not honored for TCPRoute and TLSRoute targets",
+				TargetKinds: httpTargetKinds,
+			},
+			"authComposition": {
+				Supported:   true,
+				Note:        "anyOf detects the credentials by their header, query parameter or cookie; not honored for TCPRoute and TLSRoute targets",
+				TargetKinds: httpTargetKinds,
+			},
+			"wasm": {
+				Supported:   true,
+				Note:        "modules pulled from OCI images are limited to 256MiB in total and served to Envoy by the controller; not honored for TCPRoute and TLSRoute targets",
+				TargetKinds: httpTargetKinds,
+			},
+		},
+	},
+	wellknown.BackendConfigPolicyGVK.Kind: {
+		spec:         kgateway.BackendConfigPolicySpec{},
+		envoy:        supported,
+		agentgateway: useAgentgatewayPolicy,
+		envoyOverrides: map[string]Support{
+			"http1ProtocolOptions": {Supported: true, Note: "not applied to HTTP/2 backends unless httpProtocol is set"},
+			"http2ProtocolOptions": {Supported: true, Note: "only applied to HTTP/2 backends unless httpProtocol is set"},
+		},
+	},
+	wellknown.HTTPListenerPolicyGVK.Kind: {
+		spec:         kgateway.HTTPListenerPolicySpec{},
+		envoy:        supported,
+		agentgateway: useAgentgatewayPolicy,
+	},
+	wellknown.ListenerPolicyGVK.Kind: {
+		spec:         kgateway.ListenerPolicySpec{},
+		envoy:        supported,
+		agentgateway: useAgentgatewayPolicy,
+	},
+	wellknown.AgentgatewayPolicyGVK.Kind: {
+		spec:         agentgateway.AgentgatewayPolicySpec{},
+		envoy:        useEnvoyPolicies,
+		agentgateway: supported,
+		agentgatewayOverrides: map[string]Support{
+			"traffic.mirroring":                         httpRouteOnly,
+			"traffic.csrf.percentageEnabled":            {Note: "the CSRF policy is enforced on all requests by agentgateway"},
+			"traffic.csrf.percentageShadowed":           {Note: "the CSRF policy is enforced on all requests by agentgateway"},
+			"traffic.rateLimit.local":                   {Supported: true, Note: "only the first local rate limit is enforced by agentgateway", MaxItems: 1},
+			"traffic.authorization.action=Audit":        {Note: "the Audit action is not supported by agentgateway"},
+			"traffic.authorization.policy.matchers.mcp": {Note: "mcp matchers are only supported by the MCP authorization of backends"},
+			"backend.mcp.authorization.action=Audit":    {Note: "the Audit action is not supported by agentgateway"},
+		},
+	},
+	HTTPRouteFilterKind: {
+		fields: []string{
+			string(gwv1.HTTPRouteFilterRequestHeaderModifier),
+			string(gwv1.HTTPRouteFilterResponseHeaderModifier),
+			string(gwv1.HTTPRouteFilterRequestRedirect),
+			string(gwv1.HTTPRouteFilterURLRewrite),
+			string(gwv1.HTTPRouteFilterRequestMirror),
+			string(gwv1.HTTPRouteFilterCORS),
+			string(gwv1.HTTPRouteFilterExternalAuth),
+			string(gwv1.HTTPRouteFilterExtensionRef),
+		},
+		envoy:        supported,
+		agentgateway: supported,
+		envoyOverrides: map[string]Support{
+			string(gwv1.HTTPRouteFilterCORS):         {Supported: true, Note: "requires the experimental Gateway API features to be enabled"},
+			string(gwv1.HTTPRouteFilterExternalAuth): {Note: "ignored by Envoy; use the TrafficPolicy extAuth field instead"},
+		},
+		agentgatewayOverrides: map[string]Support{
+			string(gwv1.HTTPRouteFilterExtensionRef): {Note: "rejected by agentgateway; attach an AgentgatewayPolicy instead"},
+		},
+	},
+}
+
+// Kinds returns the kinds with a known support matrix.
+func Kinds() []string {
+	kinds := make([]string, 0, len(matrix))
+	for k := range matrix {
+		kinds = append(kinds, k)
+	}
+	slices.Sort(kinds)
+	return kinds
+}
+
+// ForKind returns the support of the fields of the given kind on each dataplane.
+func ForKind(kind string) (KindSupport, bool) {
+	m, ok := matrix[kind]
+	if !ok {
+		return KindSupport{}, false
+	}
+	fields := m.fields
+	if m.spec != nil {
+		fields = specFields(m.spec)
+	}
+	out := KindSupport{Kind: kind}
+	for _, f := range fields {
+		fs := FieldSupport{Field: f, Envoy: m.envoy, Agentgateway: m.agentgateway}
+		if s, ok := m.envoyOverrides[f]; ok {
+			fs.Envoy = s
+		}
+		if s, ok := m.agentgatewayOverrides[f]; ok {
+			fs.Agentgateway = s
+		}
+		out.Fields = append(out.Fields, fs)
+	}
+	// the nested fields are only reported when one of the dataplanes does not fully support them
+	var nested []string
+	for f := range m.envoyOverrides {
+		if !slices.Contains(fields, f) {
+			nested = append(nested, f)
+		}
+	}
+	for f := range m.agentgatewayOverrides {
+		if !slices.Contains(fields, f) && !slices.Contains(nested, f) {
+			nested = append(nested, f)
+		}
+	}
+	slices.Sort(nested)
+	for _, f := range nested {
+		out.Fields = append(out.Fields, FieldSupport{Field: f, Envoy: m.support(Envoy, f), Agentgateway: m.support(Agentgateway, f)})
+	}
+	return out, true
+}
+
+// UnsupportedField is a field set in a policy which a dataplane ignores.
+type UnsupportedField struct {
+	Field string
+	Note  string
+}
+
+func (f UnsupportedField) String() string {
+	if f.Note == "" {
+		return f.Field
+	}
+	return f.Field + " (" + f.Note + ")"
+}
+
+// Unsupported returns the fields set in the spec of a policy of the given kind which the dataplane ignores for a
+// target of the given kind. It is used to report the ignored fields in the status of the policies, so the
+// status matches the support reported by ForKind.
+func Unsupported(kind string, dataplane Dataplane, spec any, targetKind string) []UnsupportedField {
+	m, ok := matrix[kind]
+	if !ok || m.spec == nil {
+		return nil
+	}
+	var out []UnsupportedField
+	walkSetFields(reflect.ValueOf(spec), "", func(field string) {
+		s := m.support(dataplane, field)
+		if s.Supported && (len(s.TargetKinds) == 0 || slices.Contains(s.TargetKinds, targetKind)) {
+			return
+		}
+		if !slices.ContainsFunc(out, func(f UnsupportedField) bool { return f.Field == field }) {
+			out = append(out, UnsupportedField{Field: field, Note: s.Note})
+		}
+	})
+	// the lists longer than their supported items are reported, as the walk does not see the length of the lists
+	overrides := m.overrides(dataplane)
+	for _, field := range slices.Sorted(maps.Keys(overrides)) {
+		s := overrides[field]
+		if s.MaxItems > 0 && listLen(reflect.ValueOf(spec), strings.Split(field, ".")) > s.MaxItems {
+			out = append(out, UnsupportedField{Field: field, Note: s.Note})
+		}
+	}
+	return out
+}
+
+// overrides returns the support of the fields overridden for the dataplane.
+func (m kindMatrix) overrides(dataplane Dataplane) map[string]Support {
+	if dataplane == Agentgateway {
+		return m.agentgatewayOverrides
+	}
+	return m.envoyOverrides
+}
+
+// support returns the support of a field by the dataplane.
+func (m kindMatrix) support(dataplane Dataplane, field string) Support {
+	def := m.envoy
+	if dataplane == Agentgateway {
+		def = m.agentgateway
+	}
+	if s, ok := m.overrides(dataplane)[field]; ok {
+		return s
+	}
+	if strings.ContainsAny(field, ".=") {
+		return supported
+	}
+	return def
+}
+
+// walkSetFields calls visit with the json path of the fields set in v, and with the path suffixed with the value
+// of the string fields. The elements of the lists share the path of the list.
+func walkSetFields(v reflect.Value, prefix string, visit func(field string)) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			walkSetFields(v.Elem(), prefix, visit)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			walkSetFields(v.Index(i), prefix, visit)
+		}
+	case reflect.String:
+		if prefix != "" {
+			visit(prefix + "=" + v.String())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := range t.NumField() {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			fv := v.Field(i)
+			switch {
+			case name == "" && f.Anonymous:
+				// inlined struct
+				walkSetFields(fv, prefix, visit)
+			case name == "" || name == "-" || name == "targetRefs" || name == "targetSelectors" || fv.IsZero():
+			default:
+				path := name
+				if prefix != "" {
+					path = prefix + "." + name
+				}
+				visit(path)
+				walkSetFields(fv, path, visit)
+			}
+		}
+	}
+}
+
+// listLen returns the length of the list at the json path in v, or zero if it is not set.
+func listLen(v reflect.Value, path []string) int {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return 0
+		}
+		v = v.Elem()
+	}
+	if len(path) == 0 {
+		if v.Kind() == reflect.Slice || v.Kind() == reflect.Array {
+			return v.Len()
+		}
+		return 0
+	}
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	t := v.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch {
+		case name == "" && f.Anonymous:
+			// inlined struct
+			if n := listLen(v.Field(i), path); n > 0 {
+				return n
+			}
+		case name == path[0]:
+			return listLen(v.Field(i), path[1:])
+		}
+	}
+	return 0
+}
+
+// specFields returns the json names of the fields of the spec, excluding the target references which
+// select what the policy attaches to rather than what it configures.
+func specFields(spec any) []string {
+	return structFields(reflect.TypeOf(spec))
+}
+
+func structFields(t reflect.Type) []string {
+	var fields []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		switch {
+		case name == "" && f.Anonymous:
+			// inlined struct
+			fields = append(fields, structFields(f.Type)...)
+		case name == "" || name == "-" || name == "targetRefs" || name == "targetSelectors":
+		default:
+			fields = append(fields, name)
+		}
+	}
+	return fields
+}
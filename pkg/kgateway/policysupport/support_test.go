@@ -0,0 +1,202 @@
+package policysupport
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/agentgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+func TestForKind(t *testing.T) {
+	r := require.New(t)
+
+	tp, ok := ForKind("TrafficPolicy")
+	r.True(ok)
+	r.Contains(tp.Fields, FieldSupport{
+		Field:        "autoHostRewrite",
+		Envoy:        httpRouteOnly,
+		Agentgateway: useAgentgatewayPolicy,
+	})
+	for _, f := range tp.Fields {
+		r.NotEqual("targetRefs", f.Field)
+	}
+
+	_, ok = ForKind("Unknown")
+	r.False(ok)
+}
+
+// TestOverridesMatchFields ensures the overrides refer to existing fields, so they do not silently
+// stop applying when a field is renamed.
+func TestOverridesMatchFields(t *testing.T) {
+	for _, kind := range Kinds() {
+		t.Run(kind, func(t *testing.T) {
+			a := assert.New(t)
+
+			ks, ok := ForKind(kind)
+			a.True(ok)
+			a.NotEmpty(ks.Fields)
+
+			m := matrix[kind]
+			fields := map[string]bool{}
+			for _, f := range m.fields {
+				fields[f] = true
+			}
+			known := func(f string) bool {
+				if m.spec == nil {
+					return fields[f]
+				}
+				path, _, _ := strings.Cut(f, "=")
+				return hasField(reflect.TypeOf(m.spec), strings.Split(path, "."))
+			}
+			for f := range m.envoyOverrides {
+				a.True(known(f), "unknown envoy override %s", f)
+			}
+			for f := range m.agentgatewayOverrides {
+				a.True(known(f), "unknown agentgateway override %s", f)
+			}
+		})
+	}
+}
+
+func hasField(t reflect.Type, path []string) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice {
+		t = t.Elem()
+	}
+	if len(path) == 0 {
+		return true
+	}
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" && f.Anonymous && hasField(f.Type, path) {
+			return true
+		}
+		if name == path[0] && hasField(f.Type, path[1:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestUnsupported(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       string
+		dataplane  Dataplane
+		spec       any
+		targetKind string
+		want       []UnsupportedField
+	}{
+		{
+			name:      "route only field on a Gateway",
+			kind:      "TrafficPolicy",
+			dataplane: Envoy,
+			spec: kgateway.TrafficPolicySpec{
+				UrlRewrite: &kgateway.URLRewrite{PathRegex: &kgateway.PathRegexRewrite{Pattern: "^/a", Substitution: "/b"}},
+			},
+			targetKind: "Gateway",
+			want:       []UnsupportedField{{Field: "urlRewrite", Note: "only honored for HTTPRoute targets"}},
+		},
+		{
+			name:      "route only field on a HTTPRoute",
+			kind:      "TrafficPolicy",
+			dataplane: Envoy,
+			spec: kgateway.TrafficPolicySpec{
+				UrlRewrite: &kgateway.URLRewrite{PathRegex: &kgateway.PathRegexRewrite{Pattern: "^/a", Substitution: "/b"}},
+			},
+			targetKind: "HTTPRoute",
+		},
+		{
+			name:      "http field on a TCPRoute",
+			kind:      "TrafficPolicy",
+			dataplane: Envoy,
+			spec: kgateway.TrafficPolicySpec{
+				Priority: ptr.To(int32(1)),
+				Timeouts: &shared.Timeouts{StreamIdle: &metav1.Duration{Duration: time.Minute}},
+				Buffer:   &kgateway.Buffer{MaxRequestSize: ptr.To(resource.MustParse("1Ki"))},
+			},
+			targetKind: "TCPRoute",
+			want:       []UnsupportedField{{Field: "buffer", Note: "not honored for TCPRoute and TLSRoute targets"}},
+		},
+		{
+			name:      "http field on a GRPCRoute",
+			kind:      "TrafficPolicy",
+			dataplane: Envoy,
+			spec: kgateway.TrafficPolicySpec{
+				Buffer: &kgateway.Buffer{MaxRequestSize: ptr.To(resource.MustParse("1Ki"))},
+			},
+			targetKind: "GRPCRoute",
+		},
+		{
+			name:      "agentgateway Audit action",
+			kind:      "AgentgatewayPolicy",
+			dataplane: Agentgateway,
+			spec: agentgateway.AgentgatewayPolicySpec{
+				Traffic: &agentgateway.Traffic{Authorization: &shared.Authorization{
+					Action: shared.AuthorizationPolicyActionAudit,
+					Policy: shared.AuthorizationPolicy{Matchers: []shared.AuthorizationMatcher{
+						{MCP: &shared.AuthorizationMCPMatch{Tools: []string{"echo"}}},
+					}},
+				}},
+			},
+			targetKind: "Gateway",
+			want: []UnsupportedField{
+				{Field: "traffic.authorization.policy.matchers.mcp", Note: "mcp matchers are only supported by the MCP authorization of backends"},
+				{Field: "traffic.authorization.action=Audit", Note: "the Audit action is not supported by agentgateway"},
+			},
+		},
+		{
+			name:      "agentgateway Allow action",
+			kind:      "AgentgatewayPolicy",
+			dataplane: Agentgateway,
+			spec: agentgateway.AgentgatewayPolicySpec{
+				Traffic: &agentgateway.Traffic{Authorization: &shared.Authorization{
+					Action: shared.AuthorizationPolicyActionAllow,
+				}},
+			},
+			targetKind: "Gateway",
+		},
+		{
+			name:      "agentgateway single local rate limit",
+			kind:      "AgentgatewayPolicy",
+			dataplane: Agentgateway,
+			spec: agentgateway.AgentgatewayPolicySpec{
+				Traffic: &agentgateway.Traffic{RateLimit: &agentgateway.RateLimits{Local: []agentgateway.LocalRateLimit{
+					{Requests: ptr.To(int32(10)), Unit: agentgateway.LocalRateLimitUnitSeconds},
+				}}},
+			},
+			targetKind: "Gateway",
+		},
+		{
+			name:      "agentgateway multiple local rate limits",
+			kind:      "AgentgatewayPolicy",
+			dataplane: Agentgateway,
+			spec: agentgateway.AgentgatewayPolicySpec{
+				Traffic: &agentgateway.Traffic{RateLimit: &agentgateway.RateLimits{Local: []agentgateway.LocalRateLimit{
+					{Requests: ptr.To(int32(10)), Unit: agentgateway.LocalRateLimitUnitSeconds},
+					{Requests: ptr.To(int32(1000)), Unit: agentgateway.LocalRateLimitUnitHours},
+				}}},
+			},
+			targetKind: "Gateway",
+			want:       []UnsupportedField{{Field: "traffic.rateLimit.local", Note: "only the first local rate limit is enforced by agentgateway"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Unsupported(tt.kind, tt.dataplane, tt.spec, tt.targetKind))
+		})
+	}
+}
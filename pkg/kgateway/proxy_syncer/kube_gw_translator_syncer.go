@@ -2,6 +2,9 @@ package proxy_syncer
 
 import (
 	"context"
+	"log/slog"
+
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 
 	"github.com/kgateway-dev/kgateway/v2/pkg/logging"
 )
@@ -21,6 +24,18 @@ func (s *ProxyTranslator) syncXds(
 
 	logger.Log(ctx, logging.LevelTrace, "syncing xds snapshot", "proxy_key", proxyKey)
 
+	// summarizing the changes requires comparing every resource against the previous snapshot,
+	// so only do it when the summary is going to be logged
+	if logger.Enabled(ctx, slog.LevelDebug) {
+		var prev *envoycache.Snapshot
+		if p, err := s.xdsCache.GetSnapshot(proxyKey); err == nil {
+			prev, _ = p.(*envoycache.Snapshot)
+		}
+		if diff := summarizeSnapshotDiff(prev, snap); len(diff) > 0 {
+			logger.Debug("xds snapshot changes", "proxy_key", proxyKey, "changes", diff)
+		}
+	}
+
 	// if the snapshot is not consistent, make it so
 	// TODO: me may need to copy this to not change krt cache.
 	// TODO: this is also may not be needed now that envoy has
@@ -7,7 +7,8 @@ import (
 )
 
 type statusSyncerConfig struct {
-	CustomStatusSync func(ctx context.Context, rm reports.ReportMap)
+	CustomStatusSync   func(ctx context.Context, rm reports.ReportMap)
+	ReadyProxyReplicas bool
 }
 
 type StatusSyncerOption func(*statusSyncerConfig)
@@ -27,3 +28,11 @@ func WithCustomStatusSync(customSync func(ctx context.Context, rm reports.Report
 		}
 	}
 }
+
+// WithReadyProxyReplicas holds the Programmed condition of Gateways at Pending until a proxy replica of their
+// generated Deployment is ready, i.e. has loaded its xDS configuration.
+func WithReadyProxyReplicas(enabled bool) StatusSyncerOption {
+	return func(cfg *statusSyncerConfig) {
+		cfg.ReadyProxyReplicas = enabled
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"maps"
+	"strings"
 	"sync/atomic"
 
 	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
@@ -57,6 +58,7 @@ type ProxySyncer struct {
 
 	statusReport            krt.Singleton[report]
 	backendPolicyReport     krt.Singleton[report]
+	routeDuplicates         krt.Singleton[routeDuplicates]
 	mostXdsSnapshots        krt.Collection[GatewayXdsResources]
 	perclientSnapCollection krt.Collection[XdsSnapWrapper]
 
@@ -70,7 +72,11 @@ type ProxySyncer struct {
 type GatewayXdsResources struct {
 	types.NamespacedName
 
-	reports reports.ReportMap
+	gatewayClassName string
+	reports          reports.ReportMap
+	// routeClaims are the hostnames and matches claimed by routes on this Gateway,
+	// used to detect duplicate routes across Gateways of the same GatewayClass.
+	routeClaims []irtranslator.RouteClaim
 	// Clusters are items in the CDS response payload.
 	// +krtEqualsTodo include CDC resources in equality for diff detection
 	Clusters     []envoycachetypes.ResourceWithTTL
@@ -92,7 +98,9 @@ func (r GatewayXdsResources) ResourceName() string {
 
 func (r GatewayXdsResources) Equals(in GatewayXdsResources) bool {
 	return r.NamespacedName == in.NamespacedName &&
+		r.gatewayClassName == in.gatewayClassName &&
 		report{r.reports}.Equals(report{in.reports}) &&
+		routeClaimsEqual(r.routeClaims, in.routeClaims) &&
 		r.ClustersHash == in.ClustersHash &&
 		r.Routes.Version == in.Routes.Version &&
 		r.Listeners.Version == in.Listeners.Version &&
@@ -124,12 +132,14 @@ func toResources(gw ir.Gateway, xdsSnap irtranslator.TranslationResult, r report
 			Namespace: gw.Obj.GetNamespace(),
 			Name:      gw.Obj.GetName(),
 		},
-		reports:      r,
-		ClustersHash: ch,
-		Clusters:     c,
-		Routes:       sliceToResources(xdsSnap.Routes),
-		Listeners:    sliceToResources(xdsSnap.Listeners),
-		Secrets:      sliceToResources(xdsSnap.Secrets),
+		gatewayClassName: string(gw.Obj.Spec.GatewayClassName),
+		reports:          r,
+		routeClaims:      xdsSnap.RouteClaims,
+		ClustersHash:     ch,
+		Clusters:         c,
+		Routes:           sliceToResources(xdsSnap.Routes),
+		Listeners:        sliceToResources(xdsSnap.Listeners),
+		Secrets:          sliceToResources(xdsSnap.Secrets),
 	}
 }
 
@@ -271,6 +281,13 @@ func (s *ProxySyncer) Init(ctx context.Context, krtopts krtutil.KrtOptions) {
 		return &report{merged}
 	}, krtopts.ToOptions("BackendsPolicyReport")...)
 
+	// routes attached to different Gateways of the same GatewayClass that claim the same hostname and match
+	// may silently shadow each other, so detect them across all proxies
+	s.routeDuplicates = krt.NewSingleton(func(kctx krt.HandlerContext) *routeDuplicates {
+		proxies := krt.Fetch(kctx, s.mostXdsSnapshots)
+		return &routeDuplicates{Duplicates: findRouteDuplicates(proxies)}
+	}, krtopts.ToOptions("RouteDuplicates")...)
+
 	// as proxies are created, they also contain a reportMap containing status for the Gateway and associated xRoutes (really parentRefs)
 	// here we will merge reports that are per-Proxy to a singleton Report used to persist to k8s on a timer
 	s.statusReport = krt.NewSingleton(func(kctx krt.HandlerContext) *report {
@@ -278,6 +295,10 @@ func (s *ProxySyncer) Init(ctx context.Context, krtopts krtutil.KrtOptions) {
 
 		merged := mergeProxyReports(proxies)
 
+		if dups := krt.FetchOne(kctx, s.routeDuplicates.AsCollection()); dups != nil {
+			reportRouteDuplicates(merged, dups.Duplicates)
+		}
+
 		// Process status markers
 		objStatus := krt.Fetch(kctx, s.commonCols.Routes.GetHTTPRouteStatusMarkers())
 		s.commonCols.Routes.ProcessHTTPRouteStatusMarkers(objStatus, merged)
@@ -462,6 +483,57 @@ func (s *ProxySyncer) BackendPolicyReportQueue() utils.AsyncQueue[reports.Report
 	return s.backendPolicyReportQueue
 }
 
+// RouteDuplicates returns the routes that claim the same hostname and match on different
+// Gateways of the same GatewayClass. It must be called only after `Init()`.
+func (s *ProxySyncer) RouteDuplicates() []RouteDuplicate {
+	dups := s.routeDuplicates.Get()
+	if dups == nil {
+		return nil
+	}
+	return dups.Duplicates
+}
+
+// GatewaySnapshot returns the xDS snapshot translated for the Gateway that serves the given xDS cache key,
+// before any per-client clusters and endpoints are added. It returns nil if no such Gateway exists.
+// It must be called only after `Init()`.
+func (s *ProxySyncer) GatewaySnapshot(key string) *envoycache.Snapshot {
+	// cache keys are the node role, optionally followed by per-client label and namespace segments
+	segments := strings.SplitN(key, xds.KeyDelimiter, 4)
+	if len(segments) < 3 {
+		return nil
+	}
+	role := strings.Join(segments[:3], xds.KeyDelimiter)
+	gw := s.mostXdsSnapshots.GetKey(role)
+	if gw == nil {
+		return nil
+	}
+	return gatewaySnapshot(gw)
+}
+
+// GatewaySnapshots returns the xDS snapshots translated for all Gateways, as returned by GatewaySnapshot.
+// It must be called only after `Init()`.
+func (s *ProxySyncer) GatewaySnapshots() map[types.NamespacedName]*envoycache.Snapshot {
+	gws := s.mostXdsSnapshots.List()
+	out := make(map[types.NamespacedName]*envoycache.Snapshot, len(gws))
+	for i := range gws {
+		out[gws[i].NamespacedName] = gatewaySnapshot(&gws[i])
+	}
+	return out
+}
+
+func gatewaySnapshot(gw *GatewayXdsResources) *envoycache.Snapshot {
+	clusters := make([]envoycachetypes.Resource, 0, len(gw.Clusters))
+	for _, c := range gw.Clusters {
+		clusters = append(clusters, c.Resource)
+	}
+	snapshot := &envoycache.Snapshot{}
+	snapshot.Resources[envoycachetypes.Cluster] = envoycache.NewResources(fmt.Sprintf("%d", gw.ClustersHash), clusters)
+	snapshot.Resources[envoycachetypes.Route] = gw.Routes
+	snapshot.Resources[envoycachetypes.Listener] = gw.Listeners
+	snapshot.Resources[envoycachetypes.Secret] = gw.Secrets
+	return snapshot
+}
+
 // WaitForSync returns a list of functions that can be used to determine if all its informers have synced.
 // This is useful for determining if caches have synced.
 // It must be called only after `Init()`.
@@ -0,0 +1,179 @@
+package proxy_syncer
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// RouteDuplicate describes a hostname and match that is claimed by different routes attached
+// to more than one Gateway of the same GatewayClass.
+type RouteDuplicate struct {
+	GatewayClass string                `json:"gatewayClass"`
+	Hostname     string                `json:"hostname"`
+	Match        string                `json:"match"`
+	Claims       []RouteDuplicateClaim `json:"claims"`
+}
+
+// RouteDuplicateClaim is a single route claiming a duplicated hostname and match on a Gateway.
+type RouteDuplicateClaim struct {
+	Gateway   types.NamespacedName `json:"gateway"`
+	Route     ir.ObjectSource      `json:"route"`
+	ParentRef gwv1.ParentReference `json:"parentRef"`
+}
+
+func (d RouteDuplicate) Equals(in RouteDuplicate) bool {
+	return d.GatewayClass == in.GatewayClass &&
+		d.Hostname == in.Hostname &&
+		d.Match == in.Match &&
+		slices.EqualFunc(d.Claims, in.Claims, func(a, b RouteDuplicateClaim) bool {
+			return a.Gateway == b.Gateway &&
+				a.Route == b.Route &&
+				reports.ParentString(a.ParentRef) == reports.ParentString(b.ParentRef)
+		})
+}
+
+type routeDuplicates struct {
+	Duplicates []RouteDuplicate
+}
+
+func (r routeDuplicates) ResourceName() string {
+	return "route-duplicates"
+}
+
+func (r routeDuplicates) Equals(in routeDuplicates) bool {
+	return slices.EqualFunc(r.Duplicates, in.Duplicates, RouteDuplicate.Equals)
+}
+
+// findRouteDuplicates returns every hostname and match that is claimed by a route on one Gateway
+// and by a different route on another Gateway of the same GatewayClass. Conflicts between routes
+// on the same Gateway are resolved by the Gateway API precedence rules and are not reported.
+func findRouteDuplicates(proxies []GatewayXdsResources) []RouteDuplicate {
+	type claimKey struct {
+		gatewayClass string
+		hostname     string
+		match        string
+	}
+	claimsByKey := map[claimKey][]RouteDuplicateClaim{}
+	for _, p := range proxies {
+		for _, c := range p.routeClaims {
+			k := claimKey{gatewayClass: p.gatewayClassName, hostname: c.Hostname, match: c.Match}
+			claim := RouteDuplicateClaim{Gateway: p.NamespacedName, Route: c.Route, ParentRef: c.ParentRef}
+			if slices.ContainsFunc(claimsByKey[k], func(existing RouteDuplicateClaim) bool {
+				return existing.Gateway == claim.Gateway && existing.Route == claim.Route
+			}) {
+				// the same route may claim the match on several listeners of a single Gateway
+				continue
+			}
+			claimsByKey[k] = append(claimsByKey[k], claim)
+		}
+	}
+
+	var out []RouteDuplicate
+	for k, claims := range claimsByKey {
+		var duplicated []RouteDuplicateClaim
+		for _, c := range claims {
+			if slices.ContainsFunc(claims, func(other RouteDuplicateClaim) bool {
+				return other.Gateway != c.Gateway && other.Route != c.Route
+			}) {
+				duplicated = append(duplicated, c)
+			}
+		}
+		if len(duplicated) == 0 {
+			continue
+		}
+		sort.Slice(duplicated, func(i, j int) bool {
+			if duplicated[i].Gateway != duplicated[j].Gateway {
+				return duplicated[i].Gateway.String() < duplicated[j].Gateway.String()
+			}
+			return duplicated[i].Route.ResourceName() < duplicated[j].Route.ResourceName()
+		})
+		out = append(out, RouteDuplicate{
+			GatewayClass: k.gatewayClass,
+			Hostname:     k.hostname,
+			Match:        k.match,
+			Claims:       duplicated,
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].GatewayClass != out[j].GatewayClass {
+			return out[i].GatewayClass < out[j].GatewayClass
+		}
+		if out[i].Hostname != out[j].Hostname {
+			return out[i].Hostname < out[j].Hostname
+		}
+		return out[i].Match < out[j].Match
+	})
+	return out
+}
+
+// reportRouteDuplicates sets the Duplicate condition on the parentRef of every route involved in a
+// duplicate. Route reports are cloned before being modified, as the merged report shares them with
+// the per-Gateway translation results.
+func reportRouteDuplicates(merged reports.ReportMap, duplicates []RouteDuplicate) {
+	type parentKey struct {
+		route     ir.ObjectSource
+		parentRef string
+	}
+	messages := map[parentKey][]string{}
+	parentRefs := map[parentKey]gwv1.ParentReference{}
+	var order []parentKey
+	for _, d := range duplicates {
+		for _, c := range d.Claims {
+			var others []string
+			for _, other := range d.Claims {
+				if other.Gateway != c.Gateway && other.Route != c.Route {
+					others = append(others, fmt.Sprintf("%s %s/%s on Gateway %s", other.Route.Kind, other.Route.Namespace, other.Route.Name, other.Gateway))
+				}
+			}
+			key := parentKey{route: c.Route, parentRef: reports.ParentString(c.ParentRef)}
+			if _, ok := messages[key]; !ok {
+				order = append(order, key)
+				parentRefs[key] = c.ParentRef
+			}
+			messages[key] = append(messages[key], fmt.Sprintf("hostname %q with match %q is also claimed by %s",
+				d.Hostname, d.Match, strings.Join(others, ", ")))
+		}
+	}
+
+	for _, k := range order {
+		var routes map[types.NamespacedName]*reports.RouteReport
+		switch k.route.Kind {
+		case wellknown.HTTPRouteKind:
+			routes = merged.HTTPRoutes
+		case wellknown.GRPCRouteKind:
+			routes = merged.GRPCRoutes
+		default:
+			continue
+		}
+		nn := types.NamespacedName{Namespace: k.route.Namespace, Name: k.route.Name}
+		rr, ok := routes[nn]
+		if !ok {
+			continue
+		}
+		rr = rr.Clone()
+		routes[nn] = rr
+		parentRef := parentRefs[k]
+		rr.ParentRef(&parentRef).SetCondition(reporter.RouteCondition{
+			Type:    gwv1.RouteConditionType(reporter.RouteConditionDuplicate),
+			Status:  metav1.ConditionTrue,
+			Reason:  gwv1.RouteConditionReason(reporter.RouteDuplicateReason),
+			Message: strings.Join(messages[k], "; "),
+		})
+	}
+}
+
+func routeClaimsEqual(a, b []irtranslator.RouteClaim) bool {
+	return slices.EqualFunc(a, b, irtranslator.RouteClaim.Equals)
+}
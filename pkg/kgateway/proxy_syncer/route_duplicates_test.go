@@ -0,0 +1,150 @@
+package proxy_syncer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/irtranslator"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+func TestFindRouteDuplicates(t *testing.T) {
+	gw1 := types.NamespacedName{Namespace: "default", Name: "gw1"}
+	gw2 := types.NamespacedName{Namespace: "default", Name: "gw2"}
+	gw3 := types.NamespacedName{Namespace: "default", Name: "gw3"}
+	routeA := ir.ObjectSource{Group: gwv1.GroupName, Kind: wellknown.HTTPRouteKind, Namespace: "default", Name: "a"}
+	routeB := ir.ObjectSource{Group: gwv1.GroupName, Kind: wellknown.HTTPRouteKind, Namespace: "default", Name: "b"}
+
+	claim := func(route ir.ObjectSource, gw types.NamespacedName, hostname, match string) irtranslator.RouteClaim {
+		return irtranslator.RouteClaim{
+			Hostname:  hostname,
+			Match:     match,
+			Route:     route,
+			ParentRef: gwv1.ParentReference{Name: gwv1.ObjectName(gw.Name)},
+		}
+	}
+	proxy := func(gw types.NamespacedName, class string, claims ...irtranslator.RouteClaim) GatewayXdsResources {
+		return GatewayXdsResources{NamespacedName: gw, gatewayClassName: class, routeClaims: claims}
+	}
+
+	tests := []struct {
+		name    string
+		proxies []GatewayXdsResources
+		want    []RouteDuplicate
+	}{
+		{
+			name: "different routes on different gateways of the same class",
+			proxies: []GatewayXdsResources{
+				proxy(gw1, "kgateway", claim(routeA, gw1, "example.com", "PathPrefix /")),
+				proxy(gw2, "kgateway", claim(routeB, gw2, "example.com", "PathPrefix /")),
+			},
+			want: []RouteDuplicate{{
+				GatewayClass: "kgateway",
+				Hostname:     "example.com",
+				Match:        "PathPrefix /",
+				Claims: []RouteDuplicateClaim{
+					{Gateway: gw1, Route: routeA, ParentRef: gwv1.ParentReference{Name: "gw1"}},
+					{Gateway: gw2, Route: routeB, ParentRef: gwv1.ParentReference{Name: "gw2"}},
+				},
+			}},
+		},
+		{
+			name: "same route reused across gateways",
+			proxies: []GatewayXdsResources{
+				proxy(gw1, "kgateway", claim(routeA, gw1, "example.com", "PathPrefix /")),
+				proxy(gw2, "kgateway", claim(routeA, gw2, "example.com", "PathPrefix /")),
+			},
+		},
+		{
+			name: "different routes on the same gateway",
+			proxies: []GatewayXdsResources{
+				proxy(gw1, "kgateway",
+					claim(routeA, gw1, "example.com", "PathPrefix /"),
+					claim(routeB, gw1, "example.com", "PathPrefix /"),
+				),
+			},
+		},
+		{
+			name: "different gateway classes",
+			proxies: []GatewayXdsResources{
+				proxy(gw1, "kgateway", claim(routeA, gw1, "example.com", "PathPrefix /")),
+				proxy(gw2, "other", claim(routeB, gw2, "example.com", "PathPrefix /")),
+			},
+		},
+		{
+			name: "different matches",
+			proxies: []GatewayXdsResources{
+				proxy(gw1, "kgateway", claim(routeA, gw1, "example.com", "PathPrefix /a")),
+				proxy(gw2, "kgateway", claim(routeB, gw2, "example.com", "PathPrefix /b")),
+			},
+		},
+		{
+			name: "reused route only reported against the other route",
+			proxies: []GatewayXdsResources{
+				proxy(gw1, "kgateway", claim(routeA, gw1, "*", "Exact /foo")),
+				proxy(gw2, "kgateway", claim(routeA, gw2, "*", "Exact /foo")),
+				proxy(gw3, "kgateway", claim(routeB, gw3, "*", "Exact /foo")),
+			},
+			want: []RouteDuplicate{{
+				GatewayClass: "kgateway",
+				Hostname:     "*",
+				Match:        "Exact /foo",
+				Claims: []RouteDuplicateClaim{
+					{Gateway: gw1, Route: routeA, ParentRef: gwv1.ParentReference{Name: "gw1"}},
+					{Gateway: gw2, Route: routeA, ParentRef: gwv1.ParentReference{Name: "gw2"}},
+					{Gateway: gw3, Route: routeB, ParentRef: gwv1.ParentReference{Name: "gw3"}},
+				},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, findRouteDuplicates(tt.proxies))
+		})
+	}
+}
+
+func TestReportRouteDuplicatesDoesNotModifySharedReports(t *testing.T) {
+	route := &gwv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "a"}}
+	parentRef := gwv1.ParentReference{Name: "gw1"}
+
+	perGateway := reports.NewReportMap()
+	reports.NewReporter(&perGateway).Route(route).ParentRef(&parentRef)
+
+	merged := mergeProxyReports([]GatewayXdsResources{{reports: perGateway}})
+	reportRouteDuplicates(merged, []RouteDuplicate{{
+		GatewayClass: "kgateway",
+		Hostname:     "example.com",
+		Match:        "PathPrefix /",
+		Claims: []RouteDuplicateClaim{
+			{
+				Gateway:   types.NamespacedName{Namespace: "default", Name: "gw1"},
+				Route:     ir.ObjectSource{Kind: wellknown.HTTPRouteKind, Namespace: "default", Name: "a"},
+				ParentRef: parentRef,
+			},
+			{
+				Gateway:   types.NamespacedName{Namespace: "default", Name: "gw2"},
+				Route:     ir.ObjectSource{Kind: wellknown.HTTPRouteKind, Namespace: "default", Name: "b"},
+				ParentRef: gwv1.ParentReference{Name: "gw2"},
+			},
+		},
+	}})
+
+	key := types.NamespacedName{Namespace: "default", Name: "a"}
+	for _, prr := range merged.HTTPRoutes[key].Parents {
+		assert.Len(t, prr.Conditions, 1)
+		assert.Equal(t, reporter.RouteConditionDuplicate, prr.Conditions[0].Type)
+		assert.Equal(t, `hostname "example.com" with match "PathPrefix /" is also claimed by HTTPRoute default/b on Gateway default/gw2`, prr.Conditions[0].Message)
+	}
+	for _, prr := range perGateway.HTTPRoutes[key].Parents {
+		assert.Empty(t, prr.Conditions, "per-Gateway report should not be modified")
+	}
+}
@@ -0,0 +1,85 @@
+package proxy_syncer
+
+import (
+	"sort"
+
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxDiffExamples is the maximum number of resource names listed per type and kind of change
+const maxDiffExamples = 3
+
+// typeDiffSummary summarizes the changes to the resources of a single type between two snapshots.
+type typeDiffSummary struct {
+	TypeURL  string   `json:"typeUrl"`
+	Added    int      `json:"added"`
+	Removed  int      `json:"removed"`
+	Changed  int      `json:"changed"`
+	Examples []string `json:"examples,omitempty"`
+}
+
+// summarizeSnapshotDiff returns a summary of the changes per type from prev to next, omitting
+// types without changes. A nil prev is treated as an empty snapshot.
+func summarizeSnapshotDiff(prev, next *envoycache.Snapshot) []typeDiffSummary {
+	var out []typeDiffSummary
+	for i := range envoycachetypes.UnknownType {
+		typeURL, err := envoycache.GetResponseTypeURL(i)
+		if err != nil {
+			continue
+		}
+		var oldRes, newRes envoycache.Resources
+		if prev != nil {
+			oldRes = prev.Resources[i]
+		}
+		if next != nil {
+			newRes = next.Resources[i]
+		}
+		if oldRes.Version == newRes.Version && len(oldRes.Items) == len(newRes.Items) {
+			continue
+		}
+
+		var added, removed, changed []string
+		for name, n := range newRes.Items {
+			o, ok := oldRes.Items[name]
+			if !ok {
+				added = append(added, name)
+			} else if !proto.Equal(o.Resource, n.Resource) {
+				changed = append(changed, name)
+			}
+		}
+		for name := range oldRes.Items {
+			if _, ok := newRes.Items[name]; !ok {
+				removed = append(removed, name)
+			}
+		}
+		if len(added) == 0 && len(removed) == 0 && len(changed) == 0 {
+			continue
+		}
+
+		s := typeDiffSummary{
+			TypeURL: typeURL,
+			Added:   len(added),
+			Removed: len(removed),
+			Changed: len(changed),
+		}
+		s.Examples = append(s.Examples, diffExamples("+", added)...)
+		s.Examples = append(s.Examples, diffExamples("-", removed)...)
+		s.Examples = append(s.Examples, diffExamples("~", changed)...)
+		out = append(out, s)
+	}
+	return out
+}
+
+func diffExamples(prefix string, names []string) []string {
+	sort.Strings(names)
+	if len(names) > maxDiffExamples {
+		names = names[:maxDiffExamples]
+	}
+	out := make([]string, 0, len(names))
+	for _, n := range names {
+		out = append(out, prefix+n)
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+package proxy_syncer
+
+import (
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	envoycache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestSummarizeSnapshotDiff(t *testing.T) {
+	r := require.New(t)
+
+	prev, err := envoycache.NewSnapshot("1", map[resource.Type][]envoycachetypes.Resource{
+		resource.ClusterType: {
+			&envoyclusterv3.Cluster{Name: "unchanged"},
+			&envoyclusterv3.Cluster{Name: "changed"},
+			&envoyclusterv3.Cluster{Name: "removed"},
+		},
+		resource.ListenerType: {
+			&envoylistenerv3.Listener{Name: "listener"},
+		},
+	})
+	r.NoError(err)
+	next, err := envoycache.NewSnapshot("2", map[resource.Type][]envoycachetypes.Resource{
+		resource.ClusterType: {
+			&envoyclusterv3.Cluster{Name: "unchanged"},
+			&envoyclusterv3.Cluster{Name: "changed", ConnectTimeout: durationpb.New(5)},
+			&envoyclusterv3.Cluster{Name: "added"},
+		},
+		resource.ListenerType: {
+			&envoylistenerv3.Listener{Name: "listener"},
+		},
+		resource.RouteType: {
+			&envoyroutev3.RouteConfiguration{Name: "a"},
+			&envoyroutev3.RouteConfiguration{Name: "b"},
+			&envoyroutev3.RouteConfiguration{Name: "c"},
+			&envoyroutev3.RouteConfiguration{Name: "d"},
+		},
+	})
+	r.NoError(err)
+
+	r.Equal([]typeDiffSummary{
+		{
+			TypeURL:  resource.ClusterType,
+			Added:    1,
+			Removed:  1,
+			Changed:  1,
+			Examples: []string{"+added", "-removed", "~changed"},
+		},
+		{
+			TypeURL:  resource.RouteType,
+			Added:    4,
+			Examples: []string{"+a", "+b", "+c"},
+		},
+	}, summarizeSnapshotDiff(prev, next))
+
+	r.Empty(summarizeSnapshotDiff(next, next))
+	r.Len(summarizeSnapshotDiff(nil, next), 3)
+}
@@ -5,17 +5,23 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/avast/retry-go/v4"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 	utilretry "k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -47,6 +53,10 @@ type StatusSyncer struct {
 	cacheSyncs                     []cache.InformerSynced
 
 	customStatusSync func(ctx context.Context, rm reports.ReportMap)
+	// readyProxyReplicas is set when the Programmed condition of Gateways requires a ready proxy replica
+	readyProxyReplicas bool
+	// proxyReplicasChanged holds the Gateways whose proxy Deployment changed its ready replicas
+	proxyReplicasChanged *gatewayQueue
 }
 
 func NewStatusSyncer(
@@ -72,6 +82,8 @@ func NewStatusSyncer(
 		latestBackendPolicyReportQueue: backendPolicyReportQueue,
 		cacheSyncs:                     cacheSyncs,
 		customStatusSync:               cfg.CustomStatusSync,
+		readyProxyReplicas:             cfg.ReadyProxyReplicas,
+		proxyReplicasChanged:           newGatewayQueue(),
 	}
 }
 
@@ -102,19 +114,31 @@ func (s *StatusSyncer) Start(ctx context.Context) error {
 	routeStatusLogger := logger.With("subcomponent", "routeStatusSyncer")
 	listenerSetStatusLogger := logger.With("subcomponent", "listenerSetStatusSyncer")
 	gatewayStatusLogger := logger.With("subcomponent", "gatewayStatusSyncer")
+	var proxyReplicasChanged <-chan struct{}
+	if s.readyProxyReplicas {
+		if err := s.watchProxyReplicas(ctx); err != nil {
+			return err
+		}
+		proxyReplicasChanged = s.proxyReplicasChanged.changed
+	}
 	go func() {
+		var latestReport reports.ReportMap
 		for {
-			latestReport, err := s.latestReportQueue.Dequeue(ctx)
-			if err != nil {
-				logger.Error("failed to dequeue gateway reports", "error", err)
+			select {
+			case <-ctx.Done():
+				logger.Error("failed to dequeue gateway reports", "error", ctx.Err())
 				return
-			}
-			s.syncGatewayStatus(ctx, gatewayStatusLogger, latestReport)
-			s.syncListenerSetStatus(ctx, listenerSetStatusLogger, latestReport)
-			s.syncRouteStatus(ctx, routeStatusLogger, latestReport)
-			s.syncPolicyStatus(ctx, latestReport)
-			if s.customStatusSync != nil {
-				s.customStatusSync(ctx, latestReport)
+			case latestReport = <-s.latestReportQueue.Next():
+				s.syncGatewayStatus(ctx, gatewayStatusLogger, latestReport)
+				s.syncListenerSetStatus(ctx, listenerSetStatusLogger, latestReport)
+				s.syncRouteStatus(ctx, routeStatusLogger, latestReport)
+				s.syncPolicyStatus(ctx, latestReport)
+				if s.customStatusSync != nil {
+					s.customStatusSync(ctx, latestReport)
+				}
+			case <-proxyReplicasChanged:
+				// the Programmed condition of the Gateways depends on their ready proxy replicas
+				s.syncGatewayStatus(ctx, gatewayStatusLogger, latestReport.ForGateways(s.proxyReplicasChanged.drain()))
 			}
 		}
 	}()
@@ -391,6 +415,10 @@ func (s *StatusSyncer) syncGatewayStatus(ctx context.Context, logger *slog.Logge
 				return nil
 			}
 
+			if s.readyProxyReplicas {
+				setReadyReplicas(newStatus, gw.Status.Conditions, s.proxyDeployment(ctx, logger, &gw))
+			}
+
 			// Skip if status hasn’t changed (ignoring Addresses)
 			old := gw.Status
 			old.Addresses = nil
@@ -557,7 +585,9 @@ func (s *StatusSyncer) syncPolicyStatus(ctx context.Context, rm reports.ReportMa
 				}
 
 				if cond.Reason != string(shared.PolicyReasonValid) &&
-					cond.Reason != string(shared.PolicyReasonPending) {
+					cond.Reason != string(shared.PolicyReasonPending) &&
+					cond.Reason != string(shared.PolicyReasonExpiring) &&
+					cond.Reason != string(shared.PolicyReasonUnsupportedField) {
 					statusErr = fmt.Errorf("invalid policy condition")
 
 					break
@@ -600,6 +630,119 @@ func (s *StatusSyncer) syncPolicyStatus(ctx context.Context, rm reports.ReportMa
 	}
 }
 
+// watchProxyReplicas queues the Gateways whose generated Deployment changed its ready or desired replicas.
+func (s *StatusSyncer) watchProxyReplicas(ctx context.Context) error {
+	informer, err := s.mgr.GetCache().GetInformer(ctx, &appsv1.Deployment{})
+	if err != nil {
+		return fmt.Errorf("failed to get the deployment informer: %w", err)
+	}
+	enqueue := func(obj any) {
+		if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+			obj = tombstone.Obj
+		}
+		deployment, ok := obj.(*appsv1.Deployment)
+		if !ok {
+			return
+		}
+		owner := metav1.GetControllerOf(deployment)
+		if owner == nil || owner.Kind != wellknown.GatewayKind || !strings.HasPrefix(owner.APIVersion, gwv1.GroupName+"/") {
+			return
+		}
+		s.proxyReplicasChanged.add(types.NamespacedName{Namespace: deployment.Namespace, Name: owner.Name})
+	}
+	_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: enqueue,
+		UpdateFunc: func(oldObj, newObj any) {
+			oldDeployment, ok1 := oldObj.(*appsv1.Deployment)
+			newDeployment, ok2 := newObj.(*appsv1.Deployment)
+			if ok1 && ok2 && oldDeployment.Status.ReadyReplicas == newDeployment.Status.ReadyReplicas &&
+				ptr.Equal(oldDeployment.Spec.Replicas, newDeployment.Spec.Replicas) {
+				return
+			}
+			enqueue(newObj)
+		},
+		DeleteFunc: enqueue,
+	})
+	return err
+}
+
+// proxyDeployment returns the proxy Deployment generated for the Gateway, or nil if the Gateway has no generated
+// Deployment.
+func (s *StatusSyncer) proxyDeployment(ctx context.Context, logger *slog.Logger, gw *gwv1.Gateway) *appsv1.Deployment {
+	var deployments appsv1.DeploymentList
+	if err := s.mgr.GetClient().List(ctx, &deployments, client.InNamespace(gw.Namespace)); err != nil {
+		logger.Error("error listing deployments", "error", err, "gateway", client.ObjectKeyFromObject(gw).String())
+		return nil
+	}
+	for i := range deployments.Items {
+		if metav1.IsControlledBy(&deployments.Items[i], gw) {
+			return &deployments.Items[i]
+		}
+	}
+	return nil
+}
+
+// setReadyReplicas holds a Programmed Gateway at Pending until at least one replica of its proxy Deployment is ready,
+// and reports the ready and desired proxy replicas in the Programmed condition message. Envoy is only ready once it
+// loaded its first full xDS configuration, so this reflects the configuration ACKed by the proxies, whichever
+// control plane replica they are connected to. Gateways without a generated Deployment are left untouched.
+func setReadyReplicas(status *gwv1.GatewayStatus, oldConditions []metav1.Condition, deployment *appsv1.Deployment) {
+	programmed := meta.FindStatusCondition(status.Conditions, string(gwv1.GatewayConditionProgrammed))
+	if programmed == nil || programmed.Status != metav1.ConditionTrue || deployment == nil {
+		return
+	}
+
+	ready := deployment.Status.ReadyReplicas
+	replicas := fmt.Sprintf("%d/%d proxy replicas ready", ready, ptr.Deref(deployment.Spec.Replicas, 1))
+	cond := *programmed
+	cond.Message = fmt.Sprintf("%s; %s", programmed.Message, replicas)
+	if ready == 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = string(gwv1.GatewayReasonPending)
+		cond.Message = fmt.Sprintf("Waiting for a proxy replica to load its configuration; %s", replicas)
+	}
+
+	// the condition was built from the old status before being held at Pending, so restore its transition time
+	if old := meta.FindStatusCondition(oldConditions, cond.Type); old != nil && old.Status == cond.Status {
+		cond.LastTransitionTime = old.LastTransitionTime
+	} else {
+		cond.LastTransitionTime = metav1.Now()
+	}
+	*programmed = cond
+}
+
+// gatewayQueue coalesces the Gateways to resync until they are drained.
+type gatewayQueue struct {
+	lock     sync.Mutex
+	gateways sets.Set[types.NamespacedName]
+	changed  chan struct{}
+}
+
+func newGatewayQueue() *gatewayQueue {
+	return &gatewayQueue{
+		gateways: sets.New[types.NamespacedName](),
+		changed:  make(chan struct{}, 1),
+	}
+}
+
+func (q *gatewayQueue) add(gw types.NamespacedName) {
+	q.lock.Lock()
+	q.gateways.Insert(gw)
+	q.lock.Unlock()
+	select {
+	case q.changed <- struct{}{}:
+	default:
+	}
+}
+
+func (q *gatewayQueue) drain() sets.Set[types.NamespacedName] {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	gateways := q.gateways
+	q.gateways = sets.New[types.NamespacedName]()
+	return gateways
+}
+
 // NeedLeaderElection returns true to ensure that the StatusSyncer runs only on the leader
 func (r *StatusSyncer) NeedLeaderElection() bool {
 	return true
@@ -7,9 +7,13 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
@@ -211,3 +215,81 @@ func TestBackendPolicyStatus(t *testing.T) {
 	)
 	a.Empty(diff)
 }
+
+func TestSetReadyReplicas(t *testing.T) {
+	programmed := func(status metav1.ConditionStatus, reason gwv1.GatewayConditionReason, msg string) metav1.Condition {
+		return metav1.Condition{
+			Type:    string(gwv1.GatewayConditionProgrammed),
+			Status:  status,
+			Reason:  string(reason),
+			Message: msg,
+		}
+	}
+	deployment := func(replicas, ready int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			Spec:   appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+			Status: appsv1.DeploymentStatus{ReadyReplicas: ready},
+		}
+	}
+	tests := []struct {
+		name       string
+		in         metav1.Condition
+		deployment *appsv1.Deployment
+		want       metav1.Condition
+	}{
+		{
+			name:       "no replica ready",
+			in:         programmed(metav1.ConditionTrue, gwv1.GatewayReasonProgrammed, reports.GatewayProgrammedMessage),
+			deployment: deployment(2, 0),
+			want: programmed(metav1.ConditionFalse, gwv1.GatewayReasonPending,
+				"Waiting for a proxy replica to load its configuration; 0/2 proxy replicas ready"),
+		},
+		{
+			name:       "replica ready",
+			in:         programmed(metav1.ConditionTrue, gwv1.GatewayReasonProgrammed, reports.GatewayProgrammedMessage),
+			deployment: deployment(2, 1),
+			want: programmed(metav1.ConditionTrue, gwv1.GatewayReasonProgrammed,
+				reports.GatewayProgrammedMessage+"; 1/2 proxy replicas ready"),
+		},
+		{
+			name: "no generated deployment",
+			in:   programmed(metav1.ConditionTrue, gwv1.GatewayReasonProgrammed, reports.GatewayProgrammedMessage),
+			want: programmed(metav1.ConditionTrue, gwv1.GatewayReasonProgrammed, reports.GatewayProgrammedMessage),
+		},
+		{
+			name:       "not programmed",
+			in:         programmed(metav1.ConditionFalse, gwv1.GatewayReasonAddressNotUsable, "Hostname addresses may not be used"),
+			deployment: deployment(2, 0),
+			want:       programmed(metav1.ConditionFalse, gwv1.GatewayReasonAddressNotUsable, "Hostname addresses may not be used"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &gwv1.GatewayStatus{Conditions: []metav1.Condition{tt.in}}
+			setReadyReplicas(status, nil, tt.deployment)
+			assert.Empty(t, cmp.Diff([]metav1.Condition{tt.want}, status.Conditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")))
+		})
+	}
+}
+
+func TestGatewayQueue(t *testing.T) {
+	a := assert.New(t)
+	gw1 := types.NamespacedName{Namespace: "default", Name: "gw1"}
+	gw2 := types.NamespacedName{Namespace: "default", Name: "gw2"}
+
+	q := newGatewayQueue()
+	q.add(gw1)
+	q.add(gw1)
+	a.Len(q.changed, 1)
+	<-q.changed
+	a.True(q.drain().Equal(sets.New(gw1)))
+	a.Empty(q.drain())
+
+	// only the queued Gateways are resynced
+	rm := reports.NewReportMap()
+	rm.Gateways[gw1] = &reports.GatewayReport{}
+	rm.Gateways[gw2] = &reports.GatewayReport{}
+	q.add(gw2)
+	a.Equal(map[types.NamespacedName]*reports.GatewayReport{gw2: rm.Gateways[gw2]}, rm.ForGateways(q.drain()).Gateways)
+}
@@ -40,6 +40,21 @@ func (m *MockGatewayQueries) EXPECT() *MockGatewayQueriesMockRecorder {
 	return m.recorder
 }
 
+// GetCACertForTrustBundleRef mocks base method.
+func (m *MockGatewayQueries) GetCACertForTrustBundleRef(arg0 krt.HandlerContext, arg1 context.Context, arg2 string, arg3 v10.ObjectReference) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCACertForTrustBundleRef", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCACertForTrustBundleRef indicates an expected call of GetCACertForTrustBundleRef.
+func (mr *MockGatewayQueriesMockRecorder) GetCACertForTrustBundleRef(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCACertForTrustBundleRef", reflect.TypeOf((*MockGatewayQueries)(nil).GetCACertForTrustBundleRef), arg0, arg1, arg2, arg3)
+}
+
 // GetConfigMapForRef mocks base method.
 func (m *MockGatewayQueries) GetConfigMapForRef(arg0 krt.HandlerContext, arg1 context.Context, arg2 schema.GroupKind, arg3 string, arg4 v10.ObjectReference) (*v1.ConfigMap, error) {
 	m.ctrl.T.Helper()
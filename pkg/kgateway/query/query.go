@@ -102,6 +102,8 @@ func (f FromObject) Namespace() string {
 type GatewayQueries interface {
 	GetSecretForRef(kctx krt.HandlerContext, ctx context.Context, fromGk schema.GroupKind, fromns string, secretRef gwv1.SecretObjectReference) (*ir.Secret, error)
 	GetConfigMapForRef(kctx krt.HandlerContext, ctx context.Context, fromGk schema.GroupKind, fromns string, configMapRef gwv1.ObjectReference) (*corev1.ConfigMap, error)
+	// GetCACertForTrustBundleRef returns the CA certificates of a ClusterTrustBundle or trust-manager Bundle referenced from the namespace
+	GetCACertForTrustBundleRef(kctx krt.HandlerContext, ctx context.Context, fromns string, trustBundleRef gwv1.ObjectReference) (string, error)
 
 	// GetRoutesForGateway finds the top level xRoutes attached to the provided Gateway
 	GetRoutesForGateway(kctx krt.HandlerContext, ctx context.Context, gw *ir.Gateway) (*RoutesForGwResult, error)
@@ -290,6 +292,10 @@ func (r *gatewayQueries) GetConfigMapForRef(kctx krt.HandlerContext, ctx context
 	return r.collections.ConfigMaps.GetConfigMap(kctx, f, configMapRef)
 }
 
+func (r *gatewayQueries) GetCACertForTrustBundleRef(kctx krt.HandlerContext, ctx context.Context, fromns string, trustBundleRef gwv1.ObjectReference) (string, error) {
+	return r.collections.TrustBundles.GetCACert(kctx, fromns, trustBundleRef)
+}
+
 func ReferenceAllowed(ctx context.Context, fromgk metav1.GroupKind, fromns string, togk metav1.GroupKind, toname string, grantsInToNs []gwv1b1.ReferenceGrant) bool {
 	for _, refGrant := range grantsInToNs {
 		for _, from := range refGrant.Spec.From {
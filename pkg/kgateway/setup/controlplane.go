@@ -25,6 +25,7 @@ import (
 	"istio.io/istio/pkg/security"
 	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
 
+	apisettings "github.com/kgateway-dev/kgateway/v2/api/settings"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/agentgatewaysyncer/krtxds"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/agentgatewaysyncer/nack"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
@@ -107,7 +108,7 @@ func NewControlPlane(
 	serverOpts := getGRPCServerOpts(authenticators, xdsAuth, certWatcher, baseLogger)
 	kgwGRPCServer := grpc.NewServer(serverOpts...)
 
-	snapshotCache := envoycache.NewSnapshotCache(true, xds.NewNodeRoleHasher(), envoyLoggerAdapter)
+	snapshotCache := xds.NewPinnedSnapshotCache(true, envoyLoggerAdapter)
 
 	xdsServer := xdsserver.NewServer(ctx, snapshotCache, allCallbacks)
 
@@ -140,6 +141,7 @@ func NewAgwControlPlane(
 	xdsAuth bool,
 	certWatcher *certwatcher.CertWatcher,
 	nackPublisher *nack.Publisher,
+	admitter krtxds.ConnectionAdmitter,
 	reg ...krtxds.Registration,
 ) {
 	baseLogger := slog.Default().With("component", "agentgateway-controlplane")
@@ -148,6 +150,7 @@ func NewAgwControlPlane(
 	grpcServer := grpc.NewServer(serverOpts...)
 
 	ds := krtxds.NewDiscoveryServer(nil, nackPublisher, reg...)
+	ds.Admitter = admitter
 	stop := make(chan struct{})
 	context.AfterFunc(ctx, func() {
 		close(stop)
@@ -166,6 +169,26 @@ func NewAgwControlPlane(
 	}()
 }
 
+// newAgwXdsAdmitter returns the admitter of the agentgateway xDS connections configured in the settings,
+// or nil if no admission webhook is configured.
+func newAgwXdsAdmitter(settings *apisettings.Settings) (krtxds.ConnectionAdmitter, error) {
+	if settings.XdsAdmissionWebhookURL == "" {
+		return nil, nil
+	}
+	admitter, err := krtxds.NewWebhookAdmitter(krtxds.WebhookAdmitterOptions{
+		URL:      settings.XdsAdmissionWebhookURL,
+		Timeout:  settings.XdsAdmissionWebhookTimeout,
+		CAFile:   settings.XdsAdmissionWebhookCAFile,
+		FailOpen: settings.XdsAdmissionWebhookFailurePolicy == apisettings.AdmissionFailurePolicyIgnore,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid xDS admission webhook: %w", err)
+	}
+	slog.Info("admitting xDS connections with webhook", "url", settings.XdsAdmissionWebhookURL,
+		"failure_policy", settings.XdsAdmissionWebhookFailurePolicy)
+	return admitter, nil
+}
+
 func getGRPCServerOpts(
 	authenticators []security.Authenticator,
 	xdsAuth bool,
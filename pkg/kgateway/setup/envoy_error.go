@@ -83,23 +83,15 @@ func (l *logNackCallback) OnStreamClosed(streamID int64, node *envoycorev3.Node)
 // OnStreamRequest implements server.Callbacks.
 func (l *logNackCallback) OnStreamRequest(streamID int64, req *discoveryv3.DiscoveryRequest) error {
 	// get gateway and typeURL from request
-	role := req.GetNode().GetMetadata().GetFields()[xds.RoleKey].GetStringValue()
-	parts := strings.SplitN(role, xds.KeyDelimiter, 3)
-	if len(parts) != 3 {
+	gw, ok := xds.GatewayID(req.GetNode())
+	if !ok {
 		return nil
 	}
-	namespace := parts[1]
-	name := parts[2]
-
-	// note, with locality, name will include name~hash~ns
-	if localityParts := strings.SplitN(name, xds.KeyDelimiter, 3); len(localityParts) == 3 {
-		name = localityParts[0]
-	}
 
 	typeUrl := req.GetTypeUrl()
 	key := resourceKey{
-		Namespace:       namespace,
-		Name:            name,
+		Namespace:       gw.Namespace,
+		Name:            gw.Name,
 		ResourceTypeUrl: strings.TrimPrefix(typeUrl, "type.googleapis.com/"),
 	}
 
@@ -34,6 +34,7 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/admin"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/agentgatewaysyncer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/controller"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/extensions2/plugins/trafficpolicy"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/proxy_syncer"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
 	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/xds"
@@ -476,11 +477,20 @@ func (s *setup) Start(ctx context.Context) error {
 	}
 
 	if s.agwXdsListener != nil && agw != nil {
-		NewAgwControlPlane(ctx, s.agwXdsListener, authenticators, s.globalSettings.XdsAuth, certWatcher, agw.NackPublisher, agw.Registrations...)
+		admitter, err := newAgwXdsAdmitter(s.globalSettings)
+		if err != nil {
+			return err
+		}
+		NewAgwControlPlane(ctx, s.agwXdsListener, authenticators, s.globalSettings.XdsAuth, certWatcher, agw.NackPublisher, admitter, agw.Registrations...)
 	}
 
 	slog.Info("starting admin server")
-	go admin.RunAdminServer(ctx, setupOpts)
+	go admin.RunAdminServer(ctx, setupOpts, s.apiClient.Kube())
+
+	if s.globalSettings.EnableEnvoy {
+		slog.Info("starting wasm module server")
+		trafficpolicy.RunWasmModuleServer(ctx, s.globalSettings.WasmServicePort)
+	}
 
 	slog.Info("starting manager")
 	return mgr.Start(ctx)
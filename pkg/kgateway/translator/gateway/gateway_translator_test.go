@@ -95,6 +95,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("frontendtlsconfig with certificate revocation list", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "frontendtlsconfig/crl.yaml",
+			outputFile: "frontendtlsconfig/crl.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("frontendtlsconfig with verify subject alt names missing ca certificate", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "frontendtlsconfig/verify-subject-alt-names-missing-ca.yaml",
@@ -672,6 +683,20 @@ func TestBasic(t *testing.T) {
 			})
 	})
 
+	t.Run("TrafficPolicy Transformation deep merge ordered by priority", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/transformation-priority.yaml",
+			outputFile: "traffic-policy/transformation-priority.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test",
+			},
+		},
+			func(s *apisettings.Settings) {
+				s.PolicyMerge = `{"trafficPolicy":{"transformation":"DeepMerge"}}`
+			})
+	})
+
 	t.Run("Load balancer with hash policies", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "loadbalancer/hash-policies.yaml",
@@ -705,6 +730,39 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("TrafficPolicy with body match", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/body-match.yaml",
+			outputFile: "traffic-policy/body-match.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with fault injection", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/fault-injection.yaml",
+			outputFile: "traffic-policy/fault-injection.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
+	t.Run("TrafficPolicy with request mirroring", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "traffic-policy/mirroring.yaml",
+			outputFile: "traffic-policy/mirroring.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("TrafficPolicy with header modifiers attached to gateway", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "traffic-policy/header-modifiers-gateway.yaml",
@@ -780,6 +838,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("tcproute with TrafficPolicy timeouts", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tcp-routing/traffic-policy.yaml",
+			outputFile: "tcp-routing/traffic-policy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("tcproute with missing backend reports correctly", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "tcp-routing/missing-backend.yaml",
@@ -879,6 +948,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("tls gateway with sni forwarding table", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "tls-routing/sni-forwarding.yaml",
+			outputFile: "tls-routing/sni-forwarding-proxy.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("grpc gateway with basic routing", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "grpc-routing/basic.yaml",
@@ -1022,6 +1102,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("DirectResponse with body from ConfigMap", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "directresponse/body-ref.yaml",
+			outputFile: "directresponse/body-ref.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("DirectResponse with missing reference reports correctly", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "directresponse/missing-ref.yaml",
@@ -1330,6 +1421,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("ListenerPolicy with properCaseHttp1Headers", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "listener-policy-http/proper-case-http1-headers.yaml",
+			outputFile: "listener-policy-http/proper-case-http1-headers.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("ListenerPolicy with useRemoteAddress absent", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "listener-policy-http/use-remote-addr-absent.yaml",
@@ -1429,6 +1531,17 @@ func TestBasic(t *testing.T) {
 		})
 	})
 
+	t.Run("ListenerPolicy with request header limits", func(t *testing.T) {
+		test(t, translatorTestCase{
+			inputFile:  "listener-policy-http/request-header-limits.yaml",
+			outputFile: "listener-policy-http/request-header-limits.yaml",
+			gwNN: types.NamespacedName{
+				Namespace: "default",
+				Name:      "example-gateway",
+			},
+		})
+	})
+
 	t.Run("ListenerPolicy with uuidRequestIdConfig explicit false", func(t *testing.T) {
 		test(t, translatorTestCase{
 			inputFile:  "listener-policy-http/request-id-config-explicit.yaml",
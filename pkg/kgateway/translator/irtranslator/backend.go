@@ -138,6 +138,21 @@ func (t *BackendTranslator) runPolicies(
 		}
 	}
 
+	// finalize the cluster once every plugin processed it, as the policy plugins run in no particular order
+	for gk, policyPlugin := range t.ContributedPolicies {
+		if policyPlugin.FinalizeBackend == nil {
+			continue
+		}
+		for _, polAttachment := range backend.AttachedPolicies.Policies[gk] {
+			if len(polAttachment.Errors) > 0 {
+				continue
+			}
+			if err := policyPlugin.FinalizeBackend(ctx, polAttachment.PolicyIr, *backend, out); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
 	// for clusters that want a CLA _and_ initialized with inlineEps, build the CLA.
 	// never overwrite the CLA that was already initialized (potentially within a plugin).
 	if out.GetLoadAssignment() == nil && endpointInputs != nil && clusterSupportsInlineCLA(out) {
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_upstreams_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -173,6 +174,66 @@ func TestBackendTranslatorPropagatesPolicyErrors(t *testing.T) {
 	assert.Empty(t, backend.Errors)
 }
 
+// TestBackendTranslatorFinalizesBackendAfterPlugins validates that the cluster is finalized once every
+// policy plugin processed it, and that finalization errors fail the translation.
+func TestBackendTranslatorFinalizesBackendAfterPlugins(t *testing.T) {
+	finalizerGK := schema.GroupKind{Group: "gateway.kgateway.dev", Kind: "BackendConfigPolicy"}
+	processorGK := schema.GroupKind{Group: "gateway-api", Kind: "BackendTLSPolicy"}
+	backend := &ir.BackendObjectIR{
+		ObjectSource: ir.ObjectSource{
+			Group:     "group",
+			Kind:      "kind",
+			Name:      "name",
+			Namespace: "namespace",
+		},
+		AttachedPolicies: ir.AttachedPolicies{
+			Policies: map[schema.GroupKind][]ir.PolicyAtt{
+				finalizerGK: {{GroupKind: finalizerGK}},
+				processorGK: {{GroupKind: processorGK}},
+			},
+		},
+	}
+
+	var finalizeErr error
+	var finalizedTransportSocket string
+	var bt irtranslator.BackendTranslator
+	bt.ContributedBackends = map[schema.GroupKind]ir.BackendInit{
+		{Group: "group", Kind: "kind"}: {
+			InitEnvoyBackend: func(ctx context.Context, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) *ir.EndpointsForBackend {
+				return nil
+			},
+		},
+	}
+	bt.ContributedPolicies = map[schema.GroupKind]sdk.PolicyPlugin{
+		finalizerGK: {
+			Name: "BackendConfigPolicy",
+			FinalizeBackend: func(ctx context.Context, polir ir.PolicyIR, backend ir.BackendObjectIR, out *envoyclusterv3.Cluster) error {
+				finalizedTransportSocket = out.GetTransportSocket().GetName()
+				return finalizeErr
+			},
+		},
+		processorGK: {
+			Name: "BackendTLSPolicy",
+			ProcessBackend: func(ctx context.Context, polir ir.PolicyIR, backend ir.BackendObjectIR, out *envoyclusterv3.Cluster) {
+				out.TransportSocket = &envoycorev3.TransportSocket{Name: "envoy.transport_sockets.tls"}
+			},
+		},
+	}
+
+	var ucc ir.UniqlyConnectedClient
+	var kctx krt.TestingDummyContext
+	cluster, err := bt.TranslateBackend(context.Background(), kctx, ucc, backend)
+	require.NoError(t, err)
+	assert.Equal(t, "envoy.transport_sockets.tls", finalizedTransportSocket)
+	assert.Equal(t, "envoy.transport_sockets.tls", cluster.GetTransportSocket().GetName())
+
+	finalizeErr = errors.New("failed to apply proxy protocol")
+	cluster, err = bt.TranslateBackend(context.Background(), kctx, ucc, backend)
+	require.ErrorIs(t, err, finalizeErr)
+	assert.Equal(t, envoyclusterv3.Cluster_STATIC, cluster.GetType())
+	assert.Nil(t, cluster.GetTransportSocket())
+}
+
 // TestBackendTranslatorHandlesXDSValidationErrors validates that when xDS validation fails
 // in strict mode, the translator returns a blackhole cluster and error.
 func TestBackendTranslatorHandlesXDSValidationErrors(t *testing.T) {
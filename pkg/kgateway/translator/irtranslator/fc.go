@@ -1,6 +1,7 @@
 package irtranslator
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 
@@ -121,7 +122,7 @@ func (n *filterChainTranslator) computeNetworkFiltersForHttp(l ir.HttpFilterChai
 		gateway:           n.gateway, // corresponds to Gateway API listener
 		policyAncestorRef: n.listener.PolicyAncestorRef,
 	}
-	networkFilters := sortNetworkFilters(n.computeCustomFilters(l.CustomNetworkFilters, listenerReporter))
+	networkFilters := sortNetworkFilters(n.computeCustomFilters(l.CustomNetworkFilters, false, listenerReporter))
 	networkFilter, err := hcm.computeNetworkFilters(l)
 	if err != nil {
 		return nil, err
@@ -135,12 +136,16 @@ func (n *filterChainTranslator) computeNetworkFiltersForHttp(l ir.HttpFilterChai
 // For HTTP FilterChains these must be added before HCM.
 func (n *filterChainTranslator) computeCustomFilters(
 	customNetworkFilters []ir.CustomEnvoyFilter,
+	tcpProxy bool,
 	listenerReporter sdkreporter.ListenerReporter,
 ) []filters.StagedNetworkFilter {
 	var networkFilters []filters.StagedNetworkFilter
 	// Process the network filters.
 	for _, plug := range n.pluginPass {
-		stagedFilters, err := plug.NetworkFilters()
+		stagedFilters, err := plug.NetworkFilters(ir.NetworkFiltersContext{
+			ListenerPort: n.listener.BindPort,
+			TcpProxy:     tcpProxy,
+		})
 		if err != nil {
 			listenerReporter.SetCondition(sdkreporter.ListenerCondition{
 				Type:    gwv1.ListenerConditionProgrammed,
@@ -375,7 +380,7 @@ func sortHttpFilters(filters filters.StagedHttpFilterList) []*envoyhttp.HttpFilt
 }
 
 func (h *filterChainTranslator) computeTcpFilters(l ir.TcpIR, reporter sdkreporter.ListenerReporter) []*envoylistenerv3.Filter {
-	networkFilters := sortNetworkFilters(h.computeCustomFilters(l.CustomNetworkFilters, reporter))
+	networkFilters := sortNetworkFilters(h.computeCustomFilters(l.CustomNetworkFilters, true, reporter))
 
 	cfg := &envoytcp.TcpProxy{
 		StatPrefix: l.FilterChainName,
@@ -401,6 +406,38 @@ func (h *filterChainTranslator) computeTcpFilters(l ir.TcpIR, reporter sdkreport
 		}
 	}
 
+	// Allow any TcpProxy plugins to make their changes. The status of the listener and gateway policies is
+	// reported when running the listener plugins, only the status of the route policies is reported here.
+	var attachedPolicies ir.AttachedPolicies
+	// Route policies take precedence over listener policies, which take precedence over gateway policies,
+	// so they are ordered first
+	attachedPolicies.Append(l.AttachedPolicies, h.listener.AttachedPolicies, h.gateway.AttachedHttpPolicies)
+	for _, gk := range attachedPolicies.ApplyOrderedGroupKinds() {
+		pass := h.pluginPass[gk]
+		if pass == nil {
+			continue
+		}
+		routePolicies := l.AttachedPolicies.Policies[gk]
+		reportPolicyAcceptanceStatus(h.reporter, h.listener.PolicyAncestorRef, routePolicies...)
+		policies, mergeOrigins := mergePolicies(pass, attachedPolicies.Policies[gk])
+		reportPolicyAttachmentStatus(h.reporter, h.listener.PolicyAncestorRef, mergeOrigins, routePolicies...)
+		for _, pol := range policies {
+			pctx := &ir.TcpProxyContext{
+				ListenerPort: h.listener.BindPort,
+				Policy:       pol.PolicyIr,
+				Gateway:      h.gateway,
+			}
+			if err := pass.ApplyTcpProxy(pctx, cfg); err != nil {
+				reporter.SetCondition(sdkreporter.ListenerCondition{
+					Type:    gwv1.ListenerConditionProgrammed,
+					Reason:  gwv1.ListenerReasonInvalid,
+					Status:  metav1.ConditionFalse,
+					Message: "Error processing TcpProxy plugin: " + err.Error(),
+				})
+			}
+		}
+	}
+
 	tcpFilter, _ := NewFilterWithTypedConfig(wellknown.TCPProxy, cfg)
 
 	return append(networkFilters, tcpFilter)
@@ -555,6 +592,9 @@ func buildValidationContext(tlsConfig *ir.TLSConfig) *envoytlsv3.CertificateVali
 			}
 			validationContext.TrustedCa = bytesDataSource(combinedCA)
 		}
+		if len(tlsConfig.ClientCertificateValidation.CRLs) > 0 && validationContext != nil {
+			validationContext.Crl = bytesDataSource(bytes.Join(tlsConfig.ClientCertificateValidation.CRLs, []byte{'\n'}))
+		}
 	}
 
 	return validationContext
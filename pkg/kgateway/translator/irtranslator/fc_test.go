@@ -34,7 +34,7 @@ type addFilters struct {
 	ir.UnimplementedProxyTranslationPass
 }
 
-func (a addFilters) NetworkFilters() ([]filters.StagedNetworkFilter, error) {
+func (a addFilters) NetworkFilters(ir.NetworkFiltersContext) ([]filters.StagedNetworkFilter, error) {
 	return []filters.StagedNetworkFilter{
 		{
 			Filter: &envoylistenerv3.Filter{Name: testPluginFilterName},
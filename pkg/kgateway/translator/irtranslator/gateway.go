@@ -40,6 +40,10 @@ type TranslationResult struct {
 	Listeners     []*envoylistenerv3.Listener
 	ExtraClusters []*envoyclusterv3.Cluster
 	Secrets       []*envoytlsv3.Secret
+
+	// RouteClaims are the hostnames and matches claimed by routes on this Gateway.
+	// They are not part of the xDS output and are used for status reporting only.
+	RouteClaims []RouteClaim
 }
 
 // Translate IR to gateway. IR is self contained, so no need for krt context
@@ -67,6 +71,8 @@ func (t *Translator) Translate(ctx context.Context, gw ir.GatewayIR, reporter sd
 		}
 	}
 
+	res.RouteClaims = computeRouteClaims(gw)
+
 	return res
 }
 
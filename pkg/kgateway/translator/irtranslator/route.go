@@ -48,6 +48,8 @@ const (
 	// directResponseActionBody is the body of the direct response action for replaced
 	// routes.
 	directResponseActionBody = `invalid route configuration detected and replaced with a direct response.`
+	// defaultMaxDirectResponseBodySize is the default limit of Envoy for the body of direct responses.
+	defaultMaxDirectResponseBodySize = 4096
 )
 
 func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(
@@ -62,6 +64,7 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(
 	// all virtual hosts from multiple listeners that share the same port. Each distinct
 	// hostname on each HTTPRoute attached to a listener will be a separate vhost.
 	cfg.VirtualHosts = h.computeVirtualHosts(ctx, vhosts)
+	cfg.MaxDirectResponseBodySizeBytes = maxDirectResponseBodySize(cfg.GetVirtualHosts())
 
 	// Gateway API spec requires that port values in HTTP Host headers be ignored when performing a match
 	// See https://gateway-api.sigs.k8s.io/reference/spec/#gateway.networking.k8s.io/v1.HTTPRouteSpec - hostnames field
@@ -114,6 +117,21 @@ func (h *httpRouteConfigurationTranslator) ComputeRouteConfiguration(
 	return cfg
 }
 
+// maxDirectResponseBodySize returns the body size limit required by the direct responses of the
+// virtual hosts, or nil if the default limit of Envoy is sufficient.
+func maxDirectResponseBodySize(vhosts []*envoyroutev3.VirtualHost) *wrapperspb.UInt32Value {
+	var maxSize int
+	for _, vhost := range vhosts {
+		for _, route := range vhost.GetRoutes() {
+			maxSize = max(maxSize, len(route.GetDirectResponse().GetBody().GetInlineString()))
+		}
+	}
+	if maxSize <= defaultMaxDirectResponseBodySize {
+		return nil
+	}
+	return wrapperspb.UInt32(uint32(maxSize)) // nolint:gosec // G115: body size is bounded by the ConfigMap size
+}
+
 func (h *httpRouteConfigurationTranslator) computeVirtualHosts(
 	ctx context.Context,
 	virtualHosts []*ir.VirtualHost,
@@ -476,6 +494,31 @@ func (h *httpRouteConfigurationTranslator) runBackendPolicies(in ir.HttpBackend,
 	return errors.Join(errs...)
 }
 
+// runBackendObjectPolicies applies the policies attached to the backend object itself, e.g. a BackendConfigPolicy
+// targeting a Service, to the route to the backend. Their errors were already reported when translating the cluster.
+func (h *httpRouteConfigurationTranslator) runBackendObjectPolicies(in ir.HttpBackend, pCtx *ir.RouteBackendContext) error {
+	if in.Backend.BackendObject == nil {
+		return nil
+	}
+	var errs []error
+	attached := in.Backend.BackendObject.AttachedPolicies
+	for _, gk := range attached.ApplyOrderedGroupKinds() {
+		pass := h.pluginPass[gk]
+		if pass == nil {
+			continue
+		}
+		for _, pol := range attached.Policies[gk] {
+			if len(pol.Errors) > 0 {
+				continue
+			}
+			if err := pass.ApplyForRouteBackend(pol.PolicyIr, pCtx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
 func (h *httpRouteConfigurationTranslator) runBackend(in ir.HttpBackend, pCtx *ir.RouteBackendContext, outRoute *envoyroutev3.Route) error {
 	var errs []error
 	if in.Backend.BackendObject != nil {
@@ -537,6 +580,13 @@ func (h *httpRouteConfigurationTranslator) translateRouteAction(
 			// TODO: error on status
 			h.logger.Error("error processing backends with policies", "error", err)
 		}
+		err = h.runBackendObjectPolicies(
+			backend,
+			&pCtx,
+		)
+		if err != nil {
+			h.logger.Error("error processing policies attached to backends", "error", err)
+		}
 
 		backendConfigCtx.RequestHeadersToAdd = pCtx.RequestHeadersToAdd
 		backendConfigCtx.RequestHeadersToRemove = pCtx.RequestHeadersToRemove
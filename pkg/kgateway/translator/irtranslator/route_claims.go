@@ -0,0 +1,106 @@
+package irtranslator
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// RouteClaim records that a route claims a hostname and match on one of the Gateway's HTTP listeners.
+// Claims are used to detect routes that shadow each other across Gateways.
+type RouteClaim struct {
+	// Hostname is the virtual host domain the route is attached to; "*" if the route has no hostname.
+	Hostname string
+	// Match is a canonical representation of the route match.
+	Match string
+	// Route is the route that contributed the match.
+	Route ir.ObjectSource
+	// ParentRef is the parentRef of the route that led to this claim, used to report status.
+	ParentRef gwv1.ParentReference
+}
+
+func (c RouteClaim) Equals(in RouteClaim) bool {
+	return c.Hostname == in.Hostname &&
+		c.Match == in.Match &&
+		c.Route == in.Route &&
+		reports.ParentString(c.ParentRef) == reports.ParentString(in.ParentRef)
+}
+
+// computeRouteClaims returns the hostname and match claimed by every route rule on the Gateway's
+// HTTP listeners. Synthetic rules without a parent route and delegating rules are skipped, as the
+// latter claim their matches through their children.
+func computeRouteClaims(gw ir.GatewayIR) []RouteClaim {
+	var claims []RouteClaim
+	for _, l := range gw.Listeners {
+		for _, hfc := range l.HttpFilterChain {
+			for _, vh := range hfc.Vhosts {
+				hostname := vh.Hostname
+				if hostname == "" {
+					hostname = "*"
+				}
+				for _, rule := range vh.Rules {
+					if rule.Parent == nil || rule.Delegates {
+						continue
+					}
+					claims = append(claims, RouteClaim{
+						Hostname:  hostname,
+						Match:     RouteMatchKey(rule.Match),
+						Route:     rule.Parent.ObjectSource,
+						ParentRef: rule.ParentRef,
+					})
+				}
+			}
+		}
+	}
+	return claims
+}
+
+// RouteMatchKey returns a canonical string for the given match, such that two matches selecting
+// the same requests produce the same key.
+func RouteMatchKey(match gwv1.HTTPRouteMatch) string {
+	var sb strings.Builder
+	pathType := gwv1.PathMatchPathPrefix
+	pathValue := "/"
+	if match.Path != nil {
+		if match.Path.Type != nil {
+			pathType = *match.Path.Type
+		}
+		if match.Path.Value != nil {
+			pathValue = *match.Path.Value
+		}
+	}
+	fmt.Fprintf(&sb, "%s %s", pathType, pathValue)
+	if match.Method != nil {
+		fmt.Fprintf(&sb, " method=%s", *match.Method)
+	}
+	if len(match.Headers) > 0 {
+		headers := make([]string, 0, len(match.Headers))
+		for _, h := range match.Headers {
+			matchType := gwv1.HeaderMatchExact
+			if h.Type != nil {
+				matchType = *h.Type
+			}
+			headers = append(headers, fmt.Sprintf("%s:%s=%s", matchType, strings.ToLower(string(h.Name)), h.Value))
+		}
+		sort.Strings(headers)
+		fmt.Fprintf(&sb, " headers=[%s]", strings.Join(headers, ","))
+	}
+	if len(match.QueryParams) > 0 {
+		params := make([]string, 0, len(match.QueryParams))
+		for _, q := range match.QueryParams {
+			matchType := gwv1.QueryParamMatchExact
+			if q.Type != nil {
+				matchType = *q.Type
+			}
+			params = append(params, fmt.Sprintf("%s:%s=%s", matchType, q.Name, q.Value))
+		}
+		sort.Strings(params)
+		fmt.Fprintf(&sb, " queryParams=[%s]", strings.Join(params, ","))
+	}
+	return sb.String()
+}
@@ -0,0 +1,51 @@
+package irtranslator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+func TestRouteMatchKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		match gwv1.HTTPRouteMatch
+		want  string
+	}{
+		{
+			name:  "empty match defaults to prefix /",
+			match: gwv1.HTTPRouteMatch{},
+			want:  "PathPrefix /",
+		},
+		{
+			name: "exact path with method",
+			match: gwv1.HTTPRouteMatch{
+				Path:   &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchExact), Value: ptr.To("/foo")},
+				Method: ptr.To(gwv1.HTTPMethodGet),
+			},
+			want: "Exact /foo method=GET",
+		},
+		{
+			name: "headers and query params are order independent",
+			match: gwv1.HTTPRouteMatch{
+				Path: &gwv1.HTTPPathMatch{Type: ptr.To(gwv1.PathMatchPathPrefix), Value: ptr.To("/")},
+				Headers: []gwv1.HTTPHeaderMatch{
+					{Name: "X-B", Value: "2"},
+					{Name: "x-a", Value: "1"},
+				},
+				QueryParams: []gwv1.HTTPQueryParamMatch{
+					{Type: ptr.To(gwv1.QueryParamMatchRegularExpression), Name: "q", Value: ".*"},
+				},
+			},
+			want: "PathPrefix / headers=[Exact:x-a=1,Exact:x-b=2] queryParams=[RegularExpression:q=.*]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RouteMatchKey(tt.match))
+		})
+	}
+}
@@ -1,10 +1,13 @@
 package irtranslator
 
 import (
+	"strings"
 	"testing"
 
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -89,3 +92,29 @@ func TestValidateWeightedClusters(t *testing.T) {
 		})
 	}
 }
+
+func TestMaxDirectResponseBodySize(t *testing.T) {
+	directResponseRoute := func(size int) *envoyroutev3.Route {
+		return &envoyroutev3.Route{
+			Action: &envoyroutev3.Route_DirectResponse{
+				DirectResponse: &envoyroutev3.DirectResponseAction{
+					Status: 200,
+					Body: &envoycorev3.DataSource{
+						Specifier: &envoycorev3.DataSource_InlineString{InlineString: strings.Repeat("a", size)},
+					},
+				},
+			},
+		}
+	}
+
+	small := []*envoyroutev3.VirtualHost{{Routes: []*envoyroutev3.Route{directResponseRoute(4096)}}}
+	assert.Nil(t, maxDirectResponseBodySize(small))
+
+	large := []*envoyroutev3.VirtualHost{
+		{Routes: []*envoyroutev3.Route{directResponseRoute(10), {}}},
+		{Routes: []*envoyroutev3.Route{directResponseRoute(8192), directResponseRoute(5000)}},
+	}
+	size := maxDirectResponseBodySize(large)
+	require.NotNil(t, size)
+	assert.Equal(t, uint32(8192), size.GetValue())
+}
@@ -1,6 +1,7 @@
 package listener
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwxv1a1 "sigs.k8s.io/gateway-api/apisx/v1alpha1"
 
@@ -32,8 +34,9 @@ import (
 var logger = logging.New("translator/listener")
 
 const (
-	TcpTlsListenerNoBackendsMessage = "TCP/TLS listener has no valid backends or routes"
-	ResourceNotFoundMessageTemplate = "%s %s/%s not found."
+	TcpTlsListenerNoBackendsMessage        = "TCP/TLS listener has no valid backends or routes"
+	ResourceNotFoundMessageTemplate        = "%s %s/%s not found."
+	OverlappingCertificatesMessageTemplate = "Multiple certificates match hostname(s) %s; the first matching certificate ref is served."
 )
 
 type ListenerTranslatorConfig struct {
@@ -345,12 +348,19 @@ func (ml *MergedListener) TranslateListener(
 		httpFilterChains = append(httpFilterChains, *httpsFilterChain)
 	}
 
+	// Hostnames of TLS listeners on this port, which take precedence over the matching route hostnames
+	// of the wildcard TLS listeners on the same port.
+	listenerSniDomains := sets.New[string]()
+	for _, tfc := range ml.TcpFilterChains {
+		if tfc.sniDomain != nil && !strings.HasPrefix(string(*tfc.sniDomain), "*") {
+			listenerSniDomains.Insert(string(*tfc.sniDomain))
+		}
+	}
+
 	// Translate TCP listeners (if any exist)
 	var matchedTcpListeners []ir.TcpIR
 	for _, tfc := range ml.TcpFilterChains {
-		if tcpListener := tfc.translateTcpFilterChain(kctx, ctx, queries, ml.name, reporter, ml.gateway.FrontendTLSConfig); tcpListener != nil {
-			matchedTcpListeners = append(matchedTcpListeners, *tcpListener)
-		}
+		matchedTcpListeners = append(matchedTcpListeners, tfc.translateTcpFilterChain(kctx, ctx, queries, ml.name, reporter, ml.gateway.FrontendTLSConfig, listenerSniDomains)...)
 	}
 
 	// Only report errors if ALL TCP filter chains failed (port is not programmed)
@@ -409,7 +419,8 @@ func (tc *tcpFilterChain) translateTcpFilterChain(
 	parentName string,
 	reporter reports.Reporter,
 	frontendTLSConfig *ir.FrontendTLSConfigIR,
-) *ir.TcpIR {
+	listenerSniDomains sets.Set[string],
+) []ir.TcpIR {
 	parent := tc.parents
 	if len(parent.routesWithHosts) == 0 {
 		return nil
@@ -429,6 +440,8 @@ func (tc *tcpFilterChain) translateTcpFilterChain(
 	})
 
 	switch r.Object.(type) {
+	case *ir.TlsRouteIR:
+		return tc.translateTlsRoutes(parentName, reporter, listenerSniDomains)
 	case *ir.TcpRouteIR:
 		tRoute := r.Object.(*ir.TcpRouteIR)
 
@@ -501,83 +514,162 @@ func (tc *tcpFilterChain) translateTcpFilterChain(
 			}
 		}
 
+		reportOverlappingCertificates(tlsConfig, tc.listenerReporter)
+
 		if tlsConfig != nil && len(tlsConfig.AlpnProtocols) == 0 {
 			tlsConfig.AlpnProtocols = []string{string(annotations.AllowEmptyAlpnProtocols)}
 		}
 
-		return &ir.TcpIR{
+		return []ir.TcpIR{{
 			FilterChainCommon: ir.FilterChainCommon{
 				FilterChainName: tcpHostName,
 				TLS:             tlsConfig,
 			},
-			BackendRefs: backends,
-		}
-	case *ir.TlsRouteIR:
-		tRoute := r.Object.(*ir.TlsRouteIR)
+			BackendRefs:      backends,
+			AttachedPolicies: tRoute.AttachedPolicies,
+		}}
+	default:
+		return nil
+	}
+}
 
-		var condition reports.RouteCondition
-		if len(tRoute.SourceObject.Spec.Rules) == 1 {
-			condition = reports.RouteCondition{
-				Type:   gwv1.RouteConditionAccepted,
-				Status: metav1.ConditionTrue,
-				Reason: gwv1.RouteReasonAccepted,
-			}
-		} else {
-			condition = reports.RouteCondition{
-				Type:   gwv1.RouteConditionAccepted,
-				Status: metav1.ConditionFalse,
-				Reason: gwv1.RouteReasonUnsupportedValue,
-			}
+// translateTlsRoutes builds the SNI forwarding table of a TLS passthrough listener, with a filter chain per
+// TLSRoute matching the route hostnames that intersect the listener hostname. Routes without hostnames match
+// the listener hostname, or all server names if the listener has none.
+// A server name is only forwarded by the oldest route matching it; the hostnames of the other listeners on
+// the same port are forwarded by those listeners instead. A route left without any server name to forward
+// is reported as not accepted with the HostnameConflict reason.
+func (tc *tcpFilterChain) translateTlsRoutes(
+	parentName string,
+	reporter reports.Reporter,
+	listenerSniDomains sets.Set[string],
+) []ir.TcpIR {
+	routes := slices.Clone(tc.parents.routesWithHosts)
+	slices.SortStableFunc(routes, func(a, b *query.RouteInfo) int {
+		return cmp.Or(
+			a.Object.GetSourceObject().GetCreationTimestamp().Compare(b.Object.GetSourceObject().GetCreationTimestamp().Time),
+			cmp.Compare(a.GetNamespace(), b.GetNamespace()),
+			cmp.Compare(a.GetName(), b.GetName()),
+		)
+	})
+
+	var listenerHostname string
+	if tc.sniDomain != nil {
+		listenerHostname = string(*tc.sniDomain)
+	}
+
+	claimed := sets.New[string]()
+	var out []ir.TcpIR
+	for _, r := range routes {
+		tRoute, ok := r.Object.(*ir.TlsRouteIR)
+		if !ok {
+			continue
 		}
-		if condition.Status != metav1.ConditionTrue {
-			return nil
+		if tcpIR := tc.translateTlsRoute(tRoute, r.Hostnames(), listenerHostname, parentName, reporter, listenerSniDomains, claimed); tcpIR != nil {
+			out = append(out, *tcpIR)
 		}
+	}
+	return out
+}
 
-		parentRefReporters := make([]reports.ParentRefReporter, 0, len(tRoute.ParentRefs))
-		for _, parentRef := range tRoute.ParentRefs {
-			parentRefReporter := reporter.Route(tRoute.SourceObject).ParentRef(&parentRef)
-			parentRefReporter.SetCondition(condition)
-			parentRefReporters = append(parentRefReporters, parentRefReporter)
+func (tc *tcpFilterChain) translateTlsRoute(
+	tRoute *ir.TlsRouteIR,
+	routeHostnames []string,
+	listenerHostname string,
+	parentName string,
+	reporter reports.Reporter,
+	listenerSniDomains sets.Set[string],
+	claimed sets.Set[string],
+) *ir.TcpIR {
+	var condition reports.RouteCondition
+	if len(tRoute.SourceObject.Spec.Rules) == 1 {
+		condition = reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionTrue,
+			Reason: gwv1.RouteReasonAccepted,
+		}
+	} else {
+		condition = reports.RouteCondition{
+			Type:   gwv1.RouteConditionAccepted,
+			Status: metav1.ConditionFalse,
+			Reason: gwv1.RouteReasonUnsupportedValue,
 		}
+	}
+	if condition.Status != metav1.ConditionTrue {
+		return nil
+	}
 
-		// Ensure unique names by appending the rule index to the TLSRoute name
-		tcpHostName := fmt.Sprintf("%s-%s.%s-rule-%d", parentName, tRoute.Namespace, tRoute.Name, 0)
-		var backends []ir.BackendRefIR
-		for _, backend := range tRoute.Backends {
-			// validate that we don't have an error:
-			if backend.Err != nil || backend.BackendObject == nil {
-				err := backend.Err
-				if err == nil {
-					err = errors.New("not found")
-				}
-				for _, parentRefReporter := range parentRefReporters {
-					query.ProcessBackendError(err, parentRefReporter)
-				}
-			}
-			// add backend even if we have errors, as according to spec, with multiple destinations,
-			// they should fail based of the weights.
-			backends = append(backends, backend)
+	sniDomains := routeHostnames
+	if len(sniDomains) == 0 {
+		// an empty server name matches all server names
+		sniDomains = []string{listenerHostname}
+	}
+	var unclaimed []string
+	for _, sni := range sniDomains {
+		if claimed.Has(sni) || (sni != listenerHostname && listenerSniDomains.Has(sni)) {
+			continue
 		}
-		// Avoid creating a TcpListener if there are no TcpHosts
-		if len(backends) == 0 {
-			return nil
+		unclaimed = append(unclaimed, sni)
+	}
+	if len(unclaimed) == 0 {
+		// all the server names of the route are forwarded by other routes or listeners
+		condition = reports.RouteCondition{
+			Type:    gwv1.RouteConditionAccepted,
+			Status:  metav1.ConditionFalse,
+			Reason:  gwv1.RouteConditionReason(reports.RouteHostnameConflictReason),
+			Message: "All hostnames of the route are forwarded by older routes or by other listeners on the same port",
 		}
+	}
 
-		var matcher ir.FilterChainMatch
-		if tc.sniDomain != nil {
-			matcher.SniDomains = []string{string(*tc.sniDomain)}
-		}
+	parentRefReporters := make([]reports.ParentRefReporter, 0, len(tRoute.ParentRefs))
+	for _, parentRef := range tRoute.ParentRefs {
+		parentRefReporter := reporter.Route(tRoute.SourceObject).ParentRef(&parentRef)
+		parentRefReporter.SetCondition(condition)
+		parentRefReporters = append(parentRefReporters, parentRefReporter)
+	}
+	if condition.Status != metav1.ConditionTrue {
+		return nil
+	}
 
-		return &ir.TcpIR{
-			FilterChainCommon: ir.FilterChainCommon{
-				FilterChainName: tcpHostName,
-				Matcher:         matcher,
-			},
-			BackendRefs: backends,
+	// Ensure unique names by appending the rule index to the TLSRoute name
+	tcpHostName := fmt.Sprintf("%s-%s.%s-rule-%d", parentName, tRoute.Namespace, tRoute.Name, 0)
+	var backends []ir.BackendRefIR
+	for _, backend := range tRoute.Backends {
+		// validate that we don't have an error:
+		if backend.Err != nil || backend.BackendObject == nil {
+			err := backend.Err
+			if err == nil {
+				err = errors.New("not found")
+			}
+			for _, parentRefReporter := range parentRefReporters {
+				query.ProcessBackendError(err, parentRefReporter)
+			}
 		}
-	default:
+		// add backend even if we have errors, as according to spec, with multiple destinations,
+		// they should fail based of the weights.
+		backends = append(backends, backend)
+	}
+	// Avoid creating a TcpListener if there are no TcpHosts
+	if len(backends) == 0 {
 		return nil
 	}
+
+	var matcher ir.FilterChainMatch
+	for _, sni := range unclaimed {
+		claimed.Insert(sni)
+		if sni != "" {
+			matcher.SniDomains = append(matcher.SniDomains, sni)
+		}
+	}
+
+	return &ir.TcpIR{
+		FilterChainCommon: ir.FilterChainCommon{
+			FilterChainName: tcpHostName,
+			Matcher:         matcher,
+		},
+		BackendRefs:      backends,
+		AttachedPolicies: tRoute.AttachedPolicies,
+	}
 }
 
 // httpFilterChain each one represents a GW Listener that has been merged into a single Listener (with distinct filter chains).
@@ -766,6 +858,7 @@ func (hfc *httpsFilterChain) translateHttpsFilterChain(
 			return nil, err
 		}
 	}
+	reportOverlappingCertificates(tlsConfig, hfc.listenerReporter)
 	sort.Slice(virtualHosts, func(i, j int) bool {
 		return virtualHosts[i].Name < virtualHosts[j].Name
 	})
@@ -948,8 +1041,9 @@ func translateTLSConfig(
 	return tlsConfig, caErr
 }
 
-// buildCaCertificateReference fetches and extracts a CA certificate from either a ConfigMap or Secret
-// referenced by the given ObjectReference. Returns the CA certificate data as a string.
+// buildCaCertificateReference fetches and extracts a CA certificate from a ConfigMap, Secret or trust bundle
+// referenced by the given ObjectReference. Returns the CA certificate data as a string, along with the
+// certificate revocation lists of the optional ca.crl key of ConfigMaps and Secrets.
 func buildCaCertificateReference(
 	kctx krt.HandlerContext,
 	ctx context.Context,
@@ -957,7 +1051,7 @@ func buildCaCertificateReference(
 	caCertRef gwv1.ObjectReference,
 	parentGVK schema.GroupVersionKind,
 	parentNamespace string,
-) (string, error) {
+) (string, string, error) {
 	switch {
 	case string(caCertRef.Group) == wellknown.ConfigMapGVK.Group && string(caCertRef.Kind) == wellknown.ConfigMapGVK.Kind:
 		// Fetch ConfigMap
@@ -971,18 +1065,22 @@ func buildCaCertificateReference(
 		if err != nil {
 			// If its a missing reference grant error, return the CA Certificate specific error
 			if errors.Is(err, krtcollections.ErrMissingReferenceGrant) {
-				return "", fmt.Errorf("failed to fetch CA certificate ConfigMap %s/%s: %w", caCertRef.Name, parentNamespace, sslutils.ErrMissingCaCertificateRefGrant)
+				return "", "", fmt.Errorf("failed to fetch CA certificate ConfigMap %s/%s: %w", caCertRef.Name, parentNamespace, sslutils.ErrMissingCaCertificateRefGrant)
 			}
 			// If its not a missing reference grant error, return the invalid certificate ref error
-			return "", fmt.Errorf("failed to fetch CA certificate ConfigMap %s/%s: %w", caCertRef.Name, parentNamespace, sslutils.ErrInvalidCACertificateRef)
+			return "", "", fmt.Errorf("failed to fetch CA certificate ConfigMap %s/%s: %w", caCertRef.Name, parentNamespace, sslutils.ErrInvalidCACertificateRef)
 		}
 
 		// Extract CA certificate from ConfigMap
 		caCertData, err := sslutils.GetCACertFromConfigMap(configMap)
 		if err != nil {
-			return "", fmt.Errorf("failed to extract CA certificate from ConfigMap %s/%s: %w", configMap.Namespace, configMap.Name, err)
+			return "", "", fmt.Errorf("failed to extract CA certificate from ConfigMap %s/%s: %w", configMap.Namespace, configMap.Name, err)
+		}
+		crl, err := sslutils.GetCRLFromConfigMap(configMap)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to extract CRL from ConfigMap %s/%s: %w", configMap.Namespace, configMap.Name, err)
 		}
-		return caCertData, nil
+		return caCertData, crl, nil
 
 	case string(caCertRef.Group) == wellknown.SecretGVK.Group && string(caCertRef.Kind) == wellknown.SecretGVK.Kind:
 		// Convert ObjectReference to SecretObjectReference
@@ -1007,19 +1105,30 @@ func buildCaCertificateReference(
 			secretObjRef,
 		)
 		if err != nil {
-			return "", fmt.Errorf("failed to fetch CA certificate Secret %s/%s: %w", caCertRef.Name, parentNamespace, err)
+			return "", "", fmt.Errorf("failed to fetch CA certificate Secret %s/%s: %w", caCertRef.Name, parentNamespace, err)
 		}
 
 		// Extract CA certificate from Secret
 		caCertData, err := sslutils.GetCACertFromSecret(secret)
 		if err != nil {
-			return "", fmt.Errorf("failed to extract CA certificate from Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+			return "", "", fmt.Errorf("failed to extract CA certificate from Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		crl, err := sslutils.GetCRLFromSecret(secret)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to extract CRL from Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+		}
+		return caCertData, crl, nil
+
+	case krtcollections.IsTrustBundleRef(string(caCertRef.Group), string(caCertRef.Kind)):
+		caCertData, err := queries.GetCACertForTrustBundleRef(kctx, ctx, parentNamespace, caCertRef)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to fetch CA certificate %s %s: %w: %w", caCertRef.Kind, caCertRef.Name, sslutils.ErrInvalidCACertificateRef, err)
 		}
-		return caCertData, nil
+		return caCertData, "", nil
 
 	// Should never happen as we validate the reference type in validateCAReferenceType
 	default:
-		return "", fmt.Errorf("unsupported CA certificate reference type: %s/%s", caCertRef.Group, caCertRef.Kind)
+		return "", "", fmt.Errorf("unsupported CA certificate reference type: %s/%s", caCertRef.Group, caCertRef.Kind)
 	}
 }
 
@@ -1052,9 +1161,10 @@ func applyClientCertificateValidation(
 		}
 	}
 
+	var crls [][]byte
 	var certErr error
 	for _, caCertRef := range validationConfig.CACertificateRefs {
-		caCertData, err := buildCaCertificateReference(
+		caCertData, crlData, err := buildCaCertificateReference(
 			kctx,
 			ctx,
 			queries,
@@ -1068,6 +1178,9 @@ func applyClientCertificateValidation(
 		}
 
 		caCertificates = append(caCertificates, []byte(caCertData))
+		if crlData != "" {
+			crls = append(crls, []byte(crlData))
+		}
 	}
 
 	// Only set ClientCertificateValidation if we successfully fetched at least one CA cert
@@ -1078,6 +1191,7 @@ func applyClientCertificateValidation(
 	// Set client certificate validation in TLS config
 	tlsConfig.ClientCertificateValidation = &ir.ClientCertificateValidation{
 		CACertificates:           caCertificates,
+		CRLs:                     crls,
 		RequireClientCertificate: validationConfig.RequireClientCertificate,
 	}
 
@@ -1149,6 +1263,33 @@ func reportTLSConfigError(err error, listenerReporter reports.ListenerReporter,
 	})
 }
 
+// reportOverlappingCertificates sets the OverlappingTLSConfig condition on a listener with several certificates
+// matching the same hostname equally well, as the certificate Envoy serves for it then only depends on their order.
+func reportOverlappingCertificates(tlsConfig *ir.TLSConfig, listenerReporter reports.ListenerReporter) {
+	if tlsConfig == nil || len(tlsConfig.Certificates) < 2 {
+		return
+	}
+	certs := make([]sslutils.CertificateInfo, 0, len(tlsConfig.Certificates))
+	for _, c := range tlsConfig.Certificates {
+		info, err := sslutils.ParseCertificateInfo(c.CertChain)
+		if err != nil {
+			// the certificates were validated when resolving the certificate refs
+			continue
+		}
+		certs = append(certs, info)
+	}
+	overlapping := sslutils.OverlappingCertificateHostnames(certs)
+	if len(overlapping) == 0 {
+		return
+	}
+	listenerReporter.SetCondition(reports.ListenerCondition{
+		Type:    gwv1.ListenerConditionOverlappingTLSConfig,
+		Status:  metav1.ConditionTrue,
+		Reason:  gwv1.ListenerReasonOverlappingCertificates,
+		Message: fmt.Sprintf(OverlappingCertificatesMessageTemplate, strings.Join(overlapping, ", ")),
+	})
+}
+
 // makeVhostName computes the name of a virtual host based on the parent name and domain.
 func makeVhostName(
 	ctx context.Context,
@@ -0,0 +1,156 @@
+package sslutils
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/util/cert"
+)
+
+// CertificateMatch ranks how well a certificate hostname matches a server name (SNI).
+// Envoy prefers a certificate matching the server name exactly over one matching it with a wildcard.
+type CertificateMatch int
+
+const (
+	CertificateMatchNone CertificateMatch = iota
+	CertificateMatchWildcard
+	CertificateMatchExact
+)
+
+// CertificateInfo describes the leaf certificate of a certificate chain, as used by Envoy to select the
+// certificate served for a server name.
+type CertificateInfo struct {
+	// Hostnames are the DNS SANs of the certificate, or its common name when it has no DNS SANs.
+	Hostnames []string `json:"hostnames"`
+	// KeyAlgorithm is the public key algorithm of the certificate. Envoy selects between certificates of different
+	// key algorithms matching the same server name based on what the client supports.
+	KeyAlgorithm string    `json:"keyAlgorithm"`
+	NotAfter     time.Time `json:"notAfter"`
+}
+
+// ParseCertificateInfo parses the leaf certificate of a PEM encoded certificate chain.
+func ParseCertificateInfo(certChain []byte) (CertificateInfo, error) {
+	certs, err := cert.ParseCertsPEM(certChain)
+	if err != nil {
+		return CertificateInfo{}, err
+	}
+	leaf := certs[0]
+	hostnames := leaf.DNSNames
+	if len(hostnames) == 0 && leaf.Subject.CommonName != "" {
+		hostnames = []string{leaf.Subject.CommonName}
+	}
+	return CertificateInfo{
+		Hostnames:    hostnames,
+		KeyAlgorithm: leaf.PublicKeyAlgorithm.String(),
+		NotAfter:     leaf.NotAfter,
+	}, nil
+}
+
+// Match returns the best match of the certificate hostnames for the server name.
+func (c CertificateInfo) Match(serverName string) CertificateMatch {
+	best := CertificateMatchNone
+	for _, h := range c.Hostnames {
+		best = max(best, MatchCertificateHostname(serverName, h))
+	}
+	return best
+}
+
+// MatchCertificateHostname returns how the certificate hostname matches the server name. A wildcard hostname only
+// matches a single label, so *.example.com matches foo.example.com but neither example.com nor a.b.example.com.
+// A wildcard server name, as configured on a listener, only matches the same wildcard hostname.
+func MatchCertificateHostname(serverName, hostname string) CertificateMatch {
+	serverName = strings.ToLower(serverName)
+	hostname = strings.ToLower(hostname)
+	if serverName == hostname {
+		return CertificateMatchExact
+	}
+	suffix, ok := strings.CutPrefix(hostname, "*")
+	if !ok || !strings.HasPrefix(suffix, ".") || strings.HasPrefix(serverName, "*") {
+		return CertificateMatchNone
+	}
+	label, ok := strings.CutSuffix(serverName, suffix)
+	if !ok || label == "" || strings.Contains(label, ".") {
+		return CertificateMatchNone
+	}
+	return CertificateMatchWildcard
+}
+
+// SelectCertificates returns the indexes of the certificates in the order Envoy prefers them for the server name:
+// certificates matching it exactly, then with a wildcard, then the ones that do not match it, which Envoy only
+// serves, starting with the first one, when no certificate matches.
+func SelectCertificates(serverName string, certs []CertificateInfo) []int {
+	order := make([]int, len(certs))
+	for i := range certs {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(certs[b].Match(serverName), certs[a].Match(serverName))
+	})
+	return order
+}
+
+// OverlappingCertificateHostnames returns the hostnames matched equally well by more than one certificate with the
+// same key algorithm, for which Envoy serves whichever of the certificates comes first.
+func OverlappingCertificateHostnames(certs []CertificateInfo) []string {
+	var overlapping []string
+	seen := map[string]bool{}
+	for _, c := range certs {
+		for _, h := range c.Hostnames {
+			h = strings.ToLower(h)
+			if seen[h] {
+				continue
+			}
+			seen[h] = true
+			if isOverlapping(h, certs) {
+				overlapping = append(overlapping, h)
+			}
+		}
+	}
+	slices.Sort(overlapping)
+	return overlapping
+}
+
+func isOverlapping(serverName string, certs []CertificateInfo) bool {
+	best := CertificateMatchNone
+	for _, c := range certs {
+		best = max(best, c.Match(serverName))
+	}
+	if best == CertificateMatchNone {
+		return false
+	}
+	byKeyAlgorithm := map[string]int{}
+	for _, c := range certs {
+		if c.Match(serverName) != best {
+			continue
+		}
+		byKeyAlgorithm[c.KeyAlgorithm]++
+		if byKeyAlgorithm[c.KeyAlgorithm] > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// CompareServerNames orders the server names of filter chains by the precedence Envoy uses to select the filter
+// chain of a connection: exact names first, then wildcard names from the most to the least specific, then the
+// empty server name of filter chains matching any name.
+func CompareServerNames(a, b string) int {
+	if c := cmp.Compare(serverNameRank(b), serverNameRank(a)); c != 0 {
+		return c
+	}
+	return cmp.Compare(a, b)
+}
+
+func serverNameRank(serverName string) int {
+	switch {
+	case serverName == "":
+		return 0
+	case strings.HasPrefix(serverName, "*"):
+		// longer wildcard suffixes are more specific
+		return len(serverName)
+	default:
+		return 1 << 16
+	}
+}
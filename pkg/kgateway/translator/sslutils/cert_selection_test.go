@@ -0,0 +1,91 @@
+package sslutils
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchCertificateHostname(t *testing.T) {
+	testCases := []struct {
+		serverName string
+		hostname   string
+		want       CertificateMatch
+	}{
+		{serverName: "foo.example.com", hostname: "foo.example.com", want: CertificateMatchExact},
+		{serverName: "Foo.Example.com", hostname: "foo.example.com", want: CertificateMatchExact},
+		{serverName: "foo.example.com", hostname: "*.example.com", want: CertificateMatchWildcard},
+		{serverName: "a.foo.example.com", hostname: "*.example.com", want: CertificateMatchNone},
+		{serverName: "example.com", hostname: "*.example.com", want: CertificateMatchNone},
+		{serverName: "*.example.com", hostname: "*.example.com", want: CertificateMatchExact},
+		{serverName: "*.example.com", hostname: "*.com", want: CertificateMatchNone},
+		{serverName: "foo.example.com", hostname: "bar.example.com", want: CertificateMatchNone},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.serverName+"/"+tc.hostname, func(t *testing.T) {
+			assert.Equal(t, tc.want, MatchCertificateHostname(tc.serverName, tc.hostname))
+		})
+	}
+}
+
+func TestSelectCertificates(t *testing.T) {
+	certs := []CertificateInfo{
+		{Hostnames: []string{"bar.example.com"}, KeyAlgorithm: "RSA"},
+		{Hostnames: []string{"*.example.com"}, KeyAlgorithm: "RSA"},
+		{Hostnames: []string{"foo.example.com"}, KeyAlgorithm: "RSA"},
+	}
+	assert.Equal(t, []int{2, 1, 0}, SelectCertificates("foo.example.com", certs))
+	assert.Equal(t, []int{1, 0, 2}, SelectCertificates("baz.example.com", certs))
+	assert.Equal(t, []int{0, 1, 2}, SelectCertificates("example.org", certs))
+}
+
+func TestOverlappingCertificateHostnames(t *testing.T) {
+	testCases := []struct {
+		name  string
+		certs []CertificateInfo
+		want  []string
+	}{
+		{
+			name: "exact takes precedence over wildcard",
+			certs: []CertificateInfo{
+				{Hostnames: []string{"*.example.com"}, KeyAlgorithm: "RSA"},
+				{Hostnames: []string{"foo.example.com"}, KeyAlgorithm: "RSA"},
+			},
+		},
+		{
+			name: "same hostname with different key algorithms",
+			certs: []CertificateInfo{
+				{Hostnames: []string{"foo.example.com"}, KeyAlgorithm: "RSA"},
+				{Hostnames: []string{"foo.example.com"}, KeyAlgorithm: "ECDSA"},
+			},
+		},
+		{
+			name: "same hostname with the same key algorithm",
+			certs: []CertificateInfo{
+				{Hostnames: []string{"foo.example.com", "bar.example.com"}, KeyAlgorithm: "RSA"},
+				{Hostnames: []string{"FOO.example.com"}, KeyAlgorithm: "RSA"},
+			},
+			want: []string{"foo.example.com"},
+		},
+		{
+			name: "same wildcard with the same key algorithm",
+			certs: []CertificateInfo{
+				{Hostnames: []string{"*.example.com"}, KeyAlgorithm: "ECDSA"},
+				{Hostnames: []string{"*.example.com", "example.com"}, KeyAlgorithm: "ECDSA"},
+			},
+			want: []string{"*.example.com"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, OverlappingCertificateHostnames(tc.certs))
+		})
+	}
+}
+
+func TestCompareServerNames(t *testing.T) {
+	names := []string{"", "*.com", "foo.example.com", "*.example.com", "bar.example.com"}
+	slices.SortFunc(names, CompareServerNames)
+	assert.Equal(t, []string{"bar.example.com", "foo.example.com", "*.example.com", "*.com", ""}, names)
+}
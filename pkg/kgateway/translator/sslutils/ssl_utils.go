@@ -1,8 +1,11 @@
 package sslutils
 
 import (
+	"bytes"
 	"crypto/tls"
+	"crypto/x509"
 	"encoding/hex"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"regexp"
@@ -10,6 +13,7 @@ import (
 	"unicode"
 
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/util/cert"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -25,6 +29,9 @@ const (
 	ListenerReasonNoValidCACertificate     gwv1.ListenerConditionReason = "NoValidCACertificate"
 )
 
+// CRLKey is the key of the certificate revocation lists in the ConfigMaps and Secrets holding CA certificates
+const CRLKey = "ca.crl"
+
 var (
 	ErrInvalidTlsSecret = errors.New("invalid TLS secret")
 
@@ -44,6 +51,10 @@ var (
 		return fmt.Errorf("invalid ca.crt in Secret %s/%s: %v", ns, n, err)
 	}
 
+	ErrInvalidCRL = func(n, ns string, err error) error {
+		return fmt.Errorf("invalid ca.crl in %s/%s: %v", ns, n, err)
+	}
+
 	ErrVerifySubjectAltNamesRequiresCA = errors.New("verify-subject-alt-names annotation requires a trusted CA to be configured")
 
 	// tlsProtocolMap maps TLS version strings to Envoy TLS protocol values
@@ -130,6 +141,67 @@ func GetCACertFromSecret(secret *ir.Secret) (string, error) {
 	return getCACertFromBytes(caCrtBytes, secret.Name, secret.Namespace)
 }
 
+// GetCRLFromConfigMap validates and extracts the optional ca.crl string from a ConfigMap.
+// An empty string is returned when the ConfigMap has no ca.crl key.
+func GetCRLFromConfigMap(cm *corev1.ConfigMap) (string, error) {
+	crl, ok := cm.Data[CRLKey]
+	if !ok {
+		return "", nil
+	}
+	return getCRLFromBytes([]byte(crl), cm.Name, cm.Namespace)
+}
+
+// GetCRLFromSecret validates and extracts the optional ca.crl string from an ir.Secret.
+// An empty string is returned when the Secret has no ca.crl key.
+func GetCRLFromSecret(secret *ir.Secret) (string, error) {
+	crl, ok := secret.Data[CRLKey]
+	if !ok {
+		return "", nil
+	}
+	return getCRLFromBytes(crl, secret.Name, secret.Namespace)
+}
+
+// getCRLFromBytes validates that the data is a list of PEM encoded certificate revocation lists
+func getCRLFromBytes(crlBytes []byte, name, namespace string) (string, error) {
+	rest := crlBytes
+	found := false
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "X509 CRL" {
+			return "", ErrInvalidCRL(name, namespace, fmt.Errorf("unexpected PEM block type %q", block.Type))
+		}
+		if _, err := x509.ParseRevocationList(block.Bytes); err != nil {
+			return "", ErrInvalidCRL(name, namespace, err)
+		}
+		found = true
+	}
+	if !found || len(bytes.TrimSpace(rest)) > 0 {
+		return "", ErrInvalidCRL(name, namespace, errors.New("no valid PEM encoded CRL found"))
+	}
+	return string(crlBytes), nil
+}
+
+// GetCACertFromTrustBundleConfigMap validates and extracts the CA certificates from a ConfigMap a trust-manager Bundle
+// is synced to. The ca.crt key is used if present, otherwise the ConfigMap must have a single key.
+func GetCACertFromTrustBundleConfigMap(cm *corev1.ConfigMap) (string, error) {
+	if _, ok := cm.Data["ca.crt"]; ok || len(cm.Data) != 1 {
+		return GetCACertFromConfigMap(cm)
+	}
+	for _, caCrt := range cm.Data {
+		return getCACertFromBytes([]byte(caCrt), cm.Name, cm.Namespace)
+	}
+	return "", ErrMissingCACertKey
+}
+
+// GetCACertFromClusterTrustBundle validates and extracts the CA certificates from a ClusterTrustBundle
+func GetCACertFromClusterTrustBundle(ctb *certificatesv1beta1.ClusterTrustBundle) (string, error) {
+	return getCACertFromBytes([]byte(ctb.Spec.TrustBundle), ctb.Name, "")
+}
+
 // getCACertFromBytes validates and extracts the ca.crt string from certificate bytes
 func getCACertFromBytes(caCrtBytes []byte, name, namespace string) (string, error) {
 	if len(caCrtBytes) == 0 {
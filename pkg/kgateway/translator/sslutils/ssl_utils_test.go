@@ -1,10 +1,21 @@
 package sslutils
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -234,3 +245,88 @@ func TestSplitFakeYamlArray(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCRL(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &key.PublicKey, key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+	crlDER, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+		RevokedCertificateEntries: []x509.RevocationListEntry{{
+			SerialNumber:   big.NewInt(2),
+			RevocationTime: time.Now(),
+		}},
+	}, ca, key)
+	require.NoError(t, err)
+	crlPEM := string(pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlDER}))
+
+	tests := []struct {
+		name      string
+		data      map[string]string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "no CRL",
+			data:     map[string]string{"ca.crt": "cert"},
+			expected: "",
+		},
+		{
+			name:     "valid CRL",
+			data:     map[string]string{CRLKey: crlPEM},
+			expected: crlPEM,
+		},
+		{
+			name:     "multiple CRLs",
+			data:     map[string]string{CRLKey: crlPEM + crlPEM},
+			expected: crlPEM + crlPEM,
+		},
+		{
+			name:      "not PEM",
+			data:      map[string]string{CRLKey: "not a crl"},
+			expectErr: true,
+		},
+		{
+			name:      "certificate instead of CRL",
+			data:      map[string]string{CRLKey: string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}))},
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crl, err := GetCRLFromConfigMap(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "default"},
+				Data:       tt.data,
+			})
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, crl)
+
+			secretData := map[string][]byte{}
+			for k, v := range tt.data {
+				secretData[k] = []byte(v)
+			}
+			crl, err = GetCRLFromSecret(&ir.Secret{Data: secretData})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, crl)
+		})
+	}
+}
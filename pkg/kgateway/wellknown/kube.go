@@ -3,6 +3,7 @@ package wellknown
 import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -21,4 +22,10 @@ var (
 	HorizontalPodAutoscalerGVK = autoscalingv2.SchemeGroupVersion.WithKind("HorizontalPodAutoscaler")
 	// VerticalPodAutoscaler is from the autoscaling.k8s.io API group (VPA custom resource)
 	VerticalPodAutoscalerGVK = schema.GroupVersionKind{Group: "autoscaling.k8s.io", Version: "v1", Kind: "VerticalPodAutoscaler"}
+
+	ClusterTrustBundleGVK = certificatesv1beta1.SchemeGroupVersion.WithKind("ClusterTrustBundle")
+	ClusterTrustBundleGVR = certificatesv1beta1.SchemeGroupVersion.WithResource("clustertrustbundles")
+	// TrustManagerBundleGVK is the trust-manager (cert-manager project) Bundle, which is synced to a ConfigMap
+	// with the same name in the target namespaces
+	TrustManagerBundleGVK = schema.GroupVersionKind{Group: "trust.cert-manager.io", Version: "v1alpha1", Kind: "Bundle"}
 )
@@ -16,6 +16,11 @@ var DefaultXdsPort uint32 = 9977
 // - the `controller.service.ports.grpc2` value in install/helm/kgateway/values.yaml
 var DefaultAgwXdsPort uint32 = 9978
 
+// DefaultWasmPort is the default port serving the Wasm modules pulled from OCI images. This value should stay in sync with:
+// - the default value of `WasmServicePort` in api/settings/settings.go
+// - the `controller.service.ports.wasm` value in install/helm/kgateway/values.yaml
+var DefaultWasmPort uint32 = 9979
+
 // EnvoyAdminPort is the default envoy admin port
 var EnvoyAdminPort uint32 = 19000
 
@@ -0,0 +1,164 @@
+package xds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	envoycachetypes "github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/log"
+)
+
+// pinnedKeyPrefix is the prefix of the cache keys used to serve pinned nodes
+const pinnedKeyPrefix = "pinned"
+
+// PinnedCacheKey returns the cache key used to serve the node with the given ID once it has been pinned.
+func PinnedCacheKey(nodeID string) string {
+	return pinnedKeyPrefix + KeyDelimiter + nodeID
+}
+
+// SnapshotPin describes a node that is pinned to a frozen snapshot.
+type SnapshotPin struct {
+	// NodeID is the Envoy node ID (node.id) of the pinned node.
+	NodeID string `json:"nodeId"`
+	// Key is the cache key the frozen snapshot was copied from.
+	Key string `json:"key"`
+	// Versions are the versions of the frozen snapshot, keyed by TypeUrl.
+	Versions map[string]string `json:"versions"`
+}
+
+type snapshotPin struct {
+	SnapshotPin
+	// frozen is false once the node is unpinned, in which case the node keeps being served from
+	// its pinned cache key, which follows the snapshot of the original key again. This ensures
+	// watches that are already open on the pinned cache key are answered.
+	frozen bool
+}
+
+// snapshotPins is the set of pinned nodes, shared between the cache and its node hasher.
+type snapshotPins struct {
+	mu   sync.RWMutex
+	pins map[string]*snapshotPin
+}
+
+func (p *snapshotPins) has(nodeID string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	_, ok := p.pins[nodeID]
+	return ok
+}
+
+// PinnedSnapshotCache is an xDS snapshot cache that allows pinning individual data-plane nodes to a
+// frozen snapshot, e.g. to hold back one replica of a Gateway on a known-good configuration while
+// evaluating a change on the other replicas.
+//
+// Pinned nodes are served from their own cache key, see PinnedCacheKey. Pinning takes effect for
+// the next xDS request of the node; nodes should therefore be pinned before the change is applied.
+type PinnedSnapshotCache struct {
+	cache.SnapshotCache
+	pins *snapshotPins
+}
+
+// NewPinnedSnapshotCache returns a snapshot cache that identifies nodes by their role, unless they are pinned.
+func NewPinnedSnapshotCache(ads bool, logger log.Logger) *PinnedSnapshotCache {
+	pins := &snapshotPins{pins: map[string]*snapshotPin{}}
+	return &PinnedSnapshotCache{
+		SnapshotCache: cache.NewSnapshotCache(ads, &nodeRoleHasher{pins: pins}, logger),
+		pins:          pins,
+	}
+}
+
+// SetSnapshot updates the snapshot for the given key, as well as for the nodes that were unpinned
+// from it.
+func (c *PinnedSnapshotCache) SetSnapshot(ctx context.Context, key string, snapshot cache.ResourceSnapshot) error {
+	if err := c.SnapshotCache.SetSnapshot(ctx, key, snapshot); err != nil {
+		return err
+	}
+	c.pins.mu.RLock()
+	var following []string
+	for nodeID, pin := range c.pins.pins {
+		if !pin.frozen && pin.Key == key {
+			following = append(following, nodeID)
+		}
+	}
+	c.pins.mu.RUnlock()
+	for _, nodeID := range following {
+		if err := c.SnapshotCache.SetSnapshot(ctx, PinnedCacheKey(nodeID), snapshot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Pin freezes the node with the given ID on the current snapshot of the given cache key.
+// Pinning an already pinned node replaces its frozen snapshot.
+func (c *PinnedSnapshotCache) Pin(ctx context.Context, nodeID, key string) (SnapshotPin, error) {
+	snapshot, err := c.SnapshotCache.GetSnapshot(key)
+	if err != nil {
+		return SnapshotPin{}, fmt.Errorf("failed to get snapshot %s: %w", key, err)
+	}
+	// the snapshot must be in place before the node hasher starts returning the pinned key
+	if err := c.SnapshotCache.SetSnapshot(ctx, PinnedCacheKey(nodeID), snapshot); err != nil {
+		return SnapshotPin{}, err
+	}
+
+	pin := SnapshotPin{
+		NodeID:   nodeID,
+		Key:      key,
+		Versions: map[string]string{},
+	}
+	for i := range envoycachetypes.UnknownType {
+		typeURL, err := cache.GetResponseTypeURL(i)
+		if err != nil {
+			continue
+		}
+		if v := snapshot.GetVersion(typeURL); v != "" {
+			pin.Versions[typeURL] = v
+		}
+	}
+
+	c.pins.mu.Lock()
+	defer c.pins.mu.Unlock()
+	c.pins.pins[nodeID] = &snapshotPin{SnapshotPin: pin, frozen: true}
+	return pin, nil
+}
+
+// Unpin releases the node with the given ID, which is then served the latest snapshot of the cache
+// key it was pinned from. It returns false if the node is not pinned.
+func (c *PinnedSnapshotCache) Unpin(ctx context.Context, nodeID string) (bool, error) {
+	c.pins.mu.Lock()
+	pin, ok := c.pins.pins[nodeID]
+	wasFrozen := ok && pin.frozen
+	if ok {
+		pin.frozen = false
+	}
+	c.pins.mu.Unlock()
+	if !wasFrozen {
+		return false, nil
+	}
+
+	snapshot, err := c.SnapshotCache.GetSnapshot(pin.Key)
+	if err != nil {
+		// the original key no longer exists; the node will be updated once it is set again
+		return true, nil
+	}
+	return true, c.SnapshotCache.SetSnapshot(ctx, PinnedCacheKey(nodeID), snapshot)
+}
+
+// Pins returns the nodes that are currently pinned, sorted by node ID.
+func (c *PinnedSnapshotCache) Pins() []SnapshotPin {
+	c.pins.mu.RLock()
+	defer c.pins.mu.RUnlock()
+	out := make([]SnapshotPin, 0, len(c.pins.pins))
+	for _, pin := range c.pins.pins {
+		if pin.frozen {
+			out = append(out, pin.SnapshotPin)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].NodeID < out[j].NodeID
+	})
+	return out
+}
@@ -0,0 +1,73 @@
+package xds
+
+import (
+	"context"
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoycorev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestPinnedSnapshotCache(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+
+	const key = "kgateway-kube-gateway-api~default~gw~123~default"
+	const nodeID = "gw-abc.default"
+	snapshot := func(version string) *cache.Snapshot {
+		snap, err := cache.NewSnapshot(version, map[resource.Type][]types.Resource{
+			resource.ClusterType: {&envoyclusterv3.Cluster{Name: "cluster-" + version}},
+		})
+		r.NoError(err)
+		return snap
+	}
+	clusterVersion := func(c *PinnedSnapshotCache, key string) string {
+		snap, err := c.GetSnapshot(key)
+		r.NoError(err)
+		return snap.GetVersion(resource.ClusterType)
+	}
+
+	c := NewPinnedSnapshotCache(true, nil)
+	hasher := &nodeRoleHasher{pins: c.pins}
+	node := &envoycorev3.Node{
+		Id: nodeID,
+		Metadata: &structpb.Struct{Fields: map[string]*structpb.Value{
+			RoleKey: structpb.NewStringValue(key),
+		}},
+	}
+	r.Equal(key, hasher.ID(node))
+
+	_, err := c.Pin(ctx, nodeID, "missing")
+	r.Error(err)
+
+	r.NoError(c.SetSnapshot(ctx, key, snapshot("v1")))
+	pin, err := c.Pin(ctx, nodeID, key)
+	r.NoError(err)
+	r.Equal(SnapshotPin{NodeID: nodeID, Key: key, Versions: map[string]string{resource.ClusterType: "v1"}}, pin)
+	r.Equal([]SnapshotPin{pin}, c.Pins())
+	r.Equal(PinnedCacheKey(nodeID), hasher.ID(node))
+
+	// the pinned node is held back while the other nodes are updated
+	r.NoError(c.SetSnapshot(ctx, key, snapshot("v2")))
+	r.Equal("v2", clusterVersion(c, key))
+	r.Equal("v1", clusterVersion(c, PinnedCacheKey(nodeID)))
+
+	unpinned, err := c.Unpin(ctx, nodeID)
+	r.NoError(err)
+	r.True(unpinned)
+	r.Empty(c.Pins())
+	r.Equal("v2", clusterVersion(c, PinnedCacheKey(nodeID)))
+
+	// once unpinned, the node follows the original key again
+	r.NoError(c.SetSnapshot(ctx, key, snapshot("v3")))
+	r.Equal("v3", clusterVersion(c, PinnedCacheKey(nodeID)))
+
+	unpinned, err = c.Unpin(ctx, nodeID)
+	r.NoError(err)
+	r.False(unpinned)
+}
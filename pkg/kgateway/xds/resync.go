@@ -0,0 +1,36 @@
+package xds
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync/atomic"
+
+	cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+)
+
+// resyncCounter makes the versions of each forced resync unique
+var resyncCounter atomic.Uint64
+
+// Resync forces a full push of the snapshot of the given cache key to all the nodes it serves, by
+// setting it again with new versions for every type. The resources themselves are unchanged.
+// The next snapshot set for the key by the translation restores the regular versions, which
+// results in one more push.
+func Resync(ctx context.Context, c cache.SnapshotCache, key string) error {
+	snap, err := c.GetSnapshot(key)
+	if err != nil {
+		return fmt.Errorf("failed to get snapshot %s: %w", key, err)
+	}
+	current, ok := snap.(*cache.Snapshot)
+	if !ok {
+		return fmt.Errorf("invalid snapshot type; expected *cache.Snapshot, got %T", snap)
+	}
+
+	suffix := "-resync-" + strconv.FormatUint(resyncCounter.Add(1), 10)
+	// Resources is an array, so this copies the per-type versions; the resources are shared
+	resynced := &cache.Snapshot{Resources: current.Resources}
+	for i := range resynced.Resources {
+		resynced.Resources[i].Version += suffix
+	}
+	return c.SetSnapshot(ctx, key, resynced)
+}
@@ -0,0 +1,39 @@
+package xds
+
+import (
+	"context"
+	"testing"
+
+	envoyclusterv3 "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResync(t *testing.T) {
+	r := require.New(t)
+	ctx := context.Background()
+
+	const key = "kgateway-kube-gateway-api~default~gw"
+	c := cache.NewSnapshotCache(true, cache.IDHash{}, nil)
+	r.Error(Resync(ctx, c, key))
+
+	snap, err := cache.NewSnapshot("v1", map[resource.Type][]types.Resource{
+		resource.ClusterType: {&envoyclusterv3.Cluster{Name: "cluster"}},
+	})
+	r.NoError(err)
+	r.NoError(c.SetSnapshot(ctx, key, snap))
+
+	r.NoError(Resync(ctx, c, key))
+	first, err := c.GetSnapshot(key)
+	r.NoError(err)
+	r.NotEqual("v1", first.GetVersion(resource.ClusterType))
+	r.Equal(snap.GetResources(resource.ClusterType), first.GetResources(resource.ClusterType))
+	r.Equal("v1", snap.GetVersion(resource.ClusterType), "the original snapshot must not be modified")
+
+	r.NoError(Resync(ctx, c, key))
+	second, err := c.GetSnapshot(key)
+	r.NoError(err)
+	r.NotEqual(first.GetVersion(resource.ClusterType), second.GetVersion(resource.ClusterType))
+}
@@ -61,13 +61,20 @@ func NewNodeRoleHasher() *nodeRoleHasher {
 }
 
 // nodeRoleHasher identifies a node based on the values provided in the `node.metadata.role`
-type nodeRoleHasher struct{}
+type nodeRoleHasher struct {
+	// pins are the nodes served from their own cache key, if any
+	pins *snapshotPins
+}
 
 // ID returns the string value of the xDS cache key
 // This value must match role metadata format: <owner>~<proxy_namespace>~<proxy_name>
 // which is equal to role defined on proxy-deployment ConfigMap:
 // kgateway-kube-gateway-api~{{ $gateway.gatewayNamespace }}-{{ $gateway.gatewayName | default (include "kgateway.gateway.fullname" .) }}
 func (h *nodeRoleHasher) ID(node *envoycorev3.Node) string {
+	if h.pins != nil && h.pins.has(node.GetId()) {
+		return PinnedCacheKey(node.GetId())
+	}
+
 	if node.GetMetadata() != nil {
 		roleValue := node.GetMetadata().GetFields()[RoleKey]
 		if roleValue != nil {
@@ -96,6 +103,25 @@ func AgentgatewayID(node *envoycorev3.Node) types.NamespacedName {
 	return types.NamespacedName{}
 }
 
+// GatewayID returns the Gateway an Envoy node is serving, from the role in its metadata.
+// The role is formatted as <owner>~<namespace>~<name>, where the name may be suffixed by ~<hash>~<namespace>
+// when the proxy is pinned to a locality.
+func GatewayID(node *envoycorev3.Node) (types.NamespacedName, bool) {
+	role := node.GetMetadata().GetFields()[RoleKey].GetStringValue()
+	parts := strings.SplitN(role, KeyDelimiter, 3)
+	if len(parts) != 3 {
+		return types.NamespacedName{}, false
+	}
+	name := parts[2]
+	if localityParts := strings.SplitN(name, KeyDelimiter, 3); len(localityParts) == 3 {
+		name = localityParts[0]
+	}
+	return types.NamespacedName{
+		Namespace: parts[1],
+		Name:      name,
+	}, true
+}
+
 func CloneSnap(snap *cache.Snapshot) *cache.Snapshot {
 	s := &cache.Snapshot{}
 	for k, v := range snap.Resources {
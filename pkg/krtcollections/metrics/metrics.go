@@ -90,6 +90,14 @@ var (
 		Name:      "updates_dropped_total",
 		Help:      "Total number of resources metrics updates dropped. If this metric is ever greater than 0, all resources subsystem metrics should be considered invalid until process restart",
 	}, nil)
+	policiesTargetPossibleRenames = metrics.NewGauge(
+		metrics.GaugeOpts{
+			Subsystem: resourcesSubsystem,
+			Name:      "policies_target_possible_renames",
+			Help:      "Current number of policies with a targetRef that resolves to nothing while their targetSelectors match another object of the same kind, suggesting the target was renamed",
+		},
+		[]string{resourceLabel},
+	)
 )
 
 type resourceMetricLabels struct {
@@ -634,6 +642,11 @@ func GetResourceMetricEventHandler[T any]() func(krt.Event[T]) {
 	}
 }
 
+// SetPolicyTargetPossibleRenames sets the number of policies of the given kind whose target was possibly renamed.
+func SetPolicyTargetPossibleRenames(resource string, count int) {
+	policiesTargetPossibleRenames.Set(float64(count), metrics.Label{Name: resourceLabel, Value: resource})
+}
+
 // ResetMetrics resets the metrics from this package.
 // This is provided for testing purposes only.
 func ResetMetrics() {
@@ -644,6 +657,7 @@ func ResetMetrics() {
 	resourcesStatusSyncsCompletedTotal.Reset()
 	resourcesStatusSyncDuration.Reset()
 	resourcesUpdatesDroppedTotal.Reset()
+	policiesTargetPossibleRenames.Reset()
 
 	startTimes.Lock()
 	defer startTimes.Unlock()
@@ -1,6 +1,7 @@
 package krtcollections
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"strings"
@@ -870,7 +871,7 @@ func (p *PolicyIndex) getTargetingPoliciesMaybeForBackends(
 
 	slices.SortFunc(ret, func(a, b ir.PolicyAtt) int {
 		// Sort policies by their PrecedenceWeight for the same kind if the weights are different,
-		// otherwise sort by creation time
+		// otherwise sort by creation time, and then by namespace and name
 		if a.GroupKind == b.GroupKind {
 			if a.PrecedenceWeight > b.PrecedenceWeight {
 				return -1
@@ -878,7 +879,14 @@ func (p *PolicyIndex) getTargetingPoliciesMaybeForBackends(
 				return 1
 			}
 		}
-		return a.PolicyIr.CreationTime().Compare(b.PolicyIr.CreationTime())
+		if c := a.PolicyIr.CreationTime().Compare(b.PolicyIr.CreationTime()); c != 0 {
+			return c
+		}
+		// use the namespace and name as a tiebreaker, so the order is deterministic
+		return cmp.Or(
+			strings.Compare(a.PolicyRef.Namespace, b.PolicyRef.Namespace),
+			strings.Compare(a.PolicyRef.Name, b.PolicyRef.Name),
+		)
 	})
 	return ret
 }
@@ -1654,7 +1662,7 @@ func getInheritedPolicyPriority(annotations map[string]string) apiannotations.In
 	}
 }
 
-// validateCAReferenceType validates that a CA certificate reference is a ConfigMap or Secret.
+// validateCAReferenceType validates that a CA certificate reference is a ConfigMap, Secret or trust bundle.
 // Returns an error if the reference type is unsupported.
 func validateCAReferenceType(ref gwv1.ObjectReference) error {
 	// Normalize group - empty group means "core" API group
@@ -1678,6 +1686,9 @@ func validateCAReferenceType(ref gwv1.ObjectReference) error {
 	if gvk.Group == wellknown.SecretGVK.Group && gvk.Kind == wellknown.SecretGVK.Kind {
 		return nil
 	}
+	if IsTrustBundleRef(gvk.Group, gvk.Kind) {
+		return nil
+	}
 
 	return sslutils.ErrInvalidCACertificateKindDetails(string(ref.Name), strOr(ref.Namespace, ""), string(ref.Kind))
 }
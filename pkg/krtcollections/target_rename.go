@@ -0,0 +1,184 @@
+package krtcollections
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"istio.io/istio/pkg/config/labels"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/krtcollections/metrics"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/reporter"
+	"github.com/kgateway-dev/kgateway/v2/pkg/reports"
+)
+
+// TargetRename describes a targetRef of a policy that resolves to nothing while the targetSelectors of the
+// policy match other objects of the same kind. Kubernetes objects cannot be renamed: renaming a Gateway or a
+// route deletes it and creates a new object, which silently detaches the policies referencing it by name.
+type TargetRename struct {
+	// TargetRef is the targetRef that resolves to nothing.
+	TargetRef ir.PolicyRef
+	// Candidates are the names of the objects the target was possibly renamed to.
+	Candidates []string
+}
+
+var renameTargetRouteKinds = []schema.GroupKind{
+	wellknown.HTTPRouteGVK.GroupKind(),
+	wellknown.GRPCRouteGVK.GroupKind(),
+	wellknown.TCPRouteGVK.GroupKind(),
+	wellknown.TLSRouteGVK.GroupKind(),
+}
+
+// PossibleTargetRenames returns the targetRefs of the policy that resolve to nothing while a targetSelector of
+// the policy matches another object of the same kind in the policy namespace.
+func PossibleTargetRenames(
+	kctx krt.HandlerContext,
+	gateways *GatewayIndex,
+	routes *RoutesIndex,
+	policy ir.PolicyWrapper,
+) []TargetRename {
+	var renames []TargetRename
+	for _, ref := range policy.TargetRefs {
+		if ref.Name == "" {
+			// targetSelector
+			continue
+		}
+		gk := schema.GroupKind{Group: ref.Group, Kind: ref.Kind}
+
+		var exists func() bool
+		var selected func(matchLabels map[string]string) []string
+		switch {
+		case gk == wellknown.GatewayGVK.GroupKind():
+			exists = func() bool {
+				return gateways.fetch(kctx, policy.Namespace, ref.Name) != nil
+			}
+			selected = func(matchLabels map[string]string) []string {
+				return gateways.namesByLabels(kctx, policy.Namespace, matchLabels)
+			}
+		case slices.Contains(renameTargetRouteKinds, gk):
+			exists = func() bool {
+				return routes.Fetch(kctx, gk, policy.Namespace, ref.Name) != nil
+			}
+			selected = func(matchLabels map[string]string) []string {
+				return routes.namesByLabels(kctx, gk, policy.Namespace, matchLabels)
+			}
+		default:
+			continue
+		}
+
+		var candidates []string
+		for _, sel := range policy.TargetRefs {
+			if sel.Name != "" || sel.Group != ref.Group || sel.Kind != ref.Kind || len(sel.MatchLabels) == 0 {
+				continue
+			}
+			for _, name := range selected(sel.MatchLabels) {
+				if !slices.Contains(candidates, name) {
+					candidates = append(candidates, name)
+				}
+			}
+		}
+		if len(candidates) == 0 || exists() {
+			continue
+		}
+		slices.Sort(candidates)
+		renames = append(renames, TargetRename{TargetRef: ref, Candidates: candidates})
+	}
+	return renames
+}
+
+// ReportPossibleTargetRenames reports a TargetNotFound condition for each targetRef of the given policies of
+// the kind that was possibly renamed, using the missing target as the ancestor, and records the number of
+// affected policies.
+func ReportPossibleTargetRenames(
+	kctx krt.HandlerContext,
+	gk schema.GroupKind,
+	policies krt.Collection[ir.PolicyWrapper],
+	gateways *GatewayIndex,
+	routes *RoutesIndex,
+	reportMap *reports.ReportMap,
+) {
+	rp := reports.NewReporter(reportMap)
+	count := 0
+	for _, policy := range krt.Fetch(kctx, policies) {
+		renames := PossibleTargetRenames(kctx, gateways, routes, policy)
+		if len(renames) == 0 {
+			continue
+		}
+		count++
+
+		var generation int64
+		if policy.Policy != nil {
+			generation = policy.Policy.GetGeneration()
+		}
+		pr := rp.Policy(reporter.PolicyKey{
+			Group:     gk.Group,
+			Kind:      gk.Kind,
+			Namespace: policy.Namespace,
+			Name:      policy.Name,
+		}, generation)
+		for _, rename := range renames {
+			r := pr.AncestorRef(gwv1.ParentReference{
+				Group:     ptr.To(gwv1.Group(rename.TargetRef.Group)),
+				Kind:      ptr.To(gwv1.Kind(rename.TargetRef.Kind)),
+				Namespace: ptr.To(gwv1.Namespace(policy.Namespace)),
+				Name:      gwv1.ObjectName(rename.TargetRef.Name),
+			})
+			r.SetCondition(reporter.PolicyCondition{
+				Type:    string(shared.PolicyConditionAttached),
+				Status:  metav1.ConditionFalse,
+				Reason:  string(shared.PolicyReasonTargetNotFound),
+				Message: possibleRenameMessage(policy.Namespace, rename),
+			})
+		}
+	}
+	metrics.SetPolicyTargetPossibleRenames(gk.Kind, count)
+}
+
+func possibleRenameMessage(namespace string, rename TargetRename) string {
+	return fmt.Sprintf("%s %s/%s not found (possible rename to %s matched by targetSelectors)",
+		rename.TargetRef.Kind, namespace, rename.TargetRef.Name, strings.Join(rename.Candidates, ", "))
+}
+
+func (h *GatewayIndex) fetch(kctx krt.HandlerContext, ns, name string) *ir.Gateway {
+	src := ir.ObjectSource{
+		Group:     wellknown.GatewayGVK.Group,
+		Kind:      wellknown.GatewayGVK.Kind,
+		Namespace: ns,
+		Name:      name,
+	}
+	return krt.FetchOne(kctx, h.Gateways, krt.FilterKey(src.ResourceName()))
+}
+
+func (h *GatewayIndex) namesByLabels(kctx krt.HandlerContext, ns string, matchLabels map[string]string) []string {
+	gws := krt.Fetch(kctx, h.Gateways, krt.FilterGeneric(func(a any) bool {
+		gw := a.(ir.Gateway)
+		return gw.Namespace == ns && gw.Obj != nil && labels.Instance(matchLabels).SubsetOf(gw.Obj.GetLabels())
+	}))
+	names := make([]string, 0, len(gws))
+	for _, gw := range gws {
+		names = append(names, gw.Name)
+	}
+	return names
+}
+
+func (h *RoutesIndex) namesByLabels(kctx krt.HandlerContext, gk schema.GroupKind, ns string, matchLabels map[string]string) []string {
+	rts := krt.Fetch(kctx, h.routes, krt.FilterGeneric(func(a any) bool {
+		rt := a.(RouteWrapper).Route
+		src := rt.GetSourceObject()
+		return rt.GetGroupKind() == gk && rt.GetNamespace() == ns && src != nil &&
+			labels.Instance(matchLabels).SubsetOf(src.GetLabels())
+	}))
+	names := make([]string, 0, len(rts))
+	for _, rt := range rts {
+		names = append(names, rt.Route.GetName())
+	}
+	return names
+}
@@ -0,0 +1,105 @@
+package krtcollections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+func TestPossibleTargetRenames(t *testing.T) {
+	route := func(name string, labels map[string]string) *gwv1.HTTPRoute {
+		return &gwv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    labels,
+			},
+		}
+	}
+	ref := func(name string) ir.PolicyRef {
+		return ir.PolicyRef{
+			Group: wellknown.HTTPRouteGVK.Group,
+			Kind:  wellknown.HTTPRouteGVK.Kind,
+			Name:  name,
+		}
+	}
+	selector := func(kind string, labels map[string]string) ir.PolicyRef {
+		return ir.PolicyRef{
+			Group:       wellknown.GatewayGVK.Group,
+			Kind:        kind,
+			MatchLabels: labels,
+		}
+	}
+
+	rtidx := preRouteIndex(t, []any{
+		route("reviews-v2", map[string]string{"app": "reviews"}),
+		route("ratings", map[string]string{"app": "ratings"}),
+	})
+
+	tests := []struct {
+		name       string
+		targetRefs []ir.PolicyRef
+		want       []TargetRename
+	}{
+		{
+			name: "missing target with a selector matching another route",
+			targetRefs: []ir.PolicyRef{
+				ref("reviews"),
+				selector(wellknown.HTTPRouteKind, map[string]string{"app": "reviews"}),
+			},
+			want: []TargetRename{{
+				TargetRef:  ref("reviews"),
+				Candidates: []string{"reviews-v2"},
+			}},
+		},
+		{
+			name: "existing target is not renamed",
+			targetRefs: []ir.PolicyRef{
+				ref("ratings"),
+				selector(wellknown.HTTPRouteKind, map[string]string{"app": "reviews"}),
+			},
+		},
+		{
+			name: "missing target without selectors",
+			targetRefs: []ir.PolicyRef{
+				ref("reviews"),
+			},
+		},
+		{
+			name: "missing target with a selector matching nothing",
+			targetRefs: []ir.PolicyRef{
+				ref("reviews"),
+				selector(wellknown.HTTPRouteKind, map[string]string{"app": "details"}),
+			},
+		},
+		{
+			name: "missing target with a selector for another kind",
+			targetRefs: []ir.PolicyRef{
+				ref("reviews"),
+				selector(wellknown.GatewayKind, map[string]string{"app": "reviews"}),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := ir.PolicyWrapper{
+				ObjectSource: ir.ObjectSource{
+					Group:     wellknown.TrafficPolicyGVK.Group,
+					Kind:      wellknown.TrafficPolicyGVK.Kind,
+					Namespace: "default",
+					Name:      "policy",
+				},
+				TargetRefs: tt.targetRefs,
+			}
+			got := PossibleTargetRenames(krt.TestingDummyContext{}, nil, rtidx, policy)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package krtcollections
+
+import (
+	"errors"
+	"fmt"
+
+	"istio.io/istio/pkg/kube/krt"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/translator/sslutils"
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+	"github.com/kgateway-dev/kgateway/v2/pkg/pluginsdk/ir"
+)
+
+var ErrClusterTrustBundlesDisabled = errors.New("ClusterTrustBundles are not enabled; set KGW_ENABLE_CLUSTER_TRUST_BUNDLES to reference them")
+
+// TrustBundleIndex resolves CA certificates from trust bundles: Kubernetes ClusterTrustBundles, and trust-manager
+// Bundles through the ConfigMap trust-manager syncs each Bundle to in the target namespaces.
+// As both are watched through krt collections, CA rotations are picked up like Secret and ConfigMap updates.
+type TrustBundleIndex struct {
+	// clusterTrustBundles is nil if the ClusterTrustBundle API is not enabled
+	clusterTrustBundles krt.Collection[*certificatesv1beta1.ClusterTrustBundle]
+	configmaps          krt.Collection[*corev1.ConfigMap]
+}
+
+func NewTrustBundleIndex(
+	clusterTrustBundles krt.Collection[*certificatesv1beta1.ClusterTrustBundle],
+	configmaps krt.Collection[*corev1.ConfigMap],
+) *TrustBundleIndex {
+	return &TrustBundleIndex{clusterTrustBundles: clusterTrustBundles, configmaps: configmaps}
+}
+
+func (t *TrustBundleIndex) HasSynced() bool {
+	if t.clusterTrustBundles != nil && !t.clusterTrustBundles.HasSynced() {
+		return false
+	}
+	return t.configmaps.HasSynced()
+}
+
+// IsTrustBundleRef returns true if the CA certificate reference is a ClusterTrustBundle or a trust-manager Bundle.
+func IsTrustBundleRef(group, kind string) bool {
+	return (group == wellknown.ClusterTrustBundleGVK.Group && kind == wellknown.ClusterTrustBundleGVK.Kind) ||
+		(group == wellknown.TrustManagerBundleGVK.Group && kind == wellknown.TrustManagerBundleGVK.Kind)
+}
+
+// GetCACert returns the PEM encoded CA certificates of the trust bundle referenced from the given namespace.
+// trust-manager Bundles are cluster-scoped and synced to every target namespace, so they are resolved from the
+// ConfigMap in the referencing namespace and do not require a ReferenceGrant.
+func (t *TrustBundleIndex) GetCACert(kctx krt.HandlerContext, namespace string, ref gwv1.ObjectReference) (string, error) {
+	switch {
+	case string(ref.Group) == wellknown.ClusterTrustBundleGVK.Group && string(ref.Kind) == wellknown.ClusterTrustBundleGVK.Kind:
+		if t.clusterTrustBundles == nil {
+			return "", ErrClusterTrustBundlesDisabled
+		}
+		ctb := krt.FetchOne(kctx, t.clusterTrustBundles, krt.FilterKey(string(ref.Name)))
+		if ctb == nil {
+			return "", &NotFoundError{NotFoundObj: ir.ObjectSource{
+				Group: wellknown.ClusterTrustBundleGVK.Group,
+				Kind:  wellknown.ClusterTrustBundleGVK.Kind,
+				Name:  string(ref.Name),
+			}}
+		}
+		return sslutils.GetCACertFromClusterTrustBundle(*ctb)
+
+	case string(ref.Group) == wellknown.TrustManagerBundleGVK.Group && string(ref.Kind) == wellknown.TrustManagerBundleGVK.Kind:
+		nn := types.NamespacedName{
+			Namespace: namespace,
+			Name:      string(ref.Name),
+		}
+		cm := krt.FetchOne(kctx, t.configmaps, krt.FilterObjectName(nn))
+		if cm == nil {
+			return "", &NotFoundError{NotFoundObj: ir.ObjectSource{
+				Kind:      wellknown.ConfigMapGVK.Kind,
+				Namespace: nn.Namespace,
+				Name:      nn.Name,
+			}}
+		}
+		return sslutils.GetCACertFromTrustBundleConfigMap(*cm)
+
+	default:
+		return "", fmt.Errorf("unsupported trust bundle reference type: %s/%s", ref.Group, ref.Kind)
+	}
+}
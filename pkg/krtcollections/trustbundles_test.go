@@ -0,0 +1,120 @@
+package krtcollections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"istio.io/istio/pkg/kube/krt"
+	"istio.io/istio/pkg/kube/krt/krttest"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/kgateway-dev/kgateway/v2/pkg/kgateway/wellknown"
+)
+
+const trustBundleCACert = `-----BEGIN CERTIFICATE-----
+MIIC1jCCAb4CCQCJczLyBBZ1GTANBgkqhkiG9w0BAQsFADAtMRUwEwYDVQQKDAxl
+eGFtcGxlIEluYy4xFDASBgNVBAMMC2V4YW1wbGUuY29tMB4XDTI1MDMwNzE0Mjkx
+NloXDTI2MDMwNzE0MjkxNlowLTEVMBMGA1UECgwMZXhhbXBsZSBJbmMuMRQwEgYD
+VQQDDAtleGFtcGxlLmNvbTCCASIwDQYJKoZIhvcNAQEBBQADggEPADCCAQoCggEB
+AN0U6TVYECkwqnxh1Kt3dS+LialrXBOXKagj9tE582T6dwmqThD75VZPrNKkRoYO
+aUzCctfDkUBXRemOTMut7ES5xoAtSAhr2GAnqgM3+yBCLOxooSjEFdlpFT7dhi1w
+jOPa5iMh6ve/pHuRHvEuaF/J6P8tr83wGutx/xFZVuGA9V1AmBmYhePM+JhdcwaB
+1+IbJp30gGyPfY4vdRQ9VQWbThE8psEzah+3SgTKJSIT7NAdwiIu3O3rXORbaYYU
+oycgXUHdOKRbJnbvy3pTnFZJ50sg1HIA4yBdX7c0diy8Zz3Suoondg3DforWr0pB
+Hs6tySAQoz2RiAqDqcE2rbMCAwEAATANBgkqhkiG9w0BAQsFAAOCAQEAWPkz3dJW
+b+LFtnv7MlOVM79Y4PqeiHnazP1G9FwnWBHARkjISsax3b0zX8/RHnU83c3tLP5D
+VwenYb9B9mzXbLiWI8aaX0UXP//D593ti15y0Od7yC2hQszlqIbxYnkFVwXoT9fQ
+bdQ9OtpCt8EZnKEyCxck+hlKEyYTcH2PqZ7Ndp0M8I2znz3Kut/uYHLUddfoPF/m
+O0V6fbyB/Mx/G1uLiv/BVpx3AdP+3ygJyKtelXkD+IdlY3y110fzmVr6NgxAbz/h
+n9KpuK4SEloIycZUaKVXAaX7T42SFYw7msmB+Uu7z5oLOijsjX6TjeofdFBZ/Byl
+SxODgqhtaPnOxQ==
+-----END CERTIFICATE-----
+`
+
+func TestTrustBundleIndex_GetCACert(t *testing.T) {
+	ctbRef := gwv1.ObjectReference{
+		Group: gwv1.Group(wellknown.ClusterTrustBundleGVK.Group),
+		Kind:  gwv1.Kind(wellknown.ClusterTrustBundleGVK.Kind),
+		Name:  "example.com:root",
+	}
+	bundleRef := gwv1.ObjectReference{
+		Group: gwv1.Group(wellknown.TrustManagerBundleGVK.Group),
+		Kind:  gwv1.Kind(wellknown.TrustManagerBundleGVK.Kind),
+		Name:  "org-roots",
+	}
+
+	tests := []struct {
+		name       string
+		disableCTB bool
+		namespace  string
+		ref        gwv1.ObjectReference
+		wantErr    string
+	}{
+		{
+			name:      "ClusterTrustBundle",
+			namespace: "default",
+			ref:       ctbRef,
+		},
+		{
+			name:       "ClusterTrustBundle API disabled",
+			disableCTB: true,
+			namespace:  "default",
+			ref:        ctbRef,
+			wantErr:    ErrClusterTrustBundlesDisabled.Error(),
+		},
+		{
+			name:      "missing ClusterTrustBundle",
+			namespace: "default",
+			ref:       gwv1.ObjectReference{Group: ctbRef.Group, Kind: ctbRef.Kind, Name: "missing"},
+			wantErr:   "not found",
+		},
+		{
+			name:      "trust-manager Bundle synced to the namespace",
+			namespace: "default",
+			ref:       bundleRef,
+		},
+		{
+			name:      "trust-manager Bundle not synced to the namespace",
+			namespace: "other",
+			ref:       bundleRef,
+			wantErr:   "not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := krttest.NewMock(t, []any{
+				&certificatesv1beta1.ClusterTrustBundle{
+					ObjectMeta: metav1.ObjectMeta{Name: "example.com:root"},
+					Spec: certificatesv1beta1.ClusterTrustBundleSpec{
+						TrustBundle: trustBundleCACert,
+					},
+				},
+				&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "org-roots", Namespace: "default"},
+					Data: map[string]string{
+						"trust-bundle.pem": trustBundleCACert,
+					},
+				},
+			})
+			var ctbs krt.Collection[*certificatesv1beta1.ClusterTrustBundle]
+			if !tt.disableCTB {
+				ctbs = krttest.GetMockCollection[*certificatesv1beta1.ClusterTrustBundle](mock)
+			}
+			idx := NewTrustBundleIndex(ctbs, krttest.GetMockCollection[*corev1.ConfigMap](mock))
+
+			caCert, err := idx.GetCACert(krt.TestingDummyContext{}, tt.namespace, tt.ref)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, trustBundleCACert, caCert)
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"istio.io/istio/pkg/kube/krt"
 	"istio.io/istio/pkg/kube/kubetypes"
 	"istio.io/istio/pkg/util/smallset"
+	certificatesv1beta1 "k8s.io/api/certificates/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	gwv1b1 "sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -28,6 +29,7 @@ type CommonCollections struct {
 	KrtOpts           krtutil.KrtOptions
 	Secrets           *krtcollections.SecretIndex
 	ConfigMaps        *krtcollections.ConfigMapIndex
+	TrustBundles      *krtcollections.TrustBundleIndex
 	BackendIndex      *krtcollections.BackendIndex
 	Routes            *krtcollections.RoutesIndex
 	Namespaces        krt.Collection[krtcollections.NamespaceMetadata]
@@ -58,6 +60,7 @@ func (c *CommonCollections) HasSynced() bool {
 	// collections aren't initialized until we call InitPlugins
 	return c.Secrets != nil && c.Secrets.HasSynced() &&
 		c.ConfigMaps != nil && c.ConfigMaps.HasSynced() &&
+		c.TrustBundles != nil && c.TrustBundles.HasSynced() &&
 		c.BackendIndex != nil && c.BackendIndex.HasSynced() &&
 		c.Routes != nil && c.Routes.HasSynced() &&
 		c.WrappedPods != nil && c.WrappedPods.HasSynced() &&
@@ -149,6 +152,13 @@ func NewCommonCollections(
 	)
 	cfgmaps := krt.WrapClient(cmClient, krtOptions.ToOptions("ConfigMaps")...)
 
+	// ClusterTrustBundles are cluster-scoped and only watched if enabled, as the API may not be served
+	var clusterTrustBundles krt.Collection[*certificatesv1beta1.ClusterTrustBundle]
+	if settings.EnableClusterTrustBundles {
+		ctbClient := kclient.New[*certificatesv1beta1.ClusterTrustBundle](client)
+		clusterTrustBundles = krt.WrapClient(ctbClient, krtOptions.ToOptions("ClusterTrustBundles")...)
+	}
+
 	// Only create GatewayExtensions collection if Envoy is enabled
 	// This CRD is specific to Envoy and not used by agentgateway
 	var gwExts krt.Collection[ir.GatewayExtension]
@@ -163,6 +173,7 @@ func NewCommonCollections(
 		KrtOpts:           krtOptions,
 		Secrets:           krtcollections.NewSecretIndex(secrets, refgrants),
 		ConfigMaps:        krtcollections.NewConfigMapIndex(cfgmaps, refgrants),
+		TrustBundles:      krtcollections.NewTrustBundleIndex(clusterTrustBundles, cfgmaps),
 		LocalityPods:      localityPods,
 		WrappedPods:       wrappedPods,
 		RefGrants:         refgrants,
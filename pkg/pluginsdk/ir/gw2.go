@@ -99,6 +99,8 @@ type TLSConfig struct {
 type ClientCertificateValidation struct {
 	// CACertificates contains the CA certificates used to validate client certificates
 	CACertificates [][]byte
+	// CRLs contains the certificate revocation lists the client certificates are checked against
+	CRLs [][]byte
 	// RequireClientCertificate indicates whether client certificates are required
 	RequireClientCertificate bool
 }
@@ -137,6 +139,8 @@ type HttpFilterChainIR struct {
 type TcpIR struct {
 	FilterChainCommon
 	BackendRefs []BackendRefIR
+	// AttachedPolicies are the policies attached to the TCPRoute or TLSRoute of the filter chain
+	AttachedPolicies AttachedPolicies
 }
 
 // this is 1:1 with envoy deployments
@@ -12,6 +12,7 @@ import (
 	envoylistenerv3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoytcp "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	envoytlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -41,6 +42,12 @@ type HttpFiltersContext struct {
 	ListenerPort uint32
 }
 
+type NetworkFiltersContext struct {
+	ListenerPort uint32
+	// TcpProxy is true for the filter chains proxying TCP connections, i.e. the ones of TCPRoutes and TLSRoutes
+	TcpProxy bool
+}
+
 type RouteConfigContext struct {
 	Policy            PolicyIR
 	FilterChainName   string
@@ -123,6 +130,12 @@ type HcmContext struct {
 	Gateway      GatewayIR
 }
 
+type TcpProxyContext struct {
+	ListenerPort uint32
+	Policy       PolicyIR
+	Gateway      GatewayIR
+}
+
 // ProxyTranslationPass represents a single translation pass for a gateway using envoy. It can hold state
 // for the duration of the translation.
 // Each of the functions here will be called in the order they appear in the interface.
@@ -178,7 +191,7 @@ type ProxyTranslationPass interface {
 	)
 
 	// NetworkFilters returns StagedNetworkFilters to be added to the listener.
-	NetworkFilters() ([]filters.StagedNetworkFilter, error)
+	NetworkFilters(nCtx NetworkFiltersContext) ([]filters.StagedNetworkFilter, error)
 
 	// called 1 time per filter-chain.
 	// If a plugin emits new filters, they must be with a plugin unique name.
@@ -190,6 +203,11 @@ type ProxyTranslationPass interface {
 		pCtx *HcmContext,
 		out *envoy_hcm.HttpConnectionManager) error
 
+	// called 1 time per TCP filter chain and allows tweaking TcpProxy settings.
+	ApplyTcpProxy(
+		pCtx *TcpProxyContext,
+		out *envoytcp.TcpProxy) error
+
 	// called 1 time (per envoy proxy). replaces GeneratedResources and allows adding clusters to the envoy.
 	ResourcesToAdd() Resources
 }
@@ -241,6 +259,10 @@ func (s UnimplementedProxyTranslationPass) ApplyHCM(pCtx *HcmContext, out *envoy
 	return nil
 }
 
+func (s UnimplementedProxyTranslationPass) ApplyTcpProxy(pCtx *TcpProxyContext, out *envoytcp.TcpProxy) error {
+	return nil
+}
+
 func (s UnimplementedProxyTranslationPass) ApplyForBackend(pCtx *RouteBackendContext, in HttpBackend, out *envoyroutev3.Route) error {
 	return nil
 }
@@ -263,7 +285,7 @@ func (s UnimplementedProxyTranslationPass) HttpFilters(hCtx HttpFiltersContext,
 	return nil, nil
 }
 
-func (s UnimplementedProxyTranslationPass) NetworkFilters() ([]filters.StagedNetworkFilter, error) {
+func (s UnimplementedProxyTranslationPass) NetworkFilters(nCtx NetworkFiltersContext) ([]filters.StagedNetworkFilter, error) {
 	return nil, nil
 }
 
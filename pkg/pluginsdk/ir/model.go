@@ -93,6 +93,8 @@ type EndpointsForBackend struct {
 	// for use in endpoints plugins
 	// +krtEqualsTodo include backend labels in equality or confirm omission
 	BackendLabels map[string]string
+	// the source of the original backend object, for use in endpoints plugins
+	BackendSource ObjectSource
 
 	// +krtEqualsTodo compare load-balanced endpoint map
 	LbEps LocalityLbMap
@@ -139,6 +141,7 @@ func NewEndpointsForBackend(us BackendObjectIR) *EndpointsForBackend {
 
 	return &EndpointsForBackend{
 		BackendLabels:        labels,
+		BackendSource:        us.ObjectSource,
 		LbEps:                make(map[PodLocality][]EndpointWithMd),
 		ClusterName:          us.ClusterName(),
 		UpstreamResourceName: us.ResourceName(),
@@ -155,6 +158,7 @@ func NewEndpointsForBackend(us BackendObjectIR) *EndpointsForBackend {
 func (e EndpointsForBackend) EmptyCopy() EndpointsForBackend {
 	return EndpointsForBackend{
 		BackendLabels:        e.BackendLabels,
+		BackendSource:        e.BackendSource,
 		LbEps:                make(map[PodLocality][]EndpointWithMd),
 		ClusterName:          e.ClusterName,
 		UpstreamResourceName: e.UpstreamResourceName,
@@ -202,5 +206,5 @@ func (c EndpointsForBackend) ResourceName() string {
 }
 
 func (c EndpointsForBackend) Equals(in EndpointsForBackend) bool {
-	return c.UpstreamResourceName == in.UpstreamResourceName && c.ClusterName == in.ClusterName && c.Port == in.Port && c.LbEpsEqualityHash == in.LbEpsEqualityHash && c.Hostname == in.Hostname && c.TrafficDistribution == in.TrafficDistribution && c.upstreamHash == in.upstreamHash && c.epsEqualityHash == in.epsEqualityHash
+	return c.UpstreamResourceName == in.UpstreamResourceName && c.ClusterName == in.ClusterName && c.Port == in.Port && c.LbEpsEqualityHash == in.LbEpsEqualityHash && c.Hostname == in.Hostname && c.BackendSource.Equals(in.BackendSource) && c.TrafficDistribution == in.TrafficDistribution && c.upstreamHash == in.upstreamHash && c.epsEqualityHash == in.epsEqualityHash
 }
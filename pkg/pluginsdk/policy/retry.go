@@ -1,7 +1,9 @@
 package policy
 
 import (
+	"fmt"
 	"strings"
+	"time"
 
 	envoyroutev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -12,6 +14,9 @@ import (
 	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/kgateway"
 )
 
+// defaultBackoffBaseInterval is the default base interval of the retry back-off, as in Envoy.
+const defaultBackoffBaseInterval = 25 * time.Millisecond
+
 func BuildRetryPolicy(in *kgateway.Retry) *envoyroutev3.RetryPolicy {
 	if in == nil {
 		return nil
@@ -25,15 +30,37 @@ func BuildRetryPolicy(in *kgateway.Retry) *envoyroutev3.RetryPolicy {
 		policy.PerTryTimeout = durationpb.New(in.PerTryTimeout.Duration)
 	}
 
-	if in.BackoffBaseInterval != nil {
+	if in.BackoffBaseInterval != nil || in.BackoffMaxInterval != nil {
+		// Envoy requires a base interval when a max interval is set
+		baseInterval := defaultBackoffBaseInterval
+		if in.BackoffBaseInterval != nil {
+			baseInterval = in.BackoffBaseInterval.Duration
+		}
 		policy.RetryBackOff = &envoyroutev3.RetryPolicy_RetryBackOff{
-			BaseInterval: durationpb.New(in.BackoffBaseInterval.Duration),
+			BaseInterval: durationpb.New(baseInterval),
+		}
+		if in.BackoffMaxInterval != nil {
+			policy.RetryBackOff.MaxInterval = durationpb.New(in.BackoffMaxInterval.Duration)
 		}
 	}
 
 	return policy
 }
 
+// ValidateRetryBackOff returns an error if the max interval of the retry back-off is less than its base interval,
+// which Envoy rejects. The base interval is always set when the back-off is, defaulting to 25ms.
+func ValidateRetryBackOff(in *envoyroutev3.RetryPolicy) error {
+	backOff := in.GetRetryBackOff()
+	if backOff.GetMaxInterval() == nil {
+		return nil
+	}
+	base, maxInterval := backOff.GetBaseInterval().AsDuration(), backOff.GetMaxInterval().AsDuration()
+	if maxInterval < base {
+		return fmt.Errorf("retry backoff max interval %s must be greater than or equal to the base interval %s", maxInterval, base)
+	}
+	return nil
+}
+
 // retryOnToString converts a slice of RetryOnCondition to a comma-separated string
 func retryOnToString(retryOn []kgateway.RetryOnCondition, forStatusCodes bool) string {
 	retryOnSet := sets.NewString()
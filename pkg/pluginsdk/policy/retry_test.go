@@ -95,6 +95,39 @@ func TestBuildRetryPolicy(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "retry policy with backoff max interval",
+			input: &kgateway.Retry{
+				RetryOn:             []kgateway.RetryOnCondition{"5xx"},
+				Attempts:            int32(3),
+				BackoffBaseInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+				BackoffMaxInterval:  &metav1.Duration{Duration: 5 * time.Second},
+			},
+			want: &envoyroutev3.RetryPolicy{
+				RetryOn:    "5xx",
+				NumRetries: wrapperspb.UInt32(3),
+				RetryBackOff: &envoyroutev3.RetryPolicy_RetryBackOff{
+					BaseInterval: durationpb.New(100 * time.Millisecond),
+					MaxInterval:  durationpb.New(5 * time.Second),
+				},
+			},
+		},
+		{
+			name: "retry policy with only backoff max interval",
+			input: &kgateway.Retry{
+				RetryOn:            []kgateway.RetryOnCondition{"5xx"},
+				Attempts:           int32(3),
+				BackoffMaxInterval: &metav1.Duration{Duration: time.Second},
+			},
+			want: &envoyroutev3.RetryPolicy{
+				RetryOn:    "5xx",
+				NumRetries: wrapperspb.UInt32(3),
+				RetryBackOff: &envoyroutev3.RetryPolicy_RetryBackOff{
+					BaseInterval: durationpb.New(25 * time.Millisecond),
+					MaxInterval:  durationpb.New(time.Second),
+				},
+			},
+		},
 		{
 			name: "comprehensive retry policy with all fields",
 			input: &kgateway.Retry{
@@ -149,3 +182,51 @@ func TestBuildRetryPolicy(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRetryBackOff(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   *kgateway.Retry
+		wantErr bool
+	}{
+		{
+			name:  "no backoff",
+			input: &kgateway.Retry{RetryOn: []kgateway.RetryOnCondition{"5xx"}},
+		},
+		{
+			name: "max interval above the default base interval",
+			input: &kgateway.Retry{
+				RetryOn:            []kgateway.RetryOnCondition{"5xx"},
+				BackoffMaxInterval: &metav1.Duration{Duration: 25 * time.Millisecond},
+			},
+		},
+		{
+			name: "max interval below the default base interval",
+			input: &kgateway.Retry{
+				RetryOn:            []kgateway.RetryOnCondition{"5xx"},
+				BackoffMaxInterval: &metav1.Duration{Duration: 10 * time.Millisecond},
+			},
+			wantErr: true,
+		},
+		{
+			name: "max interval below the base interval",
+			input: &kgateway.Retry{
+				RetryOn:             []kgateway.RetryOnCondition{"5xx"},
+				BackoffBaseInterval: &metav1.Duration{Duration: 100 * time.Millisecond},
+				BackoffMaxInterval:  &metav1.Duration{Duration: 50 * time.Millisecond},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRetryBackOff(BuildRetryPolicy(tt.input))
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -26,6 +26,10 @@ const (
 	// with a direct response.
 	RouteRuleReplacedReason = "RouteRuleReplaced"
 
+	// RouteHostnameConflictReason is used with the Accepted=False condition when all the hostnames of a
+	// route are already claimed by other routes or listeners, so that no traffic is forwarded by the route.
+	RouteHostnameConflictReason = "HostnameConflict"
+
 	// ListenerReplacedReason is used with the Accepted=False condition when an individual listener
 	// on a Gateway or XListenerSet is replaced due to an error in a policy targeting that listener.
 	ListenerReplacedReason = "ListenerReplaced"
@@ -33,6 +37,14 @@ const (
 	// GatewayReplacedReason is used with the Accepted=False condition when the entire Gateway is replaced
 	// due to an error in a policy targeting the Gateway.
 	GatewayReplacedReason = "GatewayReplaced"
+
+	// RouteConditionDuplicate is a kgateway-specific route condition type. It is set to True on a route
+	// parentRef when the same hostname and match is also claimed by a different route attached to another
+	// Gateway of the same GatewayClass, in which case the routes may silently shadow each other.
+	RouteConditionDuplicate = "Duplicate"
+
+	// RouteDuplicateReason is used with the Duplicate=True condition.
+	RouteDuplicateReason = "DuplicateHostnameAndMatch"
 )
 
 // PolicyAttachmentState represents the state of a policy attachment
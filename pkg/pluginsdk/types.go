@@ -26,6 +26,9 @@ var ErrNotFound = errors.New("not found")
 type (
 	EndpointsInputs = endpoints.EndpointsInputs
 	ProcessBackend  func(ctx context.Context, pol ir.PolicyIR, in ir.BackendObjectIR, out *envoyclusterv3.Cluster)
+	// FinalizeBackend is called once all plugins processed the cluster, for changes that must see the complete
+	// cluster, such as wrapping the transport sockets. A returned error fails the translation of the backend.
+	FinalizeBackend func(ctx context.Context, pol ir.PolicyIR, in ir.BackendObjectIR, out *envoyclusterv3.Cluster) error
 	EndpointPlugin  func(
 		kctx krt.HandlerContext,
 		ctx context.Context,
@@ -61,6 +64,7 @@ type PolicyPlugin struct {
 	ProcessBackend            ProcessBackend
 	PerClientProcessBackend   PerClientProcessBackend
 	PerClientProcessEndpoints EndpointPlugin
+	FinalizeBackend           FinalizeBackend
 
 	// Backend processing for agent gateway
 	ProcessAgentBackend func(pol ir.PolicyIR, in ir.BackendObjectIR) error
@@ -0,0 +1,103 @@
+package utils
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"istio.io/istio/pkg/kube/krt"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PolicyExpiryWarningPeriod is the period before the expiry of a policy during which the policy reports the
+// Expiring reason, so that temporary exceptions can be extended or cleaned up before they silently stop applying.
+const PolicyExpiryWarningPeriod = 24 * time.Hour
+
+var (
+	// policyExpiryTrigger recomputes the policies with an expiry when they start expiring or expire, as nothing
+	// else changes at that time
+	policyExpiryTrigger = krt.NewRecomputeTrigger(true)
+
+	policyExpiryTimersMu sync.Mutex
+	// policyExpiryTimers are the times at which a recomputation is scheduled
+	policyExpiryTimers = map[time.Time]struct{}{}
+)
+
+// PolicyExpiry is the expiry state of a policy at the time it was computed.
+type PolicyExpiry struct {
+	ExpiresAt time.Time
+	// Expiring is true when the policy was computed during the warning period before its expiry
+	Expiring bool
+	// Expired is true when the policy was computed after its expiry, in which case it must not be attached
+	Expired bool
+}
+
+// NewPolicyExpiry returns the expiry state of a policy expiring at expiresAt, and schedules the recomputation
+// of the policy when it starts expiring and when it expires. Returns nil when the policy does not expire.
+func NewPolicyExpiry(krtctx krt.HandlerContext, expiresAt *metav1.Time, now time.Time) *PolicyExpiry {
+	if expiresAt == nil {
+		return nil
+	}
+
+	if krtctx != nil {
+		policyExpiryTrigger.MarkDependant(krtctx)
+	}
+
+	at := expiresAt.Time
+	warnAt := at.Add(-PolicyExpiryWarningPeriod)
+	schedulePolicyExpiryRecompute(now, warnAt)
+	schedulePolicyExpiryRecompute(now, at)
+
+	return &PolicyExpiry{
+		ExpiresAt: at,
+		Expiring:  !now.Before(warnAt) && now.Before(at),
+		Expired:   !now.Before(at),
+	}
+}
+
+func schedulePolicyExpiryRecompute(now, at time.Time) {
+	if !at.After(now) {
+		return
+	}
+
+	policyExpiryTimersMu.Lock()
+	defer policyExpiryTimersMu.Unlock()
+	if _, ok := policyExpiryTimers[at]; ok {
+		return
+	}
+	policyExpiryTimers[at] = struct{}{}
+	time.AfterFunc(at.Sub(now), func() {
+		policyExpiryTimersMu.Lock()
+		delete(policyExpiryTimers, at)
+		policyExpiryTimersMu.Unlock()
+		policyExpiryTrigger.TriggerRecomputation()
+	})
+}
+
+func (e *PolicyExpiry) Equals(e2 *PolicyExpiry) bool {
+	if e == nil || e2 == nil {
+		return e == nil && e2 == nil
+	}
+	return e.ExpiresAt.Equal(e2.ExpiresAt) &&
+		e.Expiring == e2.Expiring &&
+		e.Expired == e2.Expired
+}
+
+// IsExpired returns true when the policy expired, and must be deactivated.
+func (e *PolicyExpiry) IsExpired() bool {
+	return e != nil && e.Expired
+}
+
+// IsExpiring returns true when the policy is about to expire.
+func (e *PolicyExpiry) IsExpiring() bool {
+	return e != nil && e.Expiring
+}
+
+// Message returns the message of the conditions reporting the expiry.
+func (e *PolicyExpiry) Message() string {
+	expiresAt := e.ExpiresAt.UTC().Format(time.RFC3339)
+	if e.IsExpired() {
+		return fmt.Sprintf("Policy expired at %s and is no longer attached", expiresAt)
+	}
+	return fmt.Sprintf("Policy accepted, but expires at %s", expiresAt)
+}
@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNewPolicyExpiry(t *testing.T) {
+	now := time.Now()
+	expiresAt := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(d)}
+	}
+
+	assert.Nil(t, NewPolicyExpiry(nil, nil, now))
+	assert.False(t, (*PolicyExpiry)(nil).IsExpired())
+
+	active := NewPolicyExpiry(nil, expiresAt(PolicyExpiryWarningPeriod+time.Hour), now)
+	assert.False(t, active.IsExpiring())
+	assert.False(t, active.IsExpired())
+
+	expiring := NewPolicyExpiry(nil, expiresAt(time.Hour), now)
+	assert.True(t, expiring.IsExpiring())
+	assert.False(t, expiring.IsExpired())
+
+	expired := NewPolicyExpiry(nil, expiresAt(-time.Hour), now)
+	assert.False(t, expired.IsExpiring())
+	assert.True(t, expired.IsExpired())
+
+	// the policy is recomputed when it expires, which must be seen as a change
+	assert.False(t, expiring.Equals(NewPolicyExpiry(nil, expiresAt(time.Hour), now.Add(2*time.Hour))))
+	assert.True(t, expiring.Equals(NewPolicyExpiry(nil, expiresAt(time.Hour), now)))
+}
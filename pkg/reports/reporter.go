@@ -3,11 +3,13 @@ package reports
 import (
 	"fmt"
 	"log/slog"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gwv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -89,6 +91,19 @@ func (r *ReportMap) GatewayNamespaceName(key types.NamespacedName) *GatewayRepor
 	return r.Gateways[key]
 }
 
+// ForGateways returns a copy of the report map that only holds the reports of the given Gateways, to sync the
+// status of these Gateways only.
+func (r *ReportMap) ForGateways(gateways sets.Set[types.NamespacedName]) ReportMap {
+	out := *r
+	out.Gateways = make(map[types.NamespacedName]*GatewayReport, len(gateways))
+	for gw := range gateways {
+		if report, ok := r.Gateways[gw]; ok {
+			out.Gateways[gw] = report
+		}
+	}
+	return out
+}
+
 // GatewayObservedGenerationFor returns the observed generation stored in the
 // GatewayReport for the provided namespaced name. The boolean indicates whether
 // a report was present for the provided key.
@@ -404,6 +419,19 @@ func (r *RouteReport) parentRefs() []gwv1.ParentReference {
 	return refs
 }
 
+// Clone returns a deep copy of the RouteReport, so that parentRef conditions can be
+// modified without affecting reports shared with other translation results.
+func (r *RouteReport) Clone() *RouteReport {
+	out := &RouteReport{
+		Parents:            make(map[ParentRefKey]*ParentRefReport, len(r.Parents)),
+		observedGeneration: r.observedGeneration,
+	}
+	for k, v := range r.Parents {
+		out.Parents[k] = &ParentRefReport{Conditions: slices.Clone(v.Conditions)}
+	}
+	return out
+}
+
 func (r *RouteReport) ParentRef(parentRef *gwv1.ParentReference) reporter.ParentRefReporter {
 	return r.parentRef(parentRef)
 }
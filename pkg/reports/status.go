@@ -366,6 +366,10 @@ func (r *ReportMap) BuildRouteStatusWithParentRefDefaulting(
 		// If there are conditions on the route that are not owned by our reporter, include
 		// them in the final list of conditions to preseve conditions we do not own
 		for _, condition := range currentParentRefConditions {
+			// the Duplicate condition is only reported while a duplicate exists, so drop it once resolved
+			if condition.Type == reporter.RouteConditionDuplicate {
+				continue
+			}
 			if meta.FindStatusCondition(finalConditions, condition.Type) == nil {
 				finalConditions = append(finalConditions, condition)
 			}
@@ -0,0 +1,135 @@
+package celutils
+
+import (
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+// AuthorizationAttributes are the CEL expressions of the request attributes used by the typed
+// authorization matchers, as they differ between data planes.
+type AuthorizationAttributes struct {
+	// Path is the path of the request, excluding the query string.
+	Path string
+	// JWTClaims is the map of the claims of the JWT authenticating the request.
+	JWTClaims string
+	// SourceAddress is the IP address of the client. When empty, the source CIDRs are not part of
+	// the expression and must be matched by the data plane with another mechanism.
+	SourceAddress string
+	// MCP is the MCP request context, with the tool, prompt or resource targeted by the request. When
+	// empty, the MCP matchers are not supported.
+	MCP string
+}
+
+// AuthorizationMatcherExpression returns the CEL expression evaluating to true when all the conditions
+// of the matcher are satisfied. The expression is empty when no condition is expressed in CEL.
+func AuthorizationMatcherExpression(m shared.AuthorizationMatcher, attrs AuthorizationAttributes) (string, error) {
+	var conditions []string
+
+	if len(m.Methods) > 0 {
+		methods := make([]string, 0, len(m.Methods))
+		for _, method := range m.Methods {
+			methods = append(methods, strconv.Quote(strings.ToUpper(method)))
+		}
+		conditions = append(conditions, fmt.Sprintf("request.method in [%s]", strings.Join(methods, ", ")))
+	}
+
+	var paths []string
+	for _, path := range m.Paths {
+		if prefix, ok := strings.CutSuffix(path, "*"); ok {
+			paths = append(paths, fmt.Sprintf("%s.startsWith(%s)", attrs.Path, strconv.Quote(prefix)))
+		} else {
+			paths = append(paths, fmt.Sprintf("%s == %s", attrs.Path, strconv.Quote(path)))
+		}
+	}
+	conditions = appendAnyOf(conditions, paths)
+
+	for _, header := range m.Headers {
+		// header names are lowercase in the request attributes
+		name := strconv.Quote(strings.ToLower(header.Name))
+		condition := fmt.Sprintf("%s in request.headers", name)
+		if header.Value != nil {
+			condition += fmt.Sprintf(" && request.headers[%s] == %s", name, strconv.Quote(*header.Value))
+		}
+		conditions = append(conditions, condition)
+	}
+
+	var cidrs []string
+	for _, cidr := range m.SourceCIDRs {
+		if _, err := netip.ParsePrefix(cidr); err != nil {
+			return "", fmt.Errorf("invalid source CIDR %q: %w", cidr, err)
+		}
+		cidrs = append(cidrs, fmt.Sprintf("cidr(%s).containsIP(%s)", strconv.Quote(cidr), attrs.SourceAddress))
+	}
+	if attrs.SourceAddress != "" {
+		conditions = appendAnyOf(conditions, cidrs)
+	}
+
+	for _, claim := range m.JWTClaims {
+		name := strconv.Quote(claim.Name)
+		condition := fmt.Sprintf("%s in %s", name, attrs.JWTClaims)
+		switch {
+		case claim.Equals != nil:
+			condition += fmt.Sprintf(" && %s[%s] == %s", attrs.JWTClaims, name, strconv.Quote(*claim.Equals))
+		case claim.Contains != nil:
+			condition += fmt.Sprintf(" && %s in %s[%s]", strconv.Quote(*claim.Contains), attrs.JWTClaims, name)
+		}
+		conditions = append(conditions, condition)
+	}
+
+	if m.MCP != nil {
+		if attrs.MCP == "" {
+			return "", fmt.Errorf("MCP matchers are only supported by the MCP authorization of agentgateway backends")
+		}
+		var targets []string
+		targets = appendMCPTarget(targets, attrs.MCP+".tool", m.MCP.Tools)
+		targets = appendMCPTarget(targets, attrs.MCP+".prompt", m.MCP.Prompts)
+		targets = appendMCPTarget(targets, attrs.MCP+".resource", m.MCP.Resources)
+		conditions = appendAnyOf(conditions, targets)
+	}
+
+	if len(conditions) == 1 {
+		return conditions[0], nil
+	}
+	for i, condition := range conditions {
+		conditions[i] = "(" + condition + ")"
+	}
+	return strings.Join(conditions, " && "), nil
+}
+
+// appendMCPTarget appends the condition satisfied when the request targets an MCP item with one of the names.
+func appendMCPTarget(targets []string, item string, names []string) []string {
+	if len(names) == 0 {
+		return targets
+	}
+	return append(targets, MCPItemExpression(item, names, false))
+}
+
+// MCPItemExpression returns the CEL expression satisfied when the request targets an MCP item, such as mcp.tool,
+// with one of the names, or with none of them when exclude is true.
+func MCPItemExpression(item string, names []string, exclude bool) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, strconv.Quote(name))
+	}
+	condition := fmt.Sprintf("%s.name in [%s]", item, strings.Join(quoted, ", "))
+	if exclude {
+		condition = "!(" + condition + ")"
+	}
+	return fmt.Sprintf("has(%s) && %s", item, condition)
+}
+
+// appendAnyOf appends the condition satisfied when any of the alternatives is.
+func appendAnyOf(conditions, alternatives []string) []string {
+	switch len(alternatives) {
+	case 0:
+		return conditions
+	case 1:
+		return append(conditions, alternatives[0])
+	default:
+		return append(conditions, strings.Join(alternatives, " || "))
+	}
+}
@@ -0,0 +1,95 @@
+package celutils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+
+	"github.com/kgateway-dev/kgateway/v2/api/v1alpha1/shared"
+)
+
+func TestAuthorizationMatcherExpression(t *testing.T) {
+	attrs := AuthorizationAttributes{
+		Path:          "request.path",
+		JWTClaims:     "jwt",
+		SourceAddress: "source.address",
+	}
+
+	tests := []struct {
+		name     string
+		matcher  shared.AuthorizationMatcher
+		attrs    AuthorizationAttributes
+		expected string
+	}{
+		{
+			name:     "methods",
+			matcher:  shared.AuthorizationMatcher{Methods: []string{"get", "POST"}},
+			attrs:    attrs,
+			expected: `request.method in ["GET", "POST"]`,
+		},
+		{
+			name:     "paths",
+			matcher:  shared.AuthorizationMatcher{Paths: []string{"/healthz", "/api/*"}},
+			attrs:    attrs,
+			expected: `request.path == "/healthz" || request.path.startsWith("/api/")`,
+		},
+		{
+			name: "headers",
+			matcher: shared.AuthorizationMatcher{Headers: []shared.AuthorizationHeaderMatch{
+				{Name: "X-Tenant", Value: ptr.To("acme")},
+				{Name: "x-debug"},
+			}},
+			attrs:    attrs,
+			expected: `("x-tenant" in request.headers && request.headers["x-tenant"] == "acme") && ("x-debug" in request.headers)`,
+		},
+		{
+			name:     "source CIDRs",
+			matcher:  shared.AuthorizationMatcher{SourceCIDRs: []string{"10.0.0.0/8"}},
+			attrs:    attrs,
+			expected: `cidr("10.0.0.0/8").containsIP(source.address)`,
+		},
+		{
+			name:     "source CIDRs matched by the data plane",
+			matcher:  shared.AuthorizationMatcher{SourceCIDRs: []string{"10.0.0.0/8"}},
+			attrs:    AuthorizationAttributes{Path: "request.url_path", JWTClaims: "claims"},
+			expected: "",
+		},
+		{
+			name: "JWT claims",
+			matcher: shared.AuthorizationMatcher{JWTClaims: []shared.AuthorizationJWTClaimMatch{
+				{Name: "sub", Equals: ptr.To("alice")},
+				{Name: "groups", Contains: ptr.To("admins")},
+			}},
+			attrs:    attrs,
+			expected: `("sub" in jwt && jwt["sub"] == "alice") && ("groups" in jwt && "admins" in jwt["groups"])`,
+		},
+		{
+			name: "MCP tools and prompts",
+			matcher: shared.AuthorizationMatcher{
+				JWTClaims: []shared.AuthorizationJWTClaimMatch{{Name: "group", Equals: ptr.To("admins")}},
+				MCP: &shared.AuthorizationMCPMatch{
+					Tools:   []string{"delete_database"},
+					Prompts: []string{"summarize", "translate"},
+				},
+			},
+			attrs:    AuthorizationAttributes{Path: "request.path", JWTClaims: "jwt", MCP: "mcp"},
+			expected: `("group" in jwt && jwt["group"] == "admins") && (has(mcp.tool) && mcp.tool.name in ["delete_database"] || has(mcp.prompt) && mcp.prompt.name in ["summarize", "translate"])`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AuthorizationMatcherExpression(tt.matcher, tt.attrs)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+
+	_, err := AuthorizationMatcherExpression(shared.AuthorizationMatcher{SourceCIDRs: []string{"not-a-cidr"}}, attrs)
+	assert.Error(t, err)
+
+	_, err = AuthorizationMatcherExpression(shared.AuthorizationMatcher{MCP: &shared.AuthorizationMCPMatch{Tools: []string{"echo"}}}, attrs)
+	assert.ErrorContains(t, err, "MCP matchers")
+}
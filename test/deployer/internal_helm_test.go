@@ -87,6 +87,12 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 		return nil
 	}
 
+	// Tap override function for tests that need tap enabled
+	tapOverride := func(inputs *pkgdeployer.Inputs) pkgdeployer.HelmValuesGenerator {
+		inputs.CommonCollections.Settings.EnableTap = true
+		return nil
+	}
+
 	tests := []HelmTestCase{
 		{
 			Name:      "basic gateway with default gatewayclass and no gwparams",
@@ -110,6 +116,15 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 			InputFile: "omit-default-security-context-via-gw",
 			Validate:  NoSecurityContextValidator(),
 		},
+		{
+			Name:      "gwparams with resourceNaming",
+			InputFile: "resource-naming",
+		},
+		{
+			Name:                        "gateway with tap enabled",
+			InputFile:                   "envoy-tap",
+			HelmValuesGeneratorOverride: tapOverride,
+		},
 		{
 			Name:      "gwparams with stats matcher inclusion",
 			InputFile: "stats-matcher-inclusion",
@@ -204,6 +219,14 @@ wIDAQABMA0GCSqGSIb3DQEBCwUAA4IBAQBtestcertdata
 			Name:      "agentgateway with env vars",
 			InputFile: "agentgateway-env",
 		},
+		{
+			Name:      "agentgateway with extra containers and volumes",
+			InputFile: "agentgateway-extra-containers",
+		},
+		{
+			Name:      "agentgateway with probe and lifecycle overrides",
+			InputFile: "agentgateway-probes",
+		},
 		{
 			Name:      "agentgateway with shutdown configuration",
 			InputFile: "agentgateway-shutdown",
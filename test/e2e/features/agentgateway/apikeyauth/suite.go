@@ -27,7 +27,6 @@ const (
 )
 
 var (
-	insecureRouteManifest     = getTestFile("insecure-route.yaml")
 	secureGwPolicyManifest    = getTestFile("secured-gateway-policy.yaml")
 	secureRoutePolicyManifest = getTestFile("secured-route.yaml")
 
@@ -35,7 +34,7 @@ var (
 
 	testCases = map[string]*base.TestCase{
 		"TestRoutePolicy": {
-			Manifests: []string{insecureRouteManifest, secureRoutePolicyManifest},
+			Manifests: []string{secureRoutePolicyManifest},
 		},
 		"TestGatewayPolicy": {
 			Manifests: []string{secureGwPolicyManifest},
@@ -56,45 +55,45 @@ func NewTestingSuite(ctx context.Context, testInst *e2e.TestInstallation) suite.
 func (s *testingSuite) TestRoutePolicy() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-example-insecure",
+		"apikeyauth-route-example-insecure",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// verify insecure route works
-	s.assertResponseWithoutAuth("insecureroute.com", http.StatusOK)
+	s.assertResponseWithoutAuth("insecureroute.apikeyauth.example.com", http.StatusOK)
 
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure",
+		"apikeyauth-route-secure",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// verify key without metadata works
-	s.assertResponse("secureroute.com", "k-1230", http.StatusOK)
+	s.assertResponse("secureroute.apikeyauth.example.com", "k-1230", http.StatusOK)
 	// verify key with metadata works
-	s.assertResponse("secureroute.com", "k-4560", http.StatusOK)
+	s.assertResponse("secureroute.apikeyauth.example.com", "k-4560", http.StatusOK)
 	// verify invalid keys are rejected
-	s.assertResponse("secureroute.com", "nosuchkey", http.StatusUnauthorized)
-	s.assertResponseWithoutAuth("secureroute.com", http.StatusUnauthorized)
+	s.assertResponse("secureroute.apikeyauth.example.com", "nosuchkey", http.StatusUnauthorized)
+	s.assertResponseWithoutAuth("secureroute.apikeyauth.example.com", http.StatusUnauthorized)
 }
 
 func (s *testingSuite) TestGatewayPolicy() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure-gw",
+		"apikeyauth-route-secure-gw",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// verify key without metadata works
-	s.assertResponse("securegateways.com", "k-123", http.StatusOK)
+	s.assertResponse("securegateways.apikeyauth.example.com", "k-123", http.StatusOK)
 	// verify key with metadata works
-	s.assertResponse("securegateways.com", "k-456", http.StatusOK)
+	s.assertResponse("securegateways.apikeyauth.example.com", "k-456", http.StatusOK)
 	// verify invalid keys are rejected
-	s.assertResponse("securegateways.com", "nosuchkey", http.StatusUnauthorized)
-	s.assertResponseWithoutAuth("securegateways.com", http.StatusUnauthorized)
+	s.assertResponse("securegateways.apikeyauth.example.com", "nosuchkey", http.StatusUnauthorized)
+	s.assertResponseWithoutAuth("securegateways.apikeyauth.example.com", http.StatusUnauthorized)
 }
 
 func (s *testingSuite) assertResponse(hostHeader, authHeader string, expectedStatus int) {
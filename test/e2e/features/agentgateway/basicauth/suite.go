@@ -28,7 +28,6 @@ const (
 )
 
 var (
-	insecureRouteManifest     = getTestFile("insecure-route.yaml")
 	secureGwPolicyManifest    = getTestFile("secured-gateway-policy.yaml")
 	secureRoutePolicyManifest = getTestFile("secured-route.yaml")
 
@@ -38,7 +37,7 @@ var (
 
 	testCases = map[string]*base.TestCase{
 		"TestRoutePolicy": {
-			Manifests: []string{insecureRouteManifest, secureRoutePolicyManifest},
+			Manifests: []string{secureRoutePolicyManifest},
 		},
 		"TestGatewayPolicy": {
 			Manifests: []string{secureGwPolicyManifest},
@@ -65,58 +64,58 @@ func NewTestingSuite(ctx context.Context, testInst *e2e.TestInstallation) suite.
 func (s *testingSuite) TestRoutePolicy() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-example-insecure",
+		"basicauth-route-example-insecure",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// test unprotected route works
-	s.assertResponseWithoutAuth("insecureroute.com", http.StatusOK)
+	s.assertResponseWithoutAuth("insecureroute.basicauth.example.com", http.StatusOK)
 
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure",
+		"basicauth-route-secure",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// test inline username/password store
-	s.assertResponse("secureroute.com", base64.StdEncoding.EncodeToString(([]byte)("alice:alicepassword")), http.StatusOK)
-	s.assertResponse("secureroute.com", base64.StdEncoding.EncodeToString(([]byte)("bob:bobpassword")), http.StatusOK)
+	s.assertResponse("secureroute.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("alice:alicepassword")), http.StatusOK)
+	s.assertResponse("secureroute.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("bob:bobpassword")), http.StatusOK)
 
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure-too",
+		"basicauth-route-secure-too",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// test secret-based username/password store
-	s.assertResponse("secureroutetoo.com", base64.StdEncoding.EncodeToString(([]byte)("eve:evepassword")), http.StatusOK)
-	s.assertResponse("secureroutetoo.com", base64.StdEncoding.EncodeToString(([]byte)("mallory:mallorypassword")), http.StatusOK)
+	s.assertResponse("secureroutetoo.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("eve:evepassword")), http.StatusOK)
+	s.assertResponse("secureroutetoo.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("mallory:mallorypassword")), http.StatusOK)
 	// test invalid username/password combinations
-	s.assertResponse("secureroute.com", base64.StdEncoding.EncodeToString(([]byte)("alice:boom")), http.StatusUnauthorized)
-	s.assertResponse("secureroutetoo.com", base64.StdEncoding.EncodeToString(([]byte)("eve:boom")), http.StatusUnauthorized)
-	s.assertResponse("secureroute.com", base64.StdEncoding.EncodeToString(([]byte)("trent:boom")), http.StatusUnauthorized)
-	s.assertResponseWithoutAuth("secureroute.com", http.StatusUnauthorized)
+	s.assertResponse("secureroute.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("alice:boom")), http.StatusUnauthorized)
+	s.assertResponse("secureroutetoo.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("eve:boom")), http.StatusUnauthorized)
+	s.assertResponse("secureroute.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("trent:boom")), http.StatusUnauthorized)
+	s.assertResponseWithoutAuth("secureroute.basicauth.example.com", http.StatusUnauthorized)
 }
 
 func (s *testingSuite) TestGatewayPolicy() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure-gw",
+		"basicauth-route-secure-gw",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// test inline user/password store
-	s.assertResponse("securegateways.com", base64.StdEncoding.EncodeToString(([]byte)("alice:alicepassword")), http.StatusOK)
-	s.assertResponse("securegateways.com", base64.StdEncoding.EncodeToString(([]byte)("bob:bobpassword")), http.StatusOK)
+	s.assertResponse("securegateways.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("alice:alicepassword")), http.StatusOK)
+	s.assertResponse("securegateways.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("bob:bobpassword")), http.StatusOK)
 
 	// test invalid username/password combinations
-	s.assertResponse("securegateways.com", base64.StdEncoding.EncodeToString(([]byte)("alice:boom")), http.StatusUnauthorized)
-	s.assertResponse("securegateways.com", base64.StdEncoding.EncodeToString(([]byte)("trent:boom")), http.StatusUnauthorized)
-	s.assertResponseWithoutAuth("securegateways.com", http.StatusUnauthorized)
+	s.assertResponse("securegateways.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("alice:boom")), http.StatusUnauthorized)
+	s.assertResponse("securegateways.basicauth.example.com", base64.StdEncoding.EncodeToString(([]byte)("trent:boom")), http.StatusUnauthorized)
+	s.assertResponseWithoutAuth("securegateways.basicauth.example.com", http.StatusUnauthorized)
 }
 
 func (s *testingSuite) assertResponse(hostHeader, authHeader string, expectedStatus int) {
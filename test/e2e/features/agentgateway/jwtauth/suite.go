@@ -43,7 +43,7 @@ var (
 
 	testCases = map[string]*base.TestCase{
 		"TestRoutePolicy": {
-			Manifests: []string{insecureRouteManifest, secureRoutePolicyManifest},
+			Manifests: []string{secureRoutePolicyManifest},
 		},
 		"TestRoutePolicyWithRbac": {
 			Manifests: []string{secureRoutePolicyWithRbacManifest},
@@ -73,7 +73,6 @@ func NewTestingSuite(ctx context.Context, testInst *e2e.TestInstallation) suite.
 }
 
 var (
-	insecureRouteManifest             = getTestFile("insecure-route.yaml")
 	secureGWPolicyManifest            = getTestFile("secured-gateway-policy.yaml")
 	secureGWPolicyWithRbacManifest    = getTestFile("secured-gateway-policy-with-rbac.yaml")
 	secureRoutePolicyManifest         = getTestFile("secured-route.yaml")
@@ -83,75 +82,75 @@ var (
 func (s *testingSuite) TestRoutePolicy() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-example-insecure",
+		"jwtauth-route-example-insecure",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// verify unprotected route works
-	s.assertResponseWithoutAuth("insecureroute.com", http.StatusOK)
+	s.assertResponseWithoutAuth("insecureroute.jwtauth.example.com", http.StatusOK)
 
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure",
+		"jwtauth-route-secure",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// verify a provider with a single key in jwks works
-	s.assertResponse("secureroute.com", jwt1, http.StatusOK)
+	s.assertResponse("secureroute.jwtauth.example.com", jwt1, http.StatusOK)
 	// verify a provider with multiple keys in jwks works
-	s.assertResponse("secureroute.com", jwt2, http.StatusOK)
-	s.assertResponse("secureroute.com", jwt3, http.StatusOK)
+	s.assertResponse("secureroute.jwtauth.example.com", jwt2, http.StatusOK)
+	s.assertResponse("secureroute.jwtauth.example.com", jwt3, http.StatusOK)
 	// verify invalid/missing tokens are caught
-	s.assertResponse("secureroute.com", "nosuchkey", http.StatusUnauthorized)
-	s.assertResponseWithoutAuth("secureroute.com", http.StatusUnauthorized)
+	s.assertResponse("secureroute.jwtauth.example.com", "nosuchkey", http.StatusUnauthorized)
+	s.assertResponseWithoutAuth("secureroute.jwtauth.example.com", http.StatusUnauthorized)
 }
 
 func (s *testingSuite) TestRoutePolicyWithRbac() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure",
+		"jwtauth-route-secure",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// jwt subject matches rbac policy
-	s.assertResponse("secureroute.com", jwt4, http.StatusOK)
+	s.assertResponse("secureroute.jwtauth.example.com", jwt4, http.StatusOK)
 	// jwt subject doesn't match rbac policy
-	s.assertResponse("secureroute.com", jwt5, http.StatusForbidden)
+	s.assertResponse("secureroute.jwtauth.example.com", jwt5, http.StatusForbidden)
 }
 
 func (s *testingSuite) TestGatewayPolicy() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure-gw",
+		"jwtauth-route-secure-gw",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// verify a provider with a single key in jwks works
-	s.assertResponse("securegateways.com", jwt1, http.StatusOK)
+	s.assertResponse("securegateways.jwtauth.example.com", jwt1, http.StatusOK)
 	// verify a provider with multiple keys in jwks works
-	s.assertResponse("securegateways.com", jwt2, http.StatusOK)
-	s.assertResponse("securegateways.com", jwt3, http.StatusOK)
-	s.assertResponse("securegateways.com", "nosuchkey", http.StatusUnauthorized)
+	s.assertResponse("securegateways.jwtauth.example.com", jwt2, http.StatusOK)
+	s.assertResponse("securegateways.jwtauth.example.com", jwt3, http.StatusOK)
+	s.assertResponse("securegateways.jwtauth.example.com", "nosuchkey", http.StatusUnauthorized)
 	// verify invalid/missing tokens are caught
-	s.assertResponseWithoutAuth("securegateways.com", http.StatusUnauthorized)
+	s.assertResponseWithoutAuth("securegateways.jwtauth.example.com", http.StatusUnauthorized)
 }
 
 func (s *testingSuite) TestGatewayPolicyWithRbac() {
 	s.TestInstallation.AssertionsT(s.T()).EventuallyHTTPRouteCondition(
 		s.Ctx,
-		"route-secure-gw",
+		"jwtauth-route-secure-gw",
 		namespace,
 		gwv1.RouteConditionAccepted,
 		metav1.ConditionTrue,
 	)
 	// jwt subject matches rbac policy
-	s.assertResponse("securegateways.com", jwt4, http.StatusOK)
+	s.assertResponse("securegateways.jwtauth.example.com", jwt4, http.StatusOK)
 	// jwt subject doesn't match rbac policy
-	s.assertResponse("securegateways.com", jwt5, http.StatusForbidden)
+	s.assertResponse("securegateways.jwtauth.example.com", jwt5, http.StatusForbidden)
 }
 
 func (s *testingSuite) assertResponse(hostHeader, authHeader string, expectedStatus int) {
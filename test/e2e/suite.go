@@ -25,6 +25,11 @@ type (
 		suites map[string]NewSuiteFunc
 	}
 
+	parallelSuites struct {
+		suites
+		fixtures []string
+	}
+
 	// A SuiteRunner is an interface that allows E2E tests to simply Register tests in one location and execute them
 	// with Run.
 	SuiteRunner interface {
@@ -36,6 +41,7 @@ type (
 var (
 	_ SuiteRunner = new(orderedSuites)
 	_ SuiteRunner = new(suites)
+	_ SuiteRunner = new(parallelSuites)
 )
 
 // NewSuiteRunner returns an implementation of TestRunner that will execute tests as specified
@@ -52,6 +58,18 @@ func NewSuiteRunner(ordered bool) SuiteRunner {
 	return new(suites)
 }
 
+// NewParallelSuiteRunner returns an implementation of TestRunner that executes the registered suites concurrently.
+// Run only returns once all suites completed, so it can be combined with other runners against the same installation.
+// The fixtures are manifests of the resources shared by the suites: they are applied once before the suites run,
+// and deleted once all of them completed.
+//
+// NOTE: suites registered here share the installation with each other, so they must not modify any shared
+// resources. Each suite must use its own resource names and hostnames, and attach gateway-level policies to
+// its own listener rather than to the whole Gateway.
+func NewParallelSuiteRunner(fixtures ...string) SuiteRunner {
+	return &parallelSuites{fixtures: fixtures}
+}
+
 func (o *orderedSuites) Run(ctx context.Context, t *testing.T, testInstallation *TestInstallation) {
 	for _, namedTest := range o.suites {
 		t.Run(namedTest.name, func(t *testing.T) {
@@ -86,3 +104,26 @@ func (u *suites) Register(name string, newSuite NewSuiteFunc) {
 	}
 	u.suites[name] = newSuite
 }
+
+func (p *parallelSuites) Run(ctx context.Context, t *testing.T, testInstallation *TestInstallation) {
+	if len(p.fixtures) > 0 {
+		if err := testInstallation.ClusterContext.IstioClient.ApplyYAMLFiles("", p.fixtures...); err != nil {
+			t.Fatalf("failed to apply the shared fixtures: %v", err)
+		}
+		defer func() {
+			if err := testInstallation.ClusterContext.IstioClient.DeleteYAMLFiles("", p.fixtures...); err != nil {
+				t.Errorf("failed to delete the shared fixtures: %v", err)
+			}
+		}()
+	}
+
+	// parallel subtests are only released once their parent returns, so group them to wait for their completion
+	t.Run("Parallel", func(t *testing.T) {
+		for testName, newSuite := range p.suites.suites {
+			t.Run(testName, func(t *testing.T) {
+				t.Parallel()
+				suite.Run(t, newSuite(ctx, testInstallation))
+			})
+		}
+	})
+}
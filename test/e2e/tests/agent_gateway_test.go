@@ -58,4 +58,5 @@ func TestAgentgatewayIntegration(t *testing.T) {
 		Name:      "gateway",
 	})
 	AgentgatewaySuiteRunner().Run(ctx, t, testInstallation)
+	AgentgatewayParallelSuiteRunner().Run(ctx, t, testInstallation)
 }
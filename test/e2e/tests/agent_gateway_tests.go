@@ -47,10 +47,20 @@ func AgentgatewaySuiteRunner() e2e.SuiteRunner {
 	agentgatewaySuiteRunner.Register("RBAC", rbac.NewTestingSuite)
 	agentgatewaySuiteRunner.Register("Transformation", transformation.NewTestingSuite)
 	agentgatewaySuiteRunner.Register("BackendTLSPolicy", backendtls.NewTestingSuite)
+	agentgatewaySuiteRunner.Register("PolicyStatus", policystatus.NewTestingSuite)
+
+	return agentgatewaySuiteRunner
+}
+
+// AgentgatewayParallelSuiteRunner returns the suites that run concurrently against the shared base installation.
+// Each of them uses its own listener of the base Gateway, see manifests/agent-gateway-base.yaml, and the
+// resources common to the suites are applied once from manifests/agent-gateway-auth-fixtures.yaml.
+func AgentgatewayParallelSuiteRunner() e2e.SuiteRunner {
+	agentgatewaySuiteRunner := e2e.NewParallelSuiteRunner(e2e.ManifestPath("agent-gateway-auth-fixtures.yaml"))
+
 	agentgatewaySuiteRunner.Register("BasicAuth", basicauth.NewTestingSuite)
 	agentgatewaySuiteRunner.Register("ApiKeyAuth", apikeyauth.NewTestingSuite)
 	agentgatewaySuiteRunner.Register("JwtAuth", jwtauth.NewTestingSuite)
-	agentgatewaySuiteRunner.Register("PolicyStatus", policystatus.NewTestingSuite)
 
 	return agentgatewaySuiteRunner
 }
@@ -259,6 +259,26 @@ spec:
 				"spec.tcpKeepalive.keepAliveTime: Invalid value: .*: keepAliveTime must be at least 1 second",
 			},
 		},
+		{
+			name: "ListenerPolicy: clientIPHeader cannot be combined with xffNumTrustedHops",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: ListenerPolicy
+metadata:
+  name: test
+spec:
+  targetRefs:
+  - group: gateway.networking.k8s.io
+    kind: Gateway
+    name: gw
+  default:
+    httpSettings:
+      xffNumTrustedHops: 1
+      clientIPHeader:
+        name: CF-Connecting-IP
+`,
+			wantErrors: []string{"clientIPHeader cannot be combined with useRemoteAddress or xffNumTrustedHops"},
+		},
 		{
 			name: "TrafficPolicy: valid target references",
 			input: `---
@@ -440,6 +460,67 @@ spec:
 `,
 			wantErrors: []string{"retryOn or statusCodes must be set"},
 		},
+		{
+			name: "TrafficPolicy: retry.backoffMaxInterval must not be less than retry.backoffBaseInterval",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: TrafficPolicy
+metadata:
+  name: test
+spec:
+  retry:
+    retryOn:
+    - gateway-error
+    attempts: 2
+    backoffBaseInterval: 100ms
+    backoffMaxInterval: 50ms
+`,
+			wantErrors: []string{"retry.backoffMaxInterval must be greater than or equal to retry.backoffBaseInterval"},
+		},
+		{
+			name: "TrafficPolicy: retry.backoffMaxInterval must not be less than the default retry.backoffBaseInterval",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: TrafficPolicy
+metadata:
+  name: test
+spec:
+  retry:
+    retryOn:
+    - gateway-error
+    attempts: 2
+    backoffMaxInterval: 10ms
+`,
+			wantErrors: []string{"retry.backoffMaxInterval must be greater than or equal to retry.backoffBaseInterval, which defaults to 25ms"},
+		},
+		{
+			name: "TrafficPolicy: admissionControl.aggression must be at least 1.0",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: TrafficPolicy
+metadata:
+  name: test
+spec:
+  admissionControl:
+    aggression: "0.5"
+`,
+			wantErrors: []string{"aggression must be a decimal number of at least 1.0"},
+		},
+		{
+			name: "TrafficPolicy: tap file sink must write to the tap directory",
+			input: `---
+apiVersion: gateway.kgateway.dev/v1alpha1
+kind: TrafficPolicy
+metadata:
+  name: test
+spec:
+  tap:
+    sink:
+      file:
+        pathPrefix: /var/run/tap/../../etc/envoy/tap
+`,
+			wantErrors: []string{"pathPrefix must be in the /var/run/tap directory"},
+		},
 		{
 			name: "TrafficPolicy: retry.perTryTimeout must be less than timeouts.request",
 			input: `---
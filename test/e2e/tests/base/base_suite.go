@@ -6,7 +6,6 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"path/filepath"
 	"slices"
 	"strings"
 	"testing"
@@ -567,10 +566,15 @@ func stripNamespaceResources(t *testing.T, manifests ...string) string {
 // DeleteManifests deletes the manifests and waits until the resources are deleted.
 func (s *BaseTestingSuite) DeleteManifests(testCase *TestCase) {
 	nf := stripNamespaceResources(s.T(), testCase.Manifests...)
-	fp := filepath.Join(s.TestInstallation.GeneratedFiles.TempDir, "delete_manifests.yaml")
-	s.Require().NoError(os.WriteFile(fp, []byte(nf), 0o644)) //nolint:gosec // G306: Golden test file can be readable
+	// suites may run in parallel, so each deletion gets its own file
+	f, err := os.CreateTemp(s.TestInstallation.GeneratedFiles.TempDir, "delete_manifests_*.yaml")
+	s.Require().NoError(err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(nf)
+	s.Require().NoError(err)
+	s.Require().NoError(f.Close())
 
-	err := s.TestInstallation.ClusterContext.IstioClient.DeleteYAMLFiles("", fp)
+	err = s.TestInstallation.ClusterContext.IstioClient.DeleteYAMLFiles("", f.Name())
 	s.Require().NoError(err)
 
 	// we don't need to transform the manifest here, as we are just deleting by filename